@@ -7,9 +7,16 @@
 package whatsmeow
 
 import (
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"strconv"
 	"time"
 
+	"google.golang.org/protobuf/proto"
+
 	"go.mau.fi/whatsmeow/appstate"
 	waBinary "go.mau.fi/whatsmeow/binary"
 	waProto "go.mau.fi/whatsmeow/binary/proto"
@@ -21,9 +28,90 @@ import (
 // even when re-syncing the whole state.
 var EmitAppStateEventsOnFullSync = false
 
+// AppStateKeyRequestTimeout is how long to wait for the phone to share requested app state keys
+// before emitting an events.AppStateKeysNotReceived event.
+var AppStateKeyRequestTimeout = 5 * time.Minute
+
+type appStateFetch struct {
+	fullSync        bool
+	onlyIfNotSynced bool
+	done            chan struct{}
+	err             error
+}
+
 // FetchAppState fetches updates to the given type of app state. If fullSync is true, the current
 // cached state will be removed and all app state patches will be re-fetched from the server.
+//
+// A full sync can be used to recover from a corrupted local state (e.g. LTHash mismatch errors, which are also
+// emitted as events.AppStateSyncError). AutoRecoverAppState can be enabled to do that automatically.
+// During full syncs, an events.AppStateSyncProgress event is emitted after each page of patches and after each
+// batch of a snapshot.
+// If a full sync fails partway, the local state is left at the last fully processed patch,
+// so calling this again (with or without fullSync) will continue from a valid state.
+//
+// If a fetch of the same app state is already in progress and it covers this request, this waits
+// for it to finish and returns its result instead of fetching again.
 func (cli *Client) FetchAppState(name appstate.WAPatchName, fullSync, onlyIfNotSynced bool) error {
+	cli.appStateFetchesLock.Lock()
+	existing, ok := cli.appStateFetches[name]
+	if ok && (existing.fullSync || !fullSync) && (!existing.onlyIfNotSynced || onlyIfNotSynced) {
+		cli.appStateFetchesLock.Unlock()
+		cli.Log.Debugf("Waiting for existing fetch of app state %s to complete", name)
+		<-existing.done
+		return existing.err
+	}
+	fetch := &appStateFetch{fullSync: fullSync, onlyIfNotSynced: onlyIfNotSynced, done: make(chan struct{})}
+	cli.appStateFetches[name] = fetch
+	cli.appStateFetchesLock.Unlock()
+
+	fetch.err = cli.fetchAppState(name, fullSync, onlyIfNotSynced)
+	var mismatch *appstate.HashMismatchError
+	if errors.As(fetch.err, &mismatch) {
+		resync := cli.AutoRecoverAppState && cli.markAppStateResync(name)
+		cli.dispatchEvent(&events.AppStateSyncError{
+			Name:       name,
+			Version:    mismatch.Version,
+			Expected:   mismatch.Expected,
+			Got:        mismatch.Got,
+			Error:      fetch.err,
+			WillResync: resync,
+		})
+		if resync {
+			cli.Log.Warnf("Local state of app state %s is corrupted, doing a full resync: %v", name, fetch.err)
+			fetch.err = cli.fetchAppState(name, true, false)
+		}
+	}
+
+	cli.appStateFetchesLock.Lock()
+	if cli.appStateFetches[name] == fetch {
+		delete(cli.appStateFetches, name)
+	}
+	cli.appStateFetchesLock.Unlock()
+	close(fetch.done)
+	return fetch.err
+}
+
+// markAppStateResync marks the given app state type as automatically resynced on this connection.
+// It returns false if the type has already been resynced.
+func (cli *Client) markAppStateResync(name appstate.WAPatchName) bool {
+	cli.appStateFetchesLock.Lock()
+	defer cli.appStateFetchesLock.Unlock()
+	if _, alreadyResynced := cli.appStateResyncs[name]; alreadyResynced {
+		return false
+	} else if cli.appStateResyncs == nil {
+		cli.appStateResyncs = make(map[appstate.WAPatchName]struct{})
+	}
+	cli.appStateResyncs[name] = struct{}{}
+	return true
+}
+
+func (cli *Client) resetAppStateResyncs() {
+	cli.appStateFetchesLock.Lock()
+	cli.appStateResyncs = nil
+	cli.appStateFetchesLock.Unlock()
+}
+
+func (cli *Client) fetchAppState(name appstate.WAPatchName, fullSync, onlyIfNotSynced bool) error {
 	cli.appStateSyncLock.Lock()
 	defer cli.appStateSyncLock.Unlock()
 	if fullSync {
@@ -43,81 +131,305 @@ func (cli *Client) FetchAppState(name appstate.WAPatchName, fullSync, onlyIfNotS
 	}
 	state := appstate.HashState{Version: version, Hash: hash}
 	hasMore := true
+	patchesProcessed := 0
 	for hasMore {
-		patches, err := cli.fetchAppStatePatches(name, state.Version)
+		// Snapshots are only requested when starting from scratch, later pages only contain patches.
+		patches, err := cli.fetchAppStatePatches(name, state.Version, state.Version == 0)
 		if err != nil {
 			return fmt.Errorf("failed to fetch app state %s patches: %w", name, err)
 		}
 		hasMore = patches.HasMorePatches
 
+		if patches.SnapshotRef != nil {
+			state, err = cli.decodeAppStateSnapshot(name, patches.SnapshotRef, fullSync)
+			if errors.Is(err, appstate.ErrKeyNotFound) {
+				go cli.requestMissingAppStateKeys(name, patches)
+				return fmt.Errorf("failed to decode app state %s snapshot: %w", name, err)
+			} else if err != nil {
+				return fmt.Errorf("failed to decode app state %s snapshot: %w", name, err)
+			}
+			patchesProcessed++
+		}
+
 		mutations, newState, err := cli.appStateProc.DecodePatches(patches, state, true)
-		if err != nil {
+		// Patches before the failing one have already been stored, so their mutations are dispatched even if there was an error.
+		for _, mutation := range mutations {
+			cli.dispatchAppState(mutation, fullSync, EmitAppStateEventsOnFullSync)
+		}
+		if errors.Is(err, appstate.ErrKeyNotFound) {
+			// The fetch will be retried after the phone sends the keys to us in an AppStateSyncKeyShare message.
+			go cli.requestMissingAppStateKeys(name, patches)
+			return fmt.Errorf("failed to decode app state %s patches: %w", name, err)
+		} else if err != nil {
 			return fmt.Errorf("failed to decode app state %s patches: %w", name, err)
 		}
 		state = newState
-		for _, mutation := range mutations {
-			cli.dispatchAppState(mutation, !fullSync || EmitAppStateEventsOnFullSync)
+		if fullSync {
+			patchesProcessed += len(patches.Patches)
+			cli.dispatchEvent(&events.AppStateSyncProgress{
+				Name:             name,
+				Version:          state.Version,
+				PatchesProcessed: patchesProcessed,
+				HasMore:          hasMore,
+			})
 		}
 	}
 	return nil
 }
 
-func (cli *Client) dispatchAppState(mutation appstate.Mutation, dispatchEvts bool) {
+// decodeAppStateSnapshot downloads the given snapshot from the media server into a temporary file and applies it
+// in batches, so that the snapshots of large accounts don't need to be held in memory all at once.
+func (cli *Client) decodeAppStateSnapshot(name appstate.WAPatchName, ref *waProto.ExternalBlobReference, fullSync bool) (appstate.HashState, error) {
+	reader, err := cli.downloadMediaStreamWithPath(ref.GetDirectPath(), ref.GetFileEncSha256(), ref.GetFileSha256(), ref.GetMediaKey(), int(ref.GetFileSizeBytes()), MediaAppState, mediaTypeToMMSType[MediaAppState])
+	if err != nil {
+		return appstate.HashState{}, fmt.Errorf("failed to download snapshot: %w", err)
+	}
+	defer reader.Close()
+	// The snapshot MAC has to be verified before anything is applied, so the snapshot is read twice.
+	file, err := os.CreateTemp("", "whatsmeow-appstate-*")
+	if err != nil {
+		return appstate.HashState{}, fmt.Errorf("failed to create temporary file for snapshot: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}()
+	if _, err = io.Copy(file, reader); err != nil {
+		return appstate.HashState{}, fmt.Errorf("failed to download snapshot: %w", err)
+	} else if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return appstate.HashState{}, fmt.Errorf("failed to rewind downloaded snapshot: %w", err)
+	}
+	total := int64(ref.GetFileSizeBytes())
+	return cli.appStateProc.DecodeSnapshotStream(name, file, true, func(mutations []appstate.Mutation, bytesRead int64) {
+		for _, mutation := range mutations {
+			cli.dispatchAppState(mutation, fullSync, EmitAppStateEventsOnFullSync)
+		}
+		cli.dispatchEvent(&events.AppStateSyncProgress{
+			Name:                 name,
+			HasMore:              true,
+			SnapshotBytesDecoded: bytesRead,
+			SnapshotBytesTotal:   total,
+		})
+	})
+}
+
+func (cli *Client) requestMissingAppStateKeys(name appstate.WAPatchName, patches *appstate.PatchList) {
+	cli.appStateKeyRequestsLock.Lock()
+	cli.stalledAppStates[name] = struct{}{}
+	rawKeyIDs := make(map[string][]byte)
+	addKeyID := func(keyID []byte) {
+		if len(keyID) == 0 {
+			return
+		}
+		stringKeyID := hex.EncodeToString(keyID)
+		if _, alreadyAdded := rawKeyIDs[stringKeyID]; alreadyAdded {
+			return
+		} else if lastRequest, ok := cli.appStateKeyRequests[stringKeyID]; ok && cli.now().Sub(lastRequest) < 24*time.Hour {
+			return
+		} else if key, err := cli.Store.AppStateKeys.GetAppStateSyncKey(keyID); key != nil || err != nil {
+			return
+		}
+		rawKeyIDs[stringKeyID] = keyID
+		cli.appStateKeyRequests[stringKeyID] = cli.now()
+	}
+	for _, patch := range patches.Patches {
+		addKeyID(patch.GetKeyId().GetId())
+		for _, mutation := range patch.GetMutations() {
+			addKeyID(mutation.GetRecord().GetKeyId().GetId())
+		}
+	}
+	cli.appStateKeyRequestsLock.Unlock()
+	if len(rawKeyIDs) == 0 {
+		return
+	}
+	keyIDs := make([][]byte, 0, len(rawKeyIDs))
+	for _, keyID := range rawKeyIDs {
+		keyIDs = append(keyIDs, keyID)
+	}
+	cli.requestAppStateKeys(keyIDs)
+	time.AfterFunc(AppStateKeyRequestTimeout, func() {
+		cli.checkAppStateKeysReceived(name, keyIDs)
+	})
+}
+
+// checkAppStateKeysReceived emits an events.AppStateKeysNotReceived event if the given app state
+// is still waiting for keys after the request timeout.
+func (cli *Client) checkAppStateKeysReceived(name appstate.WAPatchName, keyIDs [][]byte) {
+	cli.appStateKeyRequestsLock.Lock()
+	_, stillStalled := cli.stalledAppStates[name]
+	cli.appStateKeyRequestsLock.Unlock()
+	if !stillStalled {
+		return
+	}
+	missing := make([][]byte, 0, len(keyIDs))
+	for _, keyID := range keyIDs {
+		if key, err := cli.Store.AppStateKeys.GetAppStateSyncKey(keyID); err != nil {
+			cli.Log.Warnf("Failed to check if app state key %X was received: %v", keyID, err)
+		} else if key == nil {
+			missing = append(missing, keyID)
+		}
+	}
+	if len(missing) > 0 {
+		cli.Log.Warnf("Didn't receive %d requested keys for app state %s within %s", len(missing), name, AppStateKeyRequestTimeout)
+		cli.dispatchEvent(&events.AppStateKeysNotReceived{Name: name, KeyIDs: missing})
+	}
+}
+
+// popStalledAppStates returns the app state types that couldn't be synced because of missing keys and clears the list.
+func (cli *Client) popStalledAppStates() map[appstate.WAPatchName]struct{} {
+	cli.appStateKeyRequestsLock.Lock()
+	stalled := cli.stalledAppStates
+	cli.stalledAppStates = make(map[appstate.WAPatchName]struct{})
+	cli.appStateKeyRequestsLock.Unlock()
+	return stalled
+}
+
+func (cli *Client) requestAppStateKeys(rawKeyIDs [][]byte) {
+	keyIDs := make([]*waProto.AppStateSyncKeyId, len(rawKeyIDs))
+	debugKeyIDs := make([]string, len(rawKeyIDs))
+	for i, keyID := range rawKeyIDs {
+		keyIDs[i] = &waProto.AppStateSyncKeyId{KeyId: keyID}
+		debugKeyIDs[i] = hex.EncodeToString(keyID)
+	}
+	msg := &waProto.Message{
+		ProtocolMessage: &waProto.ProtocolMessage{
+			Type: waProto.ProtocolMessage_APP_STATE_SYNC_KEY_REQUEST.Enum(),
+			AppStateSyncKeyRequest: &waProto.AppStateSyncKeyRequest{
+				KeyIds: keyIDs,
+			},
+		},
+	}
+	cli.Log.Infof("Sending key request for app state keys %+v", debugKeyIDs)
+	err := cli.SendPeerMessage(msg)
+	if err != nil {
+		cli.Log.Warnf("Failed to send app state key request: %v", err)
+	}
+}
+
+func (cli *Client) handleAppStateSyncKeyRequest(info *types.MessageInfo, req *waProto.AppStateSyncKeyRequest) {
+	if !info.IsFromMe || info.Sender.Device == cli.Store.ID.Device {
+		cli.Log.Warnf("Ignoring app state key request %s from %s: not from another one of our devices", info.ID, info.Sender)
+		return
+	}
+	keys := make([]*waProto.AppStateSyncKey, 0, len(req.GetKeyIds()))
+	for _, keyID := range req.GetKeyIds() {
+		rawKeyID := keyID.GetKeyId()
+		key, err := cli.Store.AppStateKeys.GetAppStateSyncKey(rawKeyID)
+		if err != nil {
+			cli.Log.Errorf("Failed to get app state key %X requested by %s: %v", rawKeyID, info.Sender, err)
+			continue
+		} else if key == nil {
+			cli.Log.Debugf("App state key %X requested by %s not found", rawKeyID, info.Sender)
+			continue
+		}
+		var fingerprint waProto.AppStateSyncKeyFingerprint
+		err = proto.Unmarshal(key.Fingerprint, &fingerprint)
+		if err != nil {
+			cli.Log.Errorf("Failed to unmarshal fingerprint of app state key %X: %v", rawKeyID, err)
+			continue
+		}
+		keys = append(keys, &waProto.AppStateSyncKey{
+			KeyId: &waProto.AppStateSyncKeyId{KeyId: rawKeyID},
+			KeyData: &waProto.AppStateSyncKeyData{
+				KeyData:     key.Data,
+				Fingerprint: &fingerprint,
+				Timestamp:   proto.Int64(key.Timestamp),
+			},
+		})
+	}
+	if len(keys) == 0 {
+		return
+	}
+	msg := &waProto.Message{
+		ProtocolMessage: &waProto.ProtocolMessage{
+			Type: waProto.ProtocolMessage_APP_STATE_SYNC_KEY_SHARE.Enum(),
+			AppStateSyncKeyShare: &waProto.AppStateSyncKeyShare{
+				Keys: keys,
+			},
+		},
+	}
+	cli.Log.Infof("Sharing %d app state keys with %s", len(keys), info.Sender)
+	err := cli.sendPeerMessage(info.Sender, GenerateMessageID(), msg)
+	if err != nil {
+		cli.Log.Warnf("Failed to send app state key share to %s: %v", info.Sender, err)
+	}
+}
+
+func (cli *Client) dispatchAppState(mutation appstate.Mutation, fullSync, emitOnFullSync bool) {
+	dispatchEvts := !fullSync || emitOnFullSync
+
 	if mutation.Operation != waProto.SyncdMutation_SET {
 		return
 	}
 
 	if dispatchEvts {
-		cli.dispatchEvent(&events.AppState{Index: mutation.Index, SyncActionValue: mutation.Action})
+		cli.dispatchEvent(&events.AppState{Index: mutation.Index, FromFullSync: fullSync, SyncActionValue: mutation.Action})
 	}
 
 	var jid types.JID
 	if len(mutation.Index) > 1 {
 		jid, _ = types.ParseJID(mutation.Index[1])
 	}
-	ts := time.Unix(mutation.Action.GetTimestamp(), 0)
+	ts := time.UnixMilli(mutation.Action.GetTimestamp())
 
 	var storeUpdateError error
 	var eventToDispatch interface{}
 	switch mutation.Index[0] {
 	case "mute":
 		act := mutation.Action.GetMuteAction()
-		eventToDispatch = &events.Mute{JID: jid, Timestamp: ts, Action: act}
+		eventToDispatch = &events.Mute{JID: jid, Timestamp: ts, Action: act, FromFullSync: fullSync}
 		var mutedUntil time.Time
 		if act.GetMuted() {
-			mutedUntil = time.Unix(act.GetMuteEndTimestamp(), 0)
+			mutedUntil = time.UnixMilli(act.GetMuteEndTimestamp())
 		}
 		if cli.Store.ChatSettings != nil {
 			storeUpdateError = cli.Store.ChatSettings.PutMutedUntil(jid, mutedUntil)
 		}
 	case "pin_v1":
 		act := mutation.Action.GetPinAction()
-		eventToDispatch = &events.Pin{JID: jid, Timestamp: ts, Action: act}
+		eventToDispatch = &events.Pin{JID: jid, Timestamp: ts, Action: act, FromFullSync: fullSync}
 		if cli.Store.ChatSettings != nil {
 			storeUpdateError = cli.Store.ChatSettings.PutPinned(jid, act.GetPinned())
 		}
 	case "archive":
 		act := mutation.Action.GetArchiveChatAction()
-		eventToDispatch = &events.Archive{JID: jid, Timestamp: ts, Action: act}
+		eventToDispatch = &events.Archive{JID: jid, Timestamp: ts, Action: act, FromFullSync: fullSync}
 		if cli.Store.ChatSettings != nil {
 			storeUpdateError = cli.Store.ChatSettings.PutArchived(jid, act.GetArchived())
 		}
 	case "contact":
 		act := mutation.Action.GetContactAction()
-		eventToDispatch = &events.Contact{JID: jid, Timestamp: ts, Action: act}
+		eventToDispatch = &events.Contact{JID: jid, Timestamp: ts, Action: act, FromFullSync: fullSync}
 		if cli.Store.Contacts != nil {
 			storeUpdateError = cli.Store.Contacts.PutContactName(jid, act.GetFirstName(), act.GetFullName())
 		}
+	case "markChatAsRead":
+		eventToDispatch = &events.MarkChatAsRead{JID: jid, Timestamp: ts, Action: mutation.Action.GetMarkChatAsReadAction(), FromFullSync: fullSync}
+	case "clearChat":
+		evt := events.ClearChat{JID: jid, Timestamp: ts, Action: mutation.Action.GetClearChatAction(), FromFullSync: fullSync}
+		if len(mutation.Index) > 3 {
+			evt.DeleteStarred = mutation.Index[2] == "1"
+			evt.DeleteMedia = mutation.Index[3] == "1"
+		}
+		eventToDispatch = &evt
+	case "deleteChat":
+		evt := events.DeleteChat{JID: jid, Timestamp: ts, Action: mutation.Action.GetDeleteChatAction(), FromFullSync: fullSync}
+		if len(mutation.Index) > 2 {
+			evt.DeleteMedia = mutation.Index[2] == "1"
+		}
+		eventToDispatch = &evt
 	case "star":
 		if len(mutation.Index) < 5 {
 			return
 		}
 		evt := events.Star{
-			ChatJID:   jid,
-			MessageID: mutation.Index[2],
-			Timestamp: ts,
-			Action:    mutation.Action.GetStarAction(),
-			IsFromMe:  mutation.Index[3] == "1",
+			ChatJID:      jid,
+			MessageID:    mutation.Index[2],
+			Timestamp:    ts,
+			Action:       mutation.Action.GetStarAction(),
+			IsFromMe:     mutation.Index[3] == "1",
+			Starred:      mutation.Action.GetStarAction().GetStarred(),
+			FromFullSync: fullSync,
 		}
 		if mutation.Index[4] != "0" {
 			evt.SenderJID, _ = types.ParseJID(mutation.Index[4])
@@ -128,25 +440,67 @@ func (cli *Client) dispatchAppState(mutation appstate.Mutation, dispatchEvts boo
 			return
 		}
 		evt := events.DeleteForMe{
-			ChatJID:   jid,
-			MessageID: mutation.Index[2],
-			Timestamp: ts,
-			Action:    mutation.Action.GetDeleteMessageForMeAction(),
-			IsFromMe:  mutation.Index[3] == "1",
+			ChatJID:      jid,
+			MessageID:    mutation.Index[2],
+			Timestamp:    ts,
+			Action:       mutation.Action.GetDeleteMessageForMeAction(),
+			IsFromMe:     mutation.Index[3] == "1",
+			FromFullSync: fullSync,
 		}
 		if mutation.Index[4] != "0" {
 			evt.SenderJID, _ = types.ParseJID(mutation.Index[4])
 		}
 		eventToDispatch = &evt
+	case "label_edit":
+		act := mutation.Action.GetLabelEditAction()
+		eventToDispatch = &events.LabelEdit{LabelID: mutation.Index[1], Timestamp: ts, Action: act, FromFullSync: fullSync}
+		if cli.Store.Labels != nil {
+			if act.GetDeleted() {
+				storeUpdateError = cli.Store.Labels.DeleteLabel(mutation.Index[1])
+			} else {
+				storeUpdateError = cli.Store.Labels.PutLabel(types.Label{
+					ID:           mutation.Index[1],
+					Name:         act.GetName(),
+					Color:        act.GetColor(),
+					PredefinedID: act.GetPredefinedId(),
+				})
+			}
+		}
+	case "label_jid":
+		if len(mutation.Index) < 3 {
+			return
+		}
+		jid, _ = types.ParseJID(mutation.Index[2])
+		eventToDispatch = &events.LabelAssociationChat{
+			JID:          jid,
+			LabelID:      mutation.Index[1],
+			Label:        cli.getCachedLabel(mutation.Index[1]),
+			Timestamp:    ts,
+			Action:       mutation.Action.GetLabelAssociationAction(),
+			FromFullSync: fullSync,
+		}
+	case "label_message":
+		if len(mutation.Index) < 4 {
+			return
+		}
+		jid, _ = types.ParseJID(mutation.Index[2])
+		eventToDispatch = &events.LabelAssociationMessage{
+			JID:          jid,
+			LabelID:      mutation.Index[1],
+			Label:        cli.getCachedLabel(mutation.Index[1]),
+			MessageID:    mutation.Index[3],
+			Timestamp:    ts,
+			Action:       mutation.Action.GetLabelAssociationAction(),
+			FromFullSync: fullSync,
+		}
 	case "setting_pushName":
-		eventToDispatch = &events.PushNameSetting{Timestamp: ts, Action: mutation.Action.GetPushNameSetting()}
-		cli.Store.PushName = mutation.Action.GetPushNameSetting().GetName()
-		err := cli.Store.Save()
+		eventToDispatch = &events.PushNameSetting{Timestamp: ts, Action: mutation.Action.GetPushNameSetting(), FromFullSync: fullSync}
+		err := cli.Store.SetPushName(mutation.Action.GetPushNameSetting().GetName())
 		if err != nil {
 			cli.Log.Errorf("Failed to save device store after updating push name: %v", err)
 		}
 	case "setting_unarchiveChats":
-		eventToDispatch = &events.UnarchiveChatsSetting{Timestamp: ts, Action: mutation.Action.GetUnarchiveChatsSetting()}
+		eventToDispatch = &events.UnarchiveChatsSetting{Timestamp: ts, Action: mutation.Action.GetUnarchiveChatsSetting(), FromFullSync: fullSync}
 	}
 	if storeUpdateError != nil {
 		cli.Log.Errorf("Failed to update device store after app state mutation: %v", storeUpdateError)
@@ -156,7 +510,7 @@ func (cli *Client) dispatchAppState(mutation appstate.Mutation, dispatchEvts boo
 	}
 }
 
-func (cli *Client) fetchAppStatePatches(name appstate.WAPatchName, fromVersion uint64) (*appstate.PatchList, error) {
+func (cli *Client) fetchAppStatePatches(name appstate.WAPatchName, fromVersion uint64, snapshot bool) (*appstate.PatchList, error) {
 	resp, err := cli.sendIQ(infoQuery{
 		Namespace: "w:sync:app:state",
 		Type:      "set",
@@ -168,7 +522,7 @@ func (cli *Client) fetchAppStatePatches(name appstate.WAPatchName, fromVersion u
 				Attrs: waBinary.Attrs{
 					"name":            string(name),
 					"version":         fromVersion,
-					"return_snapshot": false,
+					"return_snapshot": snapshot,
 				},
 			}},
 		}},
@@ -176,5 +530,326 @@ func (cli *Client) fetchAppStatePatches(name appstate.WAPatchName, fromVersion u
 	if err != nil {
 		return nil, err
 	}
-	return appstate.ParsePatchList(resp)
+	return appstate.ParsePatchListLazySnapshot(resp, cli.downloadExternalAppStateBlob)
+}
+
+func (cli *Client) downloadExternalAppStateBlob(ref *waProto.ExternalBlobReference) ([]byte, error) {
+	return cli.downloadMediaWithPath(ref.GetDirectPath(), ref.GetFileEncSha256(), ref.GetFileSha256(), ref.GetMediaKey(), int(ref.GetFileSizeBytes()), MediaAppState, mediaTypeToMMSType[MediaAppState])
+}
+
+// SendAppState sends the given app state patch, then fetches the app state to apply the change locally.
+func (cli *Client) SendAppState(patch appstate.PatchInfo) error {
+	err := cli.sendAppStatePatch(patch)
+	if errors.Is(err, ErrAppStatePatchConflict) {
+		// Someone else changed the app state in between, fetch the new state and try again on top of it
+		cli.Log.Debugf("App state %s changed on the server, fetching latest state and retrying patch", patch.Type)
+		err = cli.FetchAppState(patch.Type, false, false)
+		if err != nil {
+			return fmt.Errorf("failed to fetch app state %s after conflict: %w", patch.Type, err)
+		}
+		err = cli.sendAppStatePatch(patch)
+	}
+	if err != nil {
+		return err
+	}
+	return cli.FetchAppState(patch.Type, false, false)
+}
+
+func (cli *Client) sendAppStatePatch(patch appstate.PatchInfo) error {
+	cli.appStateSyncLock.Lock()
+	defer cli.appStateSyncLock.Unlock()
+	version, hash, err := cli.Store.AppState.GetAppStateVersion(string(patch.Type))
+	if err != nil {
+		return fmt.Errorf("failed to get app state %s version: %w", patch.Type, err)
+	}
+	latestKeyID, err := cli.Store.AppStateKeys.GetLatestAppStateSyncKeyID()
+	if err != nil {
+		return fmt.Errorf("failed to get latest app state key ID: %w", err)
+	} else if latestKeyID == nil {
+		return ErrNoAppStateKey
+	}
+
+	if patch.Timestamp.IsZero() {
+		patch.Timestamp = cli.now()
+	}
+	state := appstate.HashState{Version: version, Hash: hash}
+	encodedPatch, err := cli.appStateProc.EncodePatch(latestKeyID, state, patch)
+	if err != nil {
+		return err
+	}
+
+	resp, err := cli.sendIQ(infoQuery{
+		Namespace: "w:sync:app:state",
+		Type:      "set",
+		To:        types.ServerJID,
+		Content: []waBinary.Node{{
+			Tag: "sync",
+			Content: []waBinary.Node{{
+				Tag: "collection",
+				Attrs: waBinary.Attrs{
+					"name":            string(patch.Type),
+					"version":         version,
+					"return_snapshot": false,
+				},
+				Content: []waBinary.Node{{
+					Tag:     "patch",
+					Content: encodedPatch,
+				}},
+			}},
+		}},
+	})
+	if errors.Is(err, ErrIQConflict) {
+		return fmt.Errorf("%w: %v", ErrAppStatePatchConflict, err)
+	} else if err != nil {
+		return fmt.Errorf("failed to send app state patch: %w", err)
+	}
+	respCollection := resp.GetChildByTag("sync", "collection")
+	if respCollection.AttrGetter().OptionalString("type") == "error" {
+		errorNode := respCollection.GetChildByTag("error")
+		if errorNode.AttrGetter().OptionalInt("code") == 409 {
+			return fmt.Errorf("%w: %s", ErrAppStatePatchConflict, respCollection.XMLString())
+		}
+		return fmt.Errorf("%w: %s", ErrAppStatePatchRejected, respCollection.XMLString())
+	}
+	return nil
+}
+
+// SetChatMuted mutes the given chat until the given time on all devices. A zero time unmutes the chat.
+//
+// To mute a chat forever, send a patch built with appstate.BuildMute with a zero duration using SendAppState.
+func (cli *Client) SetChatMuted(chat types.JID, until time.Time) error {
+	mute := !until.IsZero()
+	var duration time.Duration
+	if mute {
+		duration = until.Sub(cli.now())
+		if duration <= 0 {
+			return fmt.Errorf("mute end time must be in the future")
+		}
+	}
+	patch := appstate.BuildMute(chat, mute, duration)
+	if mute {
+		// Use the exact end time rather than adding the duration to the current time again.
+		patch.Mutations[0].Value.MuteAction.MuteEndTimestamp = proto.Int64(until.UnixMilli())
+	}
+	err := cli.SendAppState(patch)
+	if err != nil {
+		return fmt.Errorf("failed to change chat mute status: %w", err)
+	}
+	if cli.Store.ChatSettings != nil {
+		err = cli.Store.ChatSettings.PutMutedUntil(chat, until)
+		if err != nil {
+			cli.Log.Warnf("Failed to save mute status of %s in device store: %v", chat, err)
+		}
+	}
+	return nil
+}
+
+// IsChatMuted checks whether the given chat is currently muted based on the chat settings in the device store.
+func (cli *Client) IsChatMuted(chat types.JID) (bool, error) {
+	if cli.Store.ChatSettings == nil {
+		return false, fmt.Errorf("device store doesn't support chat settings")
+	}
+	settings, err := cli.Store.ChatSettings.GetChatSettings(chat)
+	if err != nil {
+		return false, fmt.Errorf("failed to get chat settings of %s: %w", chat, err)
+	}
+	return settings.IsMuted(cli.now()), nil
+}
+
+// SetChatPinned pins or unpins the given chat on all devices.
+func (cli *Client) SetChatPinned(chat types.JID, pinned bool) error {
+	err := cli.SendAppState(appstate.BuildPin(chat, pinned))
+	if err != nil {
+		return fmt.Errorf("failed to change chat pin status: %w", err)
+	}
+	if cli.Store.ChatSettings != nil {
+		err = cli.Store.ChatSettings.PutPinned(chat, pinned)
+		if err != nil {
+			cli.Log.Warnf("Failed to save pin status of %s in device store: %v", chat, err)
+		}
+	}
+	return nil
+}
+
+// SetChatArchived archives or unarchives the given chat on all devices. Archiving a chat also unpins it.
+func (cli *Client) SetChatArchived(chat types.JID, archived bool) error {
+	err := cli.SendAppState(appstate.BuildArchive(chat, archived, time.Time{}, nil))
+	if err != nil {
+		return fmt.Errorf("failed to change chat archive status: %w", err)
+	}
+	if cli.Store.ChatSettings != nil {
+		err = cli.Store.ChatSettings.PutArchived(chat, archived)
+		if err == nil && archived {
+			err = cli.Store.ChatSettings.PutPinned(chat, false)
+		}
+		if err != nil {
+			cli.Log.Warnf("Failed to save archive status of %s in device store: %v", chat, err)
+		}
+	}
+	return nil
+}
+
+// MarkChatRead marks the given chat as read or unread on all devices.
+//
+// The last messages should contain the most recent messages in the chat, but it can be empty if there are none.
+// When marking as read, read receipts are also sent for the last messages from other users. Like with MarkRead,
+// those are sent as read-self receipts if the user has disabled read receipts in the privacy settings.
+func (cli *Client) MarkChatRead(chat types.JID, read bool, lastMessages []appstate.MessageKeyInfo) error {
+	err := cli.SendAppState(appstate.BuildMarkChatAsRead(chat, read, lastMessages))
+	if err != nil {
+		return fmt.Errorf("failed to change chat read status: %w", err)
+	}
+	if !read {
+		return nil
+	}
+	idsBySender := make(map[types.JID][]types.MessageID)
+	for _, msg := range lastMessages {
+		if !msg.IsFromMe {
+			sender := msg.Sender.ToNonAD()
+			idsBySender[sender] = append(idsBySender[sender], msg.ID)
+		}
+	}
+	for sender, ids := range idsBySender {
+		err = cli.MarkRead(ids, cli.now(), chat, sender)
+		if err != nil {
+			cli.Log.Warnf("Failed to send read receipts after marking %s as read: %v", chat, err)
+		}
+	}
+	return nil
+}
+
+// DeleteChat deletes the given chat on all devices.
+//
+// The last messages in the chat can optionally be provided for the message range of the action.
+func (cli *Client) DeleteChat(chat types.JID, lastMessages ...appstate.MessageKeyInfo) error {
+	err := cli.SendAppState(appstate.BuildDeleteChat(chat, lastMessages))
+	if err != nil {
+		return fmt.Errorf("failed to delete chat: %w", err)
+	}
+	return nil
+}
+
+// ClearChat removes all messages from the given chat on all devices, but keeps the chat itself.
+// If keepStarred is true, starred messages are not removed.
+//
+// The last messages in the chat can optionally be provided for the message range of the action.
+func (cli *Client) ClearChat(chat types.JID, keepStarred bool, lastMessages ...appstate.MessageKeyInfo) error {
+	err := cli.SendAppState(appstate.BuildClearChat(chat, keepStarred, lastMessages))
+	if err != nil {
+		return fmt.Errorf("failed to clear chat: %w", err)
+	}
+	return nil
+}
+
+// MarkMessageStarred stars or unstars the given message on all devices.
+//
+// The sender is only used for messages sent by other users in group chats.
+func (cli *Client) MarkMessageStarred(chat, sender types.JID, messageID types.MessageID, fromMe, starred bool) error {
+	err := cli.SendAppState(appstate.BuildStar(chat, sender, messageID, fromMe, starred))
+	if err != nil {
+		return fmt.Errorf("failed to change message star status: %w", err)
+	}
+	return nil
+}
+
+func (cli *Client) getCachedLabel(labelID string) *types.Label {
+	if cli.Store.Labels == nil {
+		return nil
+	}
+	label, err := cli.Store.Labels.GetLabel(labelID)
+	if err != nil {
+		cli.Log.Warnf("Failed to get label %s from device store: %v", labelID, err)
+	}
+	return label
+}
+
+// sendLabelPatch sends the given label app state patch and maps rejections to ErrNotBusinessAccount,
+// as the server doesn't accept label patches from normal accounts.
+func (cli *Client) sendLabelPatch(patch appstate.PatchInfo) error {
+	err := cli.SendAppState(patch)
+	if errors.Is(err, ErrAppStatePatchRejected) || errors.Is(err, ErrIQForbidden) || errors.Is(err, ErrIQNotAcceptable) {
+		return fmt.Errorf("%w: %v", ErrNotBusinessAccount, err)
+	}
+	return err
+}
+
+// CreateLabel creates a new label with the given name and color on all devices (business accounts only).
+//
+// The returned ID can be used with SetChatLabel and SetMessageLabel. New IDs are allocated based on the labels
+// in the device store, so the app state should be synced before calling this.
+func (cli *Client) CreateLabel(name string, color int32) (string, error) {
+	if cli.Store.Labels == nil {
+		return "", fmt.Errorf("device store doesn't support labels")
+	}
+	labels, err := cli.Store.Labels.GetAllLabels()
+	if err != nil {
+		return "", fmt.Errorf("failed to get existing labels: %w", err)
+	}
+	maxID := 0
+	for _, label := range labels {
+		if id, err := strconv.Atoi(label.ID); err == nil && id > maxID {
+			maxID = id
+		}
+	}
+	labelID := strconv.Itoa(maxID + 1)
+	err = cli.EditLabel(labelID, name, color)
+	if err != nil {
+		return "", err
+	}
+	return labelID, nil
+}
+
+// EditLabel changes the name and color of the given label on all devices (business accounts only).
+//
+// If the account isn't a business account, this returns ErrNotBusinessAccount.
+func (cli *Client) EditLabel(labelID, name string, color int32) error {
+	err := cli.sendLabelPatch(appstate.BuildLabelEdit(labelID, name, color, false))
+	if err != nil {
+		return fmt.Errorf("failed to edit label: %w", err)
+	}
+	if cli.Store.Labels != nil {
+		err = cli.Store.Labels.PutLabel(types.Label{ID: labelID, Name: name, Color: color})
+		if err != nil {
+			cli.Log.Warnf("Failed to save label %s in device store: %v", labelID, err)
+		}
+	}
+	return nil
+}
+
+// DeleteLabel deletes the given label on all devices (business accounts only).
+func (cli *Client) DeleteLabel(labelID string) error {
+	var name string
+	var color int32
+	if label := cli.getCachedLabel(labelID); label != nil {
+		name, color = label.Name, label.Color
+	}
+	err := cli.sendLabelPatch(appstate.BuildLabelEdit(labelID, name, color, true))
+	if err != nil {
+		return fmt.Errorf("failed to delete label: %w", err)
+	}
+	if cli.Store.Labels != nil {
+		err = cli.Store.Labels.DeleteLabel(labelID)
+		if err != nil {
+			cli.Log.Warnf("Failed to delete label %s from device store: %v", labelID, err)
+		}
+	}
+	return nil
+}
+
+// SetChatLabel adds or removes the given label on a chat (business accounts only).
+func (cli *Client) SetChatLabel(chat types.JID, labelID string, labeled bool) error {
+	err := cli.sendLabelPatch(appstate.BuildLabelChat(chat, labelID, labeled))
+	if err != nil {
+		return fmt.Errorf("failed to change chat label: %w", err)
+	}
+	return nil
+}
+
+// SetMessageLabel adds or removes the given label on a message (business accounts only).
+func (cli *Client) SetMessageLabel(chat types.JID, labelID string, messageID types.MessageID, labeled bool) error {
+	err := cli.sendLabelPatch(appstate.BuildLabelMessage(chat, labelID, messageID, labeled))
+	if err != nil {
+		return fmt.Errorf("failed to change message label: %w", err)
+	}
+	return nil
 }
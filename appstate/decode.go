@@ -24,11 +24,48 @@ type PatchList struct {
 	Name           WAPatchName
 	HasMorePatches bool
 	Patches        []*waProto.SyncdPatch
+	Snapshot       *waProto.SyncdSnapshot
+	// SnapshotRef is the reference to the external snapshot blob. It's only set by ParsePatchListLazySnapshot,
+	// in which case Snapshot is nil and the snapshot should be decoded with Processor.DecodeSnapshotStream.
+	SnapshotRef *waProto.ExternalBlobReference
 }
 
-func ParsePatchList(node *waBinary.Node) (*PatchList, error) {
-	collection := node.GetChildByTag("sync", "collection")
-	ag := collection.AttrGetter()
+// DownloadExternalFunc is a function that can download a blob of external app state patches.
+type DownloadExternalFunc func(*waProto.ExternalBlobReference) ([]byte, error)
+
+func parseSnapshotRef(collection *waBinary.Node) (*waProto.ExternalBlobReference, error) {
+	snapshotNode := collection.GetChildByTag("snapshot")
+	rawSnapshot, ok := snapshotNode.Content.([]byte)
+	if snapshotNode.Tag != "snapshot" || !ok {
+		return nil, nil
+	}
+	var snapshot waProto.ExternalBlobReference
+	err := proto.Unmarshal(rawSnapshot, &snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal snapshot reference: %w", err)
+	}
+	return &snapshot, nil
+}
+
+func parseSnapshotInternal(collection *waBinary.Node, downloadExternal DownloadExternalFunc) (*waProto.SyncdSnapshot, error) {
+	snapshot, err := parseSnapshotRef(collection)
+	if err != nil || snapshot == nil {
+		return nil, err
+	}
+	var rawData []byte
+	rawData, err = downloadExternal(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download snapshot: %w", err)
+	}
+	var downloaded waProto.SyncdSnapshot
+	err = proto.Unmarshal(rawData, &downloaded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal downloaded snapshot: %w", err)
+	}
+	return &downloaded, nil
+}
+
+func parsePatchListInternal(collection *waBinary.Node, downloadExternal DownloadExternalFunc) ([]*waProto.SyncdPatch, error) {
 	patchesNode := collection.GetChildByTag("patches")
 	patchNodes := patchesNode.GetChildren()
 	patches := make([]*waProto.SyncdPatch, 0, len(patchNodes))
@@ -42,12 +79,72 @@ func ParsePatchList(node *waBinary.Node) (*PatchList, error) {
 		if err != nil {
 			return nil, fmt.Errorf("failed to unmarshal patch #%d: %w", i+1, err)
 		}
+		if patch.GetExternalMutations() != nil && downloadExternal != nil {
+			var rawData []byte
+			rawData, err = downloadExternal(patch.GetExternalMutations())
+			if err != nil {
+				return nil, fmt.Errorf("failed to download external mutations of patch #%d: %w", i+1, err)
+			}
+			var downloaded waProto.SyncdMutations
+			err = proto.Unmarshal(rawData, &downloaded)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal external mutations of patch #%d: %w", i+1, err)
+			}
+			patch.Mutations = downloaded.GetMutations()
+		}
 		patches = append(patches, &patch)
 	}
+	return patches, nil
+}
+
+// ParsePatchList will decode an XML node containing app state patches.
+//
+// Snapshots and external mutations are not downloaded. Use ParsePatchListWithDownloads to get them too.
+func ParsePatchList(node *waBinary.Node) (*PatchList, error) {
+	return parsePatchList(node, nil, false)
+}
+
+// ParsePatchListWithDownloads will decode an XML node containing app state patches,
+// including downloading any external blobs.
+//
+// The download function may be nil if the response is known not to contain a snapshot or external mutations.
+func ParsePatchListWithDownloads(node *waBinary.Node, downloadExternal DownloadExternalFunc) (*PatchList, error) {
+	return parsePatchList(node, downloadExternal, false)
+}
+
+// ParsePatchListLazySnapshot is like ParsePatchListWithDownloads, but it doesn't download the snapshot.
+// Instead, the reference to it is stored in the SnapshotRef field of the returned list.
+//
+// This is meant for large snapshots, which can be streamed into Processor.DecodeSnapshotStream
+// instead of being held in memory all at once.
+func ParsePatchListLazySnapshot(node *waBinary.Node, downloadExternal DownloadExternalFunc) (*PatchList, error) {
+	return parsePatchList(node, downloadExternal, true)
+}
+
+func parsePatchList(node *waBinary.Node, downloadExternal DownloadExternalFunc, lazySnapshot bool) (*PatchList, error) {
+	collection := node.GetChildByTag("sync", "collection")
+	ag := collection.AttrGetter()
+	var snapshot *waProto.SyncdSnapshot
+	var snapshotRef *waProto.ExternalBlobReference
+	var err error
+	if lazySnapshot {
+		snapshotRef, err = parseSnapshotRef(&collection)
+	} else if downloadExternal != nil {
+		snapshot, err = parseSnapshotInternal(&collection, downloadExternal)
+	}
+	if err != nil {
+		return nil, err
+	}
+	patches, err := parsePatchListInternal(&collection, downloadExternal)
+	if err != nil {
+		return nil, err
+	}
 	list := &PatchList{
 		Name:           WAPatchName(ag.String("name")),
 		HasMorePatches: ag.OptionalBool("has_more_patches"),
 		Patches:        patches,
+		Snapshot:       snapshot,
+		SnapshotRef:    snapshotRef,
 	}
 	return list, ag.Error()
 }
@@ -58,12 +155,12 @@ type patchOutput struct {
 	Mutations   []Mutation
 }
 
-func (proc *Processor) decodePatch(patch *waProto.SyncdPatch, out *patchOutput, validateMACs bool) error {
-	for i, mutation := range patch.Mutations {
+func (proc *Processor) decodeMutations(mutations []*waProto.SyncdMutation, out *patchOutput, validateMACs bool) error {
+	for i, mutation := range mutations {
 		keyID := mutation.GetRecord().GetKeyId().GetId()
 		keys, err := proc.getAppStateKey(keyID)
 		if err != nil {
-			return fmt.Errorf("failed to get key %X to decode mutation", keyID)
+			return fmt.Errorf("failed to get key %X to decode mutation: %w", keyID, err)
 		}
 		content := mutation.GetRecord().GetValue().GetBlob()
 		content, valueMAC := content[:len(content)-32], content[len(content)-32:]
@@ -114,14 +211,84 @@ func (proc *Processor) decodePatch(patch *waProto.SyncdPatch, out *patchOutput,
 	return nil
 }
 
+func (proc *Processor) decodeSnapshot(name WAPatchName, ss *waProto.SyncdSnapshot, validateMACs bool, out *patchOutput) (currentState HashState, err error) {
+	currentState.Version = ss.GetVersion().GetVersion()
+
+	encryptedMutations := make([]*waProto.SyncdMutation, len(ss.GetRecords()))
+	for i, record := range ss.GetRecords() {
+		encryptedMutations[i] = &waProto.SyncdMutation{
+			Operation: waProto.SyncdMutation_SET.Enum(),
+			Record:    record,
+		}
+	}
+	// Snapshots only contain SET operations on top of an empty state, so there are no previous values to remove.
+	err = currentState.updateHash(&waProto.SyncdPatch{Mutations: encryptedMutations}, func(indexMAC []byte, maxIndex int) ([]byte, error) {
+		return nil, nil
+	})
+	if err != nil {
+		err = fmt.Errorf("failed to update state hash: %w", err)
+		return
+	}
+
+	if validateMACs {
+		var keys ExpandedAppStateKeys
+		keys, err = proc.getAppStateKey(ss.GetKeyId().GetId())
+		if err != nil {
+			err = fmt.Errorf("failed to get key %X to verify snapshot v%d MACs: %w", ss.GetKeyId().GetId(), currentState.Version, err)
+			return
+		}
+		snapshotMAC := currentState.generateSnapshotMAC(name, keys.SnapshotMAC)
+		if !bytes.Equal(snapshotMAC, ss.GetMac()) {
+			err = fmt.Errorf("failed to verify snapshot v%d: %w", currentState.Version, &HashMismatchError{
+				Name:     name,
+				Version:  currentState.Version,
+				Expected: ss.GetMac(),
+				Got:      snapshotMAC,
+			})
+			return
+		}
+	}
+
+	err = proc.decodeMutations(encryptedMutations, out, validateMACs)
+	if err != nil {
+		err = fmt.Errorf("failed to decode snapshot of v%d: %w", currentState.Version, err)
+		return
+	}
+	// The MACs are stored before the version, so that a failure here makes the next fetch start from scratch again.
+	err = proc.Store.AppState.PutAppStateMutationMACs(string(name), currentState.Version, out.AddedMACs)
+	if err != nil {
+		err = fmt.Errorf("failed to save mutation MACs of snapshot: %w", err)
+		return
+	}
+	err = proc.Store.AppState.PutAppStateVersion(string(name), currentState.Version, currentState.Hash)
+	if err != nil {
+		err = fmt.Errorf("failed to save app state version after snapshot: %w", err)
+		return
+	}
+	return
+}
+
 func (proc *Processor) DecodePatches(list *PatchList, initialState HashState, validateMACs bool) (newMutations []Mutation, currentState HashState, err error) {
 	currentState = initialState
 	var expectedLength int
+	if list.Snapshot != nil {
+		expectedLength = len(list.Snapshot.GetRecords())
+	}
 	for _, patch := range list.Patches {
 		expectedLength += len(patch.GetMutations())
 	}
 	newMutations = make([]Mutation, 0, expectedLength)
 
+	if list.Snapshot != nil {
+		var out patchOutput
+		out.Mutations = newMutations
+		currentState, err = proc.decodeSnapshot(list.Name, list.Snapshot, validateMACs, &out)
+		if err != nil {
+			return
+		}
+		newMutations = out.Mutations
+	}
+
 	for _, patch := range list.Patches {
 		version := patch.GetVersion().GetVersion()
 		currentState.Version = version
@@ -144,15 +311,20 @@ func (proc *Processor) DecodePatches(list *PatchList, initialState HashState, va
 			var keys ExpandedAppStateKeys
 			keys, err = proc.getAppStateKey(patch.GetKeyId().GetId())
 			if err != nil {
-				err = fmt.Errorf("failed to get key %X to verify patch v%d MACs", patch.GetKeyId().GetId(), version)
+				err = fmt.Errorf("failed to get key %X to verify patch v%d MACs: %w", patch.GetKeyId().GetId(), version, err)
 				return
 			}
 			snapshotMAC := currentState.generateSnapshotMAC(list.Name, keys.SnapshotMAC)
 			if !bytes.Equal(snapshotMAC, patch.GetSnapshotMac()) {
-				err = fmt.Errorf("failed to verify patch v%d: %w", version, ErrMismatchingLTHash)
+				err = fmt.Errorf("failed to verify patch v%d: %w", version, &HashMismatchError{
+					Name:     list.Name,
+					Version:  version,
+					Expected: patch.GetSnapshotMac(),
+					Got:      snapshotMAC,
+				})
 				return
 			}
-			patchMAC := generatePatchMAC(patch, list.Name, keys.PatchMAC)
+			patchMAC := generatePatchMAC(patch, list.Name, keys.PatchMAC, patch.GetVersion().GetVersion())
 			if !bytes.Equal(patchMAC, patch.GetPatchMac()) {
 				err = fmt.Errorf("failed to verify patch v%d: %w", version, ErrMismatchingPatchMAC)
 				return
@@ -161,7 +333,7 @@ func (proc *Processor) DecodePatches(list *PatchList, initialState HashState, va
 
 		var out patchOutput
 		out.Mutations = newMutations
-		err = proc.decodePatch(patch, &out, validateMACs)
+		err = proc.decodeMutations(patch.GetMutations(), &out, validateMACs)
 		if err != nil {
 			err = fmt.Errorf("failed to decode patch v%d: %w", version, err)
 			return
@@ -0,0 +1,396 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package appstate
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/util/cbcutil"
+)
+
+// MutationInfo contains information about a single mutation to the app state.
+type MutationInfo struct {
+	// Index contains the thing being mutated (like `mute` or `pin_v1`), followed by parameters like the target JID.
+	Index []string
+	// Version is a static number that depends on the thing being mutated.
+	Version int32
+	// Value contains the data for the mutation.
+	Value *waProto.SyncActionValue
+}
+
+// PatchInfo contains information about a patch to the app state.
+// A patch can contain multiple mutations, as long as all mutations are in the same app state type.
+type PatchInfo struct {
+	// Timestamp is the time when the patch was created. This will be filled automatically in EncodePatch if it's zero.
+	Timestamp time.Time
+	// Type is the app state type being mutated.
+	Type WAPatchName
+	// Mutations contains the individual mutations to apply to the app state in this patch.
+	Mutations []MutationInfo
+}
+
+// BuildSettingPushName builds an app state patch for setting the push name.
+func BuildSettingPushName(pushName string) PatchInfo {
+	return PatchInfo{
+		Type: WAPatchCriticalBlock,
+		Mutations: []MutationInfo{{
+			Index:   []string{"setting_pushName"},
+			Version: 1,
+			Value: &waProto.SyncActionValue{
+				PushNameSetting: &waProto.PushNameSetting{
+					Name: proto.String(pushName),
+				},
+			},
+		}},
+	}
+}
+
+// BuildMute builds an app state patch for muting or unmuting a chat.
+//
+// If mute is true and the mute duration is zero, the chat is muted forever.
+func BuildMute(target types.JID, mute bool, muteDuration time.Duration) PatchInfo {
+	var muteEndTimestamp *int64
+	if mute {
+		if muteDuration > 0 {
+			muteEndTimestamp = proto.Int64(time.Now().Add(muteDuration).UnixNano() / int64(time.Millisecond))
+		} else {
+			muteEndTimestamp = proto.Int64(-1)
+		}
+	}
+	return PatchInfo{
+		Type: WAPatchRegularHigh,
+		Mutations: []MutationInfo{{
+			Index:   []string{"mute", target.String()},
+			Version: 2,
+			Value: &waProto.SyncActionValue{
+				MuteAction: &waProto.MuteAction{
+					Muted:            proto.Bool(mute),
+					MuteEndTimestamp: muteEndTimestamp,
+				},
+			},
+		}},
+	}
+}
+
+func newPinMutationInfo(target types.JID, pin bool) MutationInfo {
+	return MutationInfo{
+		Index:   []string{"pin_v1", target.String()},
+		Version: 5,
+		Value: &waProto.SyncActionValue{
+			PinAction: &waProto.PinAction{
+				Pinned: proto.Bool(pin),
+			},
+		},
+	}
+}
+
+// BuildPin builds an app state patch for pinning or unpinning a chat.
+func BuildPin(target types.JID, pin bool) PatchInfo {
+	return PatchInfo{
+		Type:      WAPatchRegularLow,
+		Mutations: []MutationInfo{newPinMutationInfo(target, pin)},
+	}
+}
+
+// MessageKeyInfo identifies a message in a chat for the message ranges included in some app state actions.
+type MessageKeyInfo struct {
+	ID        types.MessageID
+	Sender    types.JID // The sender of the message. Only needed for messages sent by other users in group chats.
+	IsFromMe  bool
+	Timestamp time.Time
+}
+
+// newMessageRange builds the message range of a chat action based on the given last messages in the chat.
+// If there are no messages, the range only contains the current time as the last message timestamp.
+func newMessageRange(target types.JID, lastMessages []MessageKeyInfo) *waProto.SyncActionMessageRange {
+	var lastMessageTimestamp time.Time
+	messages := make([]*waProto.SyncActionMessage, len(lastMessages))
+	for i, msg := range lastMessages {
+		key := &waProto.MessageKey{
+			RemoteJid: proto.String(target.String()),
+			FromMe:    proto.Bool(msg.IsFromMe),
+			Id:        proto.String(msg.ID),
+		}
+		if target.Server == types.GroupServer && !msg.IsFromMe {
+			key.Participant = proto.String(msg.Sender.ToNonAD().String())
+		}
+		messages[i] = &waProto.SyncActionMessage{
+			Key:       key,
+			Timestamp: proto.Int64(msg.Timestamp.Unix()),
+		}
+		if msg.Timestamp.After(lastMessageTimestamp) {
+			lastMessageTimestamp = msg.Timestamp
+		}
+	}
+	if lastMessageTimestamp.IsZero() {
+		lastMessageTimestamp = time.Now()
+	}
+	return &waProto.SyncActionMessageRange{
+		LastMessageTimestamp: proto.Int64(lastMessageTimestamp.Unix()),
+		Messages:             messages,
+	}
+}
+
+// BuildMarkChatAsRead builds an app state patch for marking a whole chat as read or unread.
+//
+// The last messages are the most recent messages in the chat, which are used to tell other devices
+// which messages the marker applies to. It can be empty if the chat doesn't have any known messages.
+func BuildMarkChatAsRead(target types.JID, read bool, lastMessages []MessageKeyInfo) PatchInfo {
+	return PatchInfo{
+		Type: WAPatchRegularLow,
+		Mutations: []MutationInfo{{
+			Index:   []string{"markChatAsRead", target.String()},
+			Version: 3,
+			Value: &waProto.SyncActionValue{
+				MarkChatAsReadAction: &waProto.MarkChatAsReadAction{
+					Read:         proto.Bool(read),
+					MessageRange: newMessageRange(target, lastMessages),
+				},
+			},
+		}},
+	}
+}
+
+// BuildDeleteChat builds an app state patch for deleting a chat, including any media in it.
+//
+// The last messages are used for the message range like in BuildMarkChatAsRead and can be empty.
+func BuildDeleteChat(target types.JID, lastMessages []MessageKeyInfo) PatchInfo {
+	return PatchInfo{
+		Type: WAPatchRegularHigh,
+		Mutations: []MutationInfo{{
+			Index:   []string{"deleteChat", target.String(), "1"},
+			Version: 6,
+			Value: &waProto.SyncActionValue{
+				DeleteChatAction: &waProto.DeleteChatAction{
+					MessageRange: newMessageRange(target, lastMessages),
+				},
+			},
+		}},
+	}
+}
+
+// BuildClearChat builds an app state patch for clearing all messages in a chat without deleting the chat itself.
+//
+// The last messages are used for the message range like in BuildMarkChatAsRead and can be empty.
+func BuildClearChat(target types.JID, keepStarred bool, lastMessages []MessageKeyInfo) PatchInfo {
+	deleteStarred := "1"
+	if keepStarred {
+		deleteStarred = "0"
+	}
+	return PatchInfo{
+		Type: WAPatchRegularHigh,
+		Mutations: []MutationInfo{{
+			Index:   []string{"clearChat", target.String(), deleteStarred, "0"},
+			Version: 6,
+			Value: &waProto.SyncActionValue{
+				ClearChatAction: &waProto.ClearChatAction{
+					MessageRange: newMessageRange(target, lastMessages),
+				},
+			},
+		}},
+	}
+}
+
+// BuildArchive builds an app state patch for archiving or unarchiving a chat.
+//
+// The last message timestamp and last message key are optional and can be set to zero values (`time.Time{}` and `nil`).
+//
+// Archiving a chat will also unpin it automatically.
+func BuildArchive(target types.JID, archive bool, lastMessageTimestamp time.Time, lastMessageKey *waProto.MessageKey) PatchInfo {
+	if lastMessageTimestamp.IsZero() {
+		lastMessageTimestamp = time.Now()
+	}
+	archiveMutationInfo := MutationInfo{
+		Index:   []string{"archive", target.String()},
+		Version: 3,
+		Value: &waProto.SyncActionValue{
+			ArchiveChatAction: &waProto.ArchiveChatAction{
+				Archived: proto.Bool(archive),
+				MessageRange: &waProto.SyncActionMessageRange{
+					LastMessageTimestamp: proto.Int64(lastMessageTimestamp.Unix()),
+				},
+			},
+		},
+	}
+	if lastMessageKey != nil {
+		archiveMutationInfo.Value.ArchiveChatAction.MessageRange.Messages = []*waProto.SyncActionMessage{{
+			Key:       lastMessageKey,
+			Timestamp: proto.Int64(lastMessageTimestamp.Unix()),
+		}}
+	}
+	mutations := []MutationInfo{archiveMutationInfo}
+	if archive {
+		mutations = append(mutations, newPinMutationInfo(target, false))
+	}
+	return PatchInfo{
+		Type:      WAPatchRegularLow,
+		Mutations: mutations,
+	}
+}
+
+// BuildStar builds an app state patch for starring or unstarring a message.
+//
+// The sender is only included in the index for messages sent by other users in group chats.
+func BuildStar(target, sender types.JID, messageID types.MessageID, fromMe, starred bool) PatchInfo {
+	isFromMe := "0"
+	if fromMe {
+		isFromMe = "1"
+	}
+	targetJID, senderJID := target.String(), "0"
+	if target.Server == types.GroupServer && !fromMe {
+		senderJID = sender.ToNonAD().String()
+	}
+	return PatchInfo{
+		Type: WAPatchRegularHigh,
+		Mutations: []MutationInfo{{
+			Index:   []string{"star", targetJID, messageID, isFromMe, senderJID},
+			Version: 2,
+			Value: &waProto.SyncActionValue{
+				StarAction: &waProto.StarAction{
+					Starred: proto.Bool(starred),
+				},
+			},
+		}},
+	}
+}
+
+// BuildLabelEdit builds an app state patch for creating, editing or deleting a label (business accounts only).
+func BuildLabelEdit(labelID, name string, color int32, deleted bool) PatchInfo {
+	return PatchInfo{
+		Type: WAPatchRegular,
+		Mutations: []MutationInfo{{
+			Index:   []string{"label_edit", labelID},
+			Version: 3,
+			Value: &waProto.SyncActionValue{
+				LabelEditAction: &waProto.LabelEditAction{
+					Name:    proto.String(name),
+					Color:   proto.Int32(color),
+					Deleted: proto.Bool(deleted),
+				},
+			},
+		}},
+	}
+}
+
+// BuildLabelChat builds an app state patch for adding or removing a label on a chat (business accounts only).
+func BuildLabelChat(target types.JID, labelID string, labeled bool) PatchInfo {
+	return PatchInfo{
+		Type: WAPatchRegular,
+		Mutations: []MutationInfo{{
+			Index:   []string{"label_jid", labelID, target.String()},
+			Version: 3,
+			Value: &waProto.SyncActionValue{
+				LabelAssociationAction: &waProto.LabelAssociationAction{
+					Labeled: proto.Bool(labeled),
+				},
+			},
+		}},
+	}
+}
+
+// BuildLabelMessage builds an app state patch for adding or removing a label on a message (business accounts only).
+func BuildLabelMessage(target types.JID, labelID string, messageID types.MessageID, labeled bool) PatchInfo {
+	return PatchInfo{
+		Type: WAPatchRegular,
+		Mutations: []MutationInfo{{
+			Index:   []string{"label_message", labelID, target.String(), messageID, "0", "0"},
+			Version: 3,
+			Value: &waProto.SyncActionValue{
+				LabelAssociationAction: &waProto.LabelAssociationAction{
+					Labeled: proto.Bool(labeled),
+				},
+			},
+		}},
+	}
+}
+
+// EncodePatch encrypts the given patch with the given key and returns the serialized SyncdPatch
+// that can be sent to the server. The state is the current state of the app state type in the patch.
+func (proc *Processor) EncodePatch(keyID []byte, state HashState, patchInfo PatchInfo) ([]byte, error) {
+	keys, err := proc.getAppStateKey(keyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get app state key details with key ID %X: %w", keyID, err)
+	}
+
+	if patchInfo.Timestamp.IsZero() {
+		patchInfo.Timestamp = time.Now()
+	}
+
+	mutations := make([]*waProto.SyncdMutation, 0, len(patchInfo.Mutations))
+	for _, mutationInfo := range patchInfo.Mutations {
+		mutationInfo.Value.Timestamp = proto.Int64(patchInfo.Timestamp.UnixNano() / int64(time.Millisecond))
+
+		indexBytes, err := json.Marshal(mutationInfo.Index)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal mutation index: %w", err)
+		}
+
+		content, err := proto.Marshal(&waProto.SyncActionData{
+			Index:   indexBytes,
+			Value:   mutationInfo.Value,
+			Padding: []byte{},
+			Version: proto.Int32(mutationInfo.Version),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal mutation data: %w", err)
+		}
+
+		// Passing a nil IV makes cbcutil generate a random one and prepend it to the ciphertext
+		encryptedContent, err := cbcutil.Encrypt(keys.ValueEncryption, nil, content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt mutation data: %w", err)
+		}
+
+		valueMAC := generateContentMAC(waProto.SyncdMutation_SET, encryptedContent, keyID, keys.ValueMAC)
+		indexMAC := concatAndHMAC(sha256.New, keys.Index, indexBytes)
+
+		mutations = append(mutations, &waProto.SyncdMutation{
+			Operation: waProto.SyncdMutation_SET.Enum(),
+			Record: &waProto.SyncdRecord{
+				Index: &waProto.SyncdIndex{Blob: indexMAC},
+				Value: &waProto.SyncdValue{Blob: append(encryptedContent, valueMAC...)},
+				KeyId: &waProto.KeyId{Id: keyID},
+			},
+		})
+	}
+
+	patch := &waProto.SyncdPatch{
+		KeyId:     &waProto.KeyId{Id: keyID},
+		Mutations: mutations,
+	}
+	err = state.updateHash(patch, func(indexMAC []byte, maxIndex int) ([]byte, error) {
+		for i := maxIndex - 1; i >= 0; i-- {
+			if bytes.Equal(mutations[i].GetRecord().GetIndex().GetBlob(), indexMAC) {
+				value := mutations[i].GetRecord().GetValue().GetBlob()
+				return value[len(value)-32:], nil
+			}
+		}
+		// Previous value not found in current patch, look in the database
+		return proc.Store.AppState.GetAppStateMutationMAC(string(patchInfo.Type), indexMAC)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update state hash: %w", err)
+	}
+
+	state.Version++
+	patch.SnapshotMac = state.generateSnapshotMAC(patchInfo.Type, keys.SnapshotMAC)
+	patch.PatchMac = generatePatchMAC(patch, patchInfo.Type, keys.PatchMAC, state.Version)
+
+	result, err := proto.Marshal(patch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal compiled patch: %w", err)
+	}
+	return result, nil
+}
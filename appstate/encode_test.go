@@ -0,0 +1,41 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package appstate
+
+import (
+	"reflect"
+	"testing"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+func TestBuildStarIndex(t *testing.T) {
+	user := types.NewJID("1234567890", types.DefaultUserServer)
+	group := types.NewJID("123456789-987654321", types.GroupServer)
+	sender := types.NewADJID("9876543210", 0, 2)
+
+	cases := []struct {
+		chat     types.JID
+		fromMe   bool
+		expected []string
+	}{
+		{user, false, []string{"star", user.String(), "ABCD", "0", "0"}},
+		{user, true, []string{"star", user.String(), "ABCD", "1", "0"}},
+		{group, false, []string{"star", group.String(), "ABCD", "0", "9876543210@s.whatsapp.net"}},
+		{group, true, []string{"star", group.String(), "ABCD", "1", "0"}},
+	}
+	for _, c := range cases {
+		patch := BuildStar(c.chat, sender, "ABCD", c.fromMe, true)
+		index := patch.Mutations[0].Index
+		if !reflect.DeepEqual(index, c.expected) {
+			t.Errorf("Unexpected index for %s (from me: %t): %v", c.chat, c.fromMe, index)
+		}
+		if !patch.Mutations[0].Value.GetStarAction().GetStarred() {
+			t.Errorf("Expected star action to be starred")
+		}
+	}
+}
@@ -6,7 +6,10 @@
 
 package appstate
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrMissingPreviousSetValueOperation = errors.New("missing value MAC of previous SET operation")
@@ -14,4 +17,25 @@ var (
 	ErrMismatchingPatchMAC              = errors.New("mismatching patch MAC")
 	ErrMismatchingContentMAC            = errors.New("mismatching content MAC")
 	ErrMismatchingIndexMAC              = errors.New("mismatching index MAC")
+	ErrKeyNotFound                      = errors.New("didn't find app state key")
 )
+
+// HashMismatchError is returned when the LTHash calculated from the local state doesn't match the server's one,
+// which means the local state is corrupted and the app state type needs to be fully resynced.
+//
+// The LTHash itself isn't sent by the server, so the snapshot MACs derived from it are compared instead.
+// errors.Is(err, ErrMismatchingLTHash) is true for these errors.
+type HashMismatchError struct {
+	Name     WAPatchName
+	Version  uint64 // The version of the patch or snapshot that failed to verify.
+	Expected []byte // The snapshot MAC sent by the server.
+	Got      []byte // The snapshot MAC calculated from the local state.
+}
+
+func (hme *HashMismatchError) Error() string {
+	return fmt.Sprintf("%s in %s v%d (expected MAC %X, got %X)", ErrMismatchingLTHash.Error(), hme.Name, hme.Version, hme.Expected, hme.Got)
+}
+
+func (hme *HashMismatchError) Is(other error) bool {
+	return other == ErrMismatchingLTHash
+}
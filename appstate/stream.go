@@ -0,0 +1,248 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package appstate
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+)
+
+// SnapshotBatchSize is the number of records that DecodeSnapshotStream decodes and stores at a time.
+var SnapshotBatchSize = 500
+
+// maxSnapshotFieldLength is the maximum length of a single field in a streamed snapshot.
+// Individual records are small, so anything bigger than this means the data is corrupted.
+const maxSnapshotFieldLength = 16 * 1024 * 1024
+
+// Field numbers of the SyncdSnapshot protobuf message
+const (
+	snapshotFieldVersion protowire.Number = 1
+	snapshotFieldRecords protowire.Number = 2
+	snapshotFieldMAC     protowire.Number = 3
+	snapshotFieldKeyID   protowire.Number = 4
+)
+
+type snapshotReader struct {
+	r    *bufio.Reader
+	read int64
+}
+
+func (sr *snapshotReader) ReadByte() (byte, error) {
+	b, err := sr.r.ReadByte()
+	if err == nil {
+		sr.read++
+	}
+	return b, err
+}
+
+func (sr *snapshotReader) readBytes(length uint64) ([]byte, error) {
+	if length > maxSnapshotFieldLength {
+		return nil, fmt.Errorf("field too long (%d bytes)", length)
+	}
+	data := make([]byte, length)
+	n, err := io.ReadFull(sr.r, data)
+	sr.read += int64(n)
+	return data, err
+}
+
+// readField reads the next field in the protobuf stream. The data is only returned for length-delimited fields,
+// other fields are skipped. io.EOF is returned if the stream ends cleanly before the next field.
+func (sr *snapshotReader) readField() (num protowire.Number, data []byte, err error) {
+	var tag uint64
+	tag, err = binary.ReadUvarint(sr)
+	if err != nil {
+		return
+	}
+	num, typ := protowire.DecodeTag(tag)
+	switch typ {
+	case protowire.VarintType:
+		_, err = binary.ReadUvarint(sr)
+	case protowire.Fixed32Type:
+		_, err = sr.readBytes(4)
+	case protowire.Fixed64Type:
+		_, err = sr.readBytes(8)
+	case protowire.BytesType:
+		var length uint64
+		length, err = binary.ReadUvarint(sr)
+		if err == nil {
+			data, err = sr.readBytes(length)
+		}
+	default:
+		err = fmt.Errorf("unsupported wire type %d in field %d", typ, num)
+	}
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return
+}
+
+// readSnapshotStream reads a serialized SyncdSnapshot from the given reader and calls onRecord for each record.
+func readSnapshotStream(sr *snapshotReader, onRecord func(record *waProto.SyncdRecord) error) (version uint64, mac, keyID []byte, err error) {
+	recordNum := 0
+	for {
+		num, data, readErr := sr.readField()
+		if readErr == io.EOF {
+			return
+		} else if readErr != nil {
+			err = fmt.Errorf("failed to read snapshot: %w", readErr)
+			return
+		}
+		switch num {
+		case snapshotFieldVersion:
+			var parsedVersion waProto.SyncdVersion
+			if err = proto.Unmarshal(data, &parsedVersion); err != nil {
+				err = fmt.Errorf("failed to unmarshal snapshot version: %w", err)
+				return
+			}
+			version = parsedVersion.GetVersion()
+		case snapshotFieldRecords:
+			recordNum++
+			record := &waProto.SyncdRecord{}
+			if err = proto.Unmarshal(data, record); err != nil {
+				err = fmt.Errorf("failed to unmarshal snapshot record #%d: %w", recordNum, err)
+				return
+			}
+			if err = onRecord(record); err != nil {
+				return
+			}
+		case snapshotFieldMAC:
+			mac = data
+		case snapshotFieldKeyID:
+			var parsedKeyID waProto.KeyId
+			if err = proto.Unmarshal(data, &parsedKeyID); err != nil {
+				err = fmt.Errorf("failed to unmarshal snapshot key ID: %w", err)
+				return
+			}
+			keyID = parsedKeyID.GetId()
+		}
+	}
+}
+
+// DecodeSnapshotStream decodes a serialized SyncdSnapshot from the given reader without holding the whole snapshot
+// in memory.
+//
+// The snapshot MAC comes after the records, so the snapshot is read twice: first only the LTHash is calculated to
+// verify the snapshot MAC, then the records are decoded in batches of SnapshotBatchSize. For each batch, the mutation
+// MACs are stored and onBatch is called with the decoded mutations and the number of bytes read from the reader
+// so far in the second pass. Nothing is stored and onBatch is never called if the snapshot MAC doesn't match.
+//
+// If anything fails, the partially stored state is removed, so that the next fetch starts from scratch.
+func (proc *Processor) DecodeSnapshotStream(name WAPatchName, r io.ReadSeeker, validateMACs bool, onBatch func(mutations []Mutation, bytesRead int64)) (currentState HashState, err error) {
+	batch := make([]*waProto.SyncdMutation, 0, SnapshotBatchSize)
+	addRecord := func(record *waProto.SyncdRecord, flush func() error) error {
+		batch = append(batch, &waProto.SyncdMutation{
+			Operation: waProto.SyncdMutation_SET.Enum(),
+			Record:    record,
+		})
+		if len(batch) >= SnapshotBatchSize {
+			return flush()
+		}
+		return nil
+	}
+
+	updateHash := func() error {
+		// Snapshots only contain SET operations on top of an empty state, so there are no previous values to remove.
+		err := currentState.updateHash(&waProto.SyncdPatch{Mutations: batch}, func(indexMAC []byte, maxIndex int) ([]byte, error) {
+			return nil, nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update state hash: %w", err)
+		}
+		batch = batch[:0]
+		return nil
+	}
+	version, mac, keyID, err := readSnapshotStream(&snapshotReader{r: bufio.NewReader(r)}, func(record *waProto.SyncdRecord) error {
+		return addRecord(record, updateHash)
+	})
+	if err == nil {
+		err = updateHash()
+	}
+	if err != nil {
+		err = fmt.Errorf("failed to calculate hash of snapshot: %w", err)
+		return
+	}
+	currentState.Version = version
+
+	if validateMACs {
+		var keys ExpandedAppStateKeys
+		keys, err = proc.getAppStateKey(keyID)
+		if err != nil {
+			err = fmt.Errorf("failed to get key %X to verify snapshot v%d MACs: %w", keyID, currentState.Version, err)
+			return
+		}
+		snapshotMAC := currentState.generateSnapshotMAC(name, keys.SnapshotMAC)
+		if !bytes.Equal(snapshotMAC, mac) {
+			err = fmt.Errorf("failed to verify snapshot v%d: %w", currentState.Version, &HashMismatchError{
+				Name:     name,
+				Version:  currentState.Version,
+				Expected: mac,
+				Got:      snapshotMAC,
+			})
+			return
+		}
+	}
+
+	if _, err = r.Seek(0, io.SeekStart); err != nil {
+		err = fmt.Errorf("failed to rewind snapshot: %w", err)
+		return
+	}
+	defer func() {
+		if err != nil {
+			deleteErr := proc.Store.AppState.DeleteAppStateVersion(string(name))
+			if deleteErr != nil {
+				proc.Log.Warnf("Failed to remove partially stored %s snapshot: %v", name, deleteErr)
+			}
+		}
+	}()
+	sr := &snapshotReader{r: bufio.NewReader(r)}
+	recordsProcessed := 0
+	decodeBatch := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		var out patchOutput
+		err := proc.decodeMutations(batch, &out, validateMACs)
+		if err != nil {
+			return fmt.Errorf("failed to decode records %d-%d: %w", recordsProcessed+1, recordsProcessed+len(batch), err)
+		}
+		err = proc.Store.AppState.PutAppStateMutationMACs(string(name), currentState.Version, out.AddedMACs)
+		if err != nil {
+			return fmt.Errorf("failed to save mutation MACs: %w", err)
+		}
+		recordsProcessed += len(batch)
+		batch = batch[:0]
+		if onBatch != nil {
+			onBatch(out.Mutations, sr.read)
+		}
+		return nil
+	}
+	_, _, _, err = readSnapshotStream(sr, func(record *waProto.SyncdRecord) error {
+		return addRecord(record, decodeBatch)
+	})
+	if err == nil {
+		err = decodeBatch()
+	}
+	if err != nil {
+		err = fmt.Errorf("failed to decode snapshot of v%d: %w", currentState.Version, err)
+		return
+	}
+
+	err = proc.Store.AppState.PutAppStateVersion(string(name), currentState.Version, currentState.Hash)
+	if err != nil {
+		err = fmt.Errorf("failed to save app state version after snapshot: %w", err)
+		return
+	}
+	return
+}
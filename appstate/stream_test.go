@@ -0,0 +1,196 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package appstate
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+type fakeAppStateStore struct {
+	version uint64
+	hash    [128]byte
+	macs    map[string][]byte
+
+	macsStored int
+}
+
+func (fs *fakeAppStateStore) PutAppStateVersion(name string, version uint64, hash [128]byte) error {
+	fs.version, fs.hash = version, hash
+	return nil
+}
+
+func (fs *fakeAppStateStore) GetAppStateVersion(name string) (uint64, [128]byte, error) {
+	return fs.version, fs.hash, nil
+}
+
+func (fs *fakeAppStateStore) DeleteAppStateVersion(name string) error {
+	fs.version, fs.hash, fs.macs = 0, [128]byte{}, map[string][]byte{}
+	return nil
+}
+
+func (fs *fakeAppStateStore) PutAppStateMutationMACs(name string, version uint64, mutations []store.AppStateMutationMAC) error {
+	for _, mutation := range mutations {
+		fs.macs[string(mutation.IndexMAC)] = mutation.ValueMAC
+	}
+	fs.macsStored += len(mutations)
+	return nil
+}
+
+func (fs *fakeAppStateStore) DeleteAppStateMutationMACs(name string, indexMACs [][]byte) error {
+	for _, indexMAC := range indexMACs {
+		delete(fs.macs, string(indexMAC))
+	}
+	return nil
+}
+
+func (fs *fakeAppStateStore) GetAppStateMutationMAC(name string, indexMAC []byte) ([]byte, error) {
+	return fs.macs[string(indexMAC)], nil
+}
+
+func makeTestSnapshot(t *testing.T, proc *Processor, keyID []byte, contacts int) []byte {
+	patchInfo := PatchInfo{Type: WAPatchCriticalUnblockLow}
+	for i := 0; i < contacts; i++ {
+		jid := types.NewJID(string(rune('1'+i))+"234567890", types.DefaultUserServer)
+		patchInfo.Mutations = append(patchInfo.Mutations, MutationInfo{
+			Index:   []string{"contact", jid.String()},
+			Version: 2,
+			Value: &waProto.SyncActionValue{
+				ContactAction: &waProto.ContactAction{FullName: proto.String("Contact")},
+			},
+		})
+	}
+	encodedPatch, err := proc.EncodePatch(keyID, HashState{}, patchInfo)
+	if err != nil {
+		t.Fatalf("Failed to encode patch: %v", err)
+	}
+	var patch waProto.SyncdPatch
+	if err = proto.Unmarshal(encodedPatch, &patch); err != nil {
+		t.Fatalf("Failed to unmarshal encoded patch: %v", err)
+	}
+	snapshot := &waProto.SyncdSnapshot{
+		Version: &waProto.SyncdVersion{Version: proto.Uint64(5)},
+		KeyId:   &waProto.KeyId{Id: keyID},
+	}
+	state := HashState{Version: 5}
+	for _, mutation := range patch.GetMutations() {
+		snapshot.Records = append(snapshot.Records, mutation.GetRecord())
+	}
+	err = state.updateHash(&waProto.SyncdPatch{Mutations: patch.GetMutations()}, func(indexMAC []byte, maxIndex int) ([]byte, error) {
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to update hash: %v", err)
+	}
+	keys, _ := proc.getAppStateKey(keyID)
+	snapshot.Mac = state.generateSnapshotMAC(WAPatchCriticalUnblockLow, keys.SnapshotMAC)
+	data, err := proto.Marshal(snapshot)
+	if err != nil {
+		t.Fatalf("Failed to marshal snapshot: %v", err)
+	}
+	return data
+}
+
+func newTestProcessor() (*Processor, *fakeAppStateStore, []byte) {
+	appState := &fakeAppStateStore{macs: map[string][]byte{}}
+	proc := NewProcessor(&store.Device{AppState: appState}, waLog.Noop)
+	keyID := []byte("test key")
+	proc.keyCache[base64.RawStdEncoding.EncodeToString(keyID)] = expandAppStateKeys(bytes.Repeat([]byte{1}, 32))
+	return proc, appState, keyID
+}
+
+func TestDecodeSnapshotStream(t *testing.T) {
+	proc, appState, keyID := newTestProcessor()
+	data := makeTestSnapshot(t, proc, keyID, 5)
+
+	defer func(size int) { SnapshotBatchSize = size }(SnapshotBatchSize)
+	SnapshotBatchSize = 2
+	var batchSizes []int
+	var mutations []Mutation
+	var lastBytesRead int64
+	state, err := proc.DecodeSnapshotStream(WAPatchCriticalUnblockLow, bytes.NewReader(data), true, func(batch []Mutation, bytesRead int64) {
+		batchSizes = append(batchSizes, len(batch))
+		mutations = append(mutations, batch...)
+		lastBytesRead = bytesRead
+	})
+	if err != nil {
+		t.Fatalf("Failed to decode snapshot: %v", err)
+	}
+	if len(batchSizes) != 3 || batchSizes[0] != 2 || batchSizes[2] != 1 {
+		t.Errorf("Unexpected batch sizes %v", batchSizes)
+	}
+	if len(mutations) != 5 || mutations[0].Action.GetContactAction().GetFullName() != "Contact" {
+		t.Errorf("Unexpected mutations %+v", mutations)
+	}
+	if lastBytesRead > int64(len(data)) || lastBytesRead == 0 {
+		t.Errorf("Unexpected number of bytes read %d (snapshot is %d bytes)", lastBytesRead, len(data))
+	}
+	if state.Version != 5 || appState.version != 5 || appState.hash != state.Hash {
+		t.Errorf("Unexpected state after snapshot: v%d (stored v%d)", state.Version, appState.version)
+	}
+	if len(appState.macs) != 5 {
+		t.Errorf("Expected 5 stored mutation MACs, got %d", len(appState.macs))
+	}
+}
+
+func TestDecodeSnapshotStreamMismatchingMAC(t *testing.T) {
+	proc, appState, keyID := newTestProcessor()
+	data := makeTestSnapshot(t, proc, keyID, 3)
+	var snapshot waProto.SyncdSnapshot
+	_ = proto.Unmarshal(data, &snapshot)
+	snapshot.Mac[0] ^= 0xff
+	data, _ = proto.Marshal(&snapshot)
+
+	batches := 0
+	_, err := proc.DecodeSnapshotStream(WAPatchCriticalUnblockLow, bytes.NewReader(data), true, func([]Mutation, int64) {
+		batches++
+	})
+	if !errors.Is(err, ErrMismatchingLTHash) {
+		t.Fatalf("Expected ErrMismatchingLTHash, got %v", err)
+	}
+	var mismatch *HashMismatchError
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("Expected HashMismatchError, got %T", err)
+	} else if mismatch.Name != WAPatchCriticalUnblockLow || mismatch.Version != 5 || !bytes.Equal(mismatch.Expected, snapshot.Mac) || bytes.Equal(mismatch.Got, snapshot.Mac) {
+		t.Errorf("Unexpected mismatch error details %+v", mismatch)
+	}
+	if batches != 0 {
+		t.Errorf("Expected no mutations to be emitted from an unverified snapshot, got %d batches", batches)
+	}
+	if appState.macsStored != 0 || len(appState.macs) != 0 || appState.version != 0 {
+		t.Errorf("Expected nothing to be stored from an unverified snapshot")
+	}
+}
+
+func TestDecodeSnapshotStreamBadRecordRemovesState(t *testing.T) {
+	proc, appState, keyID := newTestProcessor()
+	data := makeTestSnapshot(t, proc, keyID, 3)
+	var snapshot waProto.SyncdSnapshot
+	_ = proto.Unmarshal(data, &snapshot)
+	// The index MAC isn't covered by the snapshot MAC, so this is only noticed when the last record is decoded.
+	snapshot.Records[2].Index.Blob[0] ^= 0xff
+	data, _ = proto.Marshal(&snapshot)
+
+	defer func(size int) { SnapshotBatchSize = size }(SnapshotBatchSize)
+	SnapshotBatchSize = 2
+	_, err := proc.DecodeSnapshotStream(WAPatchCriticalUnblockLow, bytes.NewReader(data), true, nil)
+	if !errors.Is(err, ErrMismatchingIndexMAC) {
+		t.Fatalf("Expected ErrMismatchingIndexMAC, got %v", err)
+	}
+	if appState.macsStored != 2 || len(appState.macs) != 0 || appState.version != 0 {
+		t.Errorf("Expected partially stored snapshot to be removed")
+	}
+}
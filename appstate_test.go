@@ -0,0 +1,140 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.mau.fi/whatsmeow/appstate"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+type mutedUntilRecorder map[types.JID]time.Time
+
+func (mur mutedUntilRecorder) PutMutedUntil(chat types.JID, mutedUntil time.Time) error {
+	mur[chat] = mutedUntil
+	return nil
+}
+func (mur mutedUntilRecorder) PutPinned(types.JID, bool) error   { return nil }
+func (mur mutedUntilRecorder) PutArchived(types.JID, bool) error { return nil }
+func (mur mutedUntilRecorder) GetChatSettings(chat types.JID) (types.LocalChatSettings, error) {
+	return types.LocalChatSettings{MutedUntil: mur[chat]}, nil
+}
+
+func TestAppStateMillisecondTimestamps(t *testing.T) {
+	settings := mutedUntilRecorder{}
+	cli := NewClient(&store.Device{ChatSettings: settings}, waLog.Noop)
+	var mute *events.Mute
+	cli.AddEventHandler(func(evt interface{}) {
+		if m, ok := evt.(*events.Mute); ok {
+			mute = m
+		}
+	})
+	chat := types.NewJID("1234567890", types.DefaultUserServer)
+	mutedAt := time.UnixMilli(1700000000123)
+	mutedUntil := mutedAt.Add(8 * time.Hour)
+	cli.dispatchAppState(appstate.Mutation{
+		Operation: waProto.SyncdMutation_SET,
+		Index:     []string{"mute", chat.String()},
+		Action: &waProto.SyncActionValue{
+			Timestamp: proto.Int64(mutedAt.UnixMilli()),
+			MuteAction: &waProto.MuteAction{
+				Muted:            proto.Bool(true),
+				MuteEndTimestamp: proto.Int64(mutedUntil.UnixMilli()),
+			},
+		},
+	}, false, false)
+	if mute == nil || !mute.Timestamp.Equal(mutedAt) {
+		t.Errorf("Expected mute event with timestamp %s, got %+v", mutedAt, mute)
+	}
+	if !settings[chat].Equal(mutedUntil) {
+		t.Errorf("Expected chat to be muted until %s, got %s", mutedUntil, settings[chat])
+	}
+}
+
+type fakeChatSettingsStore struct {
+	settings map[types.JID]types.LocalChatSettings
+}
+
+func (fs *fakeChatSettingsStore) PutMutedUntil(chat types.JID, mutedUntil time.Time) error {
+	settings := fs.settings[chat]
+	settings.Found, settings.MutedUntil = true, mutedUntil
+	fs.settings[chat] = settings
+	return nil
+}
+
+func (fs *fakeChatSettingsStore) PutPinned(chat types.JID, pinned bool) error     { return nil }
+func (fs *fakeChatSettingsStore) PutArchived(chat types.JID, archived bool) error { return nil }
+func (fs *fakeChatSettingsStore) GetChatSettings(chat types.JID) (types.LocalChatSettings, error) {
+	return fs.settings[chat], nil
+}
+
+func TestChatMuteExpiry(t *testing.T) {
+	now := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	chatSettings := &fakeChatSettingsStore{settings: make(map[types.JID]types.LocalChatSettings)}
+	cli := &Client{
+		Store:   &store.Device{ChatSettings: chatSettings},
+		Log:     waLog.Noop,
+		nowFunc: func() time.Time { return now },
+	}
+	chat := types.NewJID("1234", types.DefaultUserServer)
+	forever := types.NewJID("5678", types.DefaultUserServer)
+	cli.dispatchAppState(appstate.Mutation{
+		Operation: waProto.SyncdMutation_SET,
+		Index:     []string{"mute", chat.String()},
+		Action: &waProto.SyncActionValue{MuteAction: &waProto.MuteAction{
+			Muted:            proto.Bool(true),
+			MuteEndTimestamp: proto.Int64(now.Add(time.Hour).UnixMilli()),
+		}},
+	}, false, false)
+	cli.dispatchAppState(appstate.Mutation{
+		Operation: waProto.SyncdMutation_SET,
+		Index:     []string{"mute", forever.String()},
+		Action: &waProto.SyncActionValue{MuteAction: &waProto.MuteAction{
+			Muted:            proto.Bool(true),
+			MuteEndTimestamp: proto.Int64(-1),
+		}},
+	}, false, false)
+
+	for _, step := range []struct {
+		offset       time.Duration
+		chatMuted    bool
+		foreverMuted bool
+	}{{0, true, true}, {time.Hour - time.Second, true, true}, {time.Hour, false, true}, {365 * 24 * time.Hour, false, true}} {
+		now = time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC).Add(step.offset)
+		if muted, _ := cli.IsChatMuted(chat); muted != step.chatMuted {
+			t.Errorf("Expected chat muted=%t after %s, got %t", step.chatMuted, step.offset, muted)
+		}
+		if muted, _ := cli.IsChatMuted(forever); muted != step.foreverMuted {
+			t.Errorf("Expected chat muted forever=%t after %s, got %t", step.foreverMuted, step.offset, muted)
+		}
+	}
+}
+
+func TestAppStateResyncOncePerConnection(t *testing.T) {
+	cli := &Client{}
+	if !cli.markAppStateResync(appstate.WAPatchRegular) {
+		t.Error("Expected first resync to be allowed")
+	}
+	if cli.markAppStateResync(appstate.WAPatchRegular) {
+		t.Error("Expected second resync on the same connection to be refused")
+	}
+	if !cli.markAppStateResync(appstate.WAPatchCriticalBlock) {
+		t.Error("Expected resync of a different app state type to be allowed")
+	}
+	cli.resetAppStateResyncs()
+	if !cli.markAppStateResync(appstate.WAPatchRegular) {
+		t.Error("Expected resync to be allowed again after reconnecting")
+	}
+}
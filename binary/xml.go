@@ -23,12 +23,22 @@ var (
 
 // XMLString converts the Node to its XML representation
 func (n *Node) XMLString() string {
-	content := n.contentString()
+	return n.xmlString(IndentXML)
+}
+
+// IndentedXMLString converts the Node to its XML representation with indentation,
+// regardless of the IndentXML option.
+func (n *Node) IndentedXMLString() string {
+	return n.xmlString(true)
+}
+
+func (n *Node) xmlString(indent bool) string {
+	content := n.contentString(indent)
 	if len(content) == 0 {
 		return fmt.Sprintf("<%[1]s%[2]s/>", n.Tag, n.attributeString())
 	}
 	newline := "\n"
-	if len(content) == 1 || !IndentXML {
+	if len(content) == 1 || !indent {
 		newline = ""
 	}
 	return fmt.Sprintf("<%[1]s%[2]s>%[4]s%[3]s%[4]s</%[1]s>", n.Tag, n.attributeString(), strings.Join(content, newline), newline)
@@ -61,23 +71,23 @@ func printable(data []byte) string {
 	return str
 }
 
-func (n *Node) contentString() []string {
+func (n *Node) contentString(indent bool) []string {
 	split := make([]string, 0)
 	switch content := n.Content.(type) {
 	case []Node:
 		for _, item := range content {
-			split = append(split, strings.Split(item.XMLString(), "\n")...)
+			split = append(split, strings.Split(item.xmlString(indent), "\n")...)
 		}
 	case []byte:
 		if strContent := printable(content); len(strContent) > 0 {
-			if IndentXML {
+			if indent {
 				split = append(split, strings.Split(string(content), "\n")...)
 			} else {
 				split = append(split, strings.ReplaceAll(string(content), "\n", "\\n"))
 			}
 		} else if len(content) > MaxBytesToPrintAsHex {
 			split = append(split, fmt.Sprintf("<!-- %d bytes -->", len(content)))
-		} else if !IndentXML {
+		} else if !indent {
 			split = append(split, hex.EncodeToString(content))
 		} else {
 			hexData := hex.EncodeToString(content)
@@ -93,13 +103,13 @@ func (n *Node) contentString() []string {
 		// don't append anything
 	default:
 		strContent := fmt.Sprintf("%s", content)
-		if IndentXML {
+		if indent {
 			split = append(split, strings.Split(strContent, "\n")...)
 		} else {
 			split = append(split, strings.ReplaceAll(strContent, "\n", "\\n"))
 		}
 	}
-	if len(split) > 1 && IndentXML {
+	if len(split) > 1 && indent {
 		for i, line := range split {
 			split[i] = "  " + line
 		}
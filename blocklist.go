@@ -0,0 +1,104 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"fmt"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// BlocklistAction is the action to take in UpdateBlocklist.
+type BlocklistAction = events.BlocklistChangeAction
+
+// The possible actions for UpdateBlocklist.
+const (
+	Block   = events.BlocklistChangeActionBlock
+	Unblock = events.BlocklistChangeActionUnblock
+)
+
+// GetBlocklist gets the list of users that the current user has blocked.
+func (cli *Client) GetBlocklist() (*types.Blocklist, error) {
+	resp, err := cli.sendIQ(infoQuery{
+		Namespace: "blocklist",
+		Type:      "get",
+		To:        types.ServerJID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to request blocklist: %w", err)
+	}
+	list, ok := resp.GetOptionalChildByTag("list")
+	if !ok {
+		return nil, fmt.Errorf("blocklist response didn't contain list element")
+	}
+	return parseBlocklist(&list), nil
+}
+
+// UpdateBlocklist blocks or unblocks the given user and returns the updated blocklist.
+func (cli *Client) UpdateBlocklist(jid types.JID, action BlocklistAction) (*types.Blocklist, error) {
+	resp, err := cli.sendIQ(infoQuery{
+		Namespace: "blocklist",
+		Type:      "set",
+		To:        types.ServerJID,
+		Content: []waBinary.Node{{
+			Tag: "item",
+			Attrs: waBinary.Attrs{
+				"jid":    jid.ToNonAD(),
+				"action": string(action),
+			},
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update blocklist: %w", err)
+	}
+	list, ok := resp.GetOptionalChildByTag("list")
+	if !ok {
+		return nil, fmt.Errorf("blocklist update response didn't contain list element")
+	}
+	return parseBlocklist(&list), nil
+}
+
+func parseBlocklist(node *waBinary.Node) *types.Blocklist {
+	output := &types.Blocklist{
+		DHash: node.AttrGetter().OptionalString("dhash"),
+	}
+	for _, child := range node.GetChildren() {
+		jid, ok := child.Attrs["jid"].(types.JID)
+		if child.Tag != "item" || !ok {
+			continue
+		}
+		output.JIDs = append(output.JIDs, jid)
+	}
+	return output
+}
+
+func (cli *Client) handleBlocklist(node *waBinary.Node) {
+	ag := node.AttrGetter()
+	evt := events.Blocklist{
+		Action:    events.BlocklistAction(ag.OptionalString("action")),
+		DHash:     ag.OptionalString("dhash"),
+		PrevDHash: ag.OptionalString("prev_dhash"),
+	}
+	for _, child := range node.GetChildren() {
+		if child.Tag != "item" {
+			continue
+		}
+		cag := child.AttrGetter()
+		change := events.BlocklistChange{
+			JID:    cag.JID("jid"),
+			Action: events.BlocklistChangeAction(cag.String("action")),
+		}
+		if !cag.OK() {
+			cli.Log.Warnf("Unexpected data in blocklist event child %v: %v", child.XMLString(), cag.Error())
+			continue
+		}
+		evt.Changes = append(evt.Changes, change)
+	}
+	cli.dispatchEvent(&evt)
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+func nodeText(node waBinary.Node) string {
+	switch content := node.Content.(type) {
+	case []byte:
+		return string(content)
+	case string:
+		return content
+	default:
+		return ""
+	}
+}
+
+// GetBusinessProfile gets the profile info of a WhatsApp business account.
+//
+// If the user is not a business account, this returns ErrNotBusinessAccount.
+func (cli *Client) GetBusinessProfile(jid types.JID) (*types.BusinessProfile, error) {
+	resp, err := cli.sendIQ(infoQuery{
+		Namespace: "w:biz",
+		Type:      "get",
+		To:        types.ServerJID,
+		Content: []waBinary.Node{{
+			Tag:   "business_profile",
+			Attrs: waBinary.Attrs{"v": "244"},
+			Content: []waBinary.Node{{
+				Tag:   "profile",
+				Attrs: waBinary.Attrs{"jid": jid},
+			}},
+		}},
+	})
+	if errors.Is(err, ErrIQNotFound) {
+		return nil, fmt.Errorf("%w: %v", ErrNotBusinessAccount, err)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get business profile: %w", err)
+	}
+	profileNode, ok := resp.GetOptionalChildByTag("business_profile", "profile")
+	if !ok {
+		return nil, ErrNotBusinessAccount
+	}
+	profile := types.BusinessProfile{
+		JID: profileNode.AttrGetter().OptionalJIDOrEmpty("jid"),
+	}
+	for _, child := range profileNode.GetChildren() {
+		switch child.Tag {
+		case "address":
+			profile.Address = nodeText(child)
+		case "email":
+			profile.Email = nodeText(child)
+		case "description":
+			profile.Description = nodeText(child)
+		case "website":
+			profile.Websites = append(profile.Websites, nodeText(child))
+		case "categories":
+			for _, category := range child.GetChildren() {
+				if category.Tag != "category" {
+					continue
+				}
+				profile.Categories = append(profile.Categories, types.BusinessCategory{
+					ID:   category.AttrGetter().OptionalString("id"),
+					Name: nodeText(category),
+				})
+			}
+		case "business_hours":
+			profile.BusinessHoursTimeZone = child.AttrGetter().OptionalString("timezone")
+			for _, config := range child.GetChildren() {
+				if config.Tag != "business_hours_config" {
+					continue
+				}
+				ag := config.AttrGetter()
+				profile.BusinessHours = append(profile.BusinessHours, types.BusinessHoursConfig{
+					DayOfWeek: ag.OptionalString("day_of_week"),
+					Mode:      ag.OptionalString("mode"),
+					OpenTime:  ag.OptionalInt("open_time"),
+					CloseTime: ag.OptionalInt("close_time"),
+				})
+			}
+		}
+	}
+	return &profile, nil
+}
+
+// GetBusinessCatalogParams contains the optional parameters for GetBusinessCatalog.
+type GetBusinessCatalogParams struct {
+	// The maximum number of products to return. Defaults to 10.
+	Limit int
+	// The NextCursor value from the previous page. Leave empty to get the first page.
+	Cursor string
+	// The requested size of the product image URLs. Defaults to 100x100.
+	ImageWidth  int
+	ImageHeight int
+}
+
+// GetBusinessCatalog gets a page of products from the catalog of a WhatsApp business account.
+//
+// If the user is not a business account or doesn't have a catalog, this returns ErrNotBusinessAccount.
+func (cli *Client) GetBusinessCatalog(jid types.JID, params GetBusinessCatalogParams) (*types.ProductCatalogPage, error) {
+	if params.Limit <= 0 {
+		params.Limit = 10
+	}
+	if params.ImageWidth <= 0 || params.ImageHeight <= 0 {
+		params.ImageWidth, params.ImageHeight = 100, 100
+	}
+	content := []waBinary.Node{
+		{Tag: "limit", Content: []byte(strconv.Itoa(params.Limit))},
+		{Tag: "width", Content: []byte(strconv.Itoa(params.ImageWidth))},
+		{Tag: "height", Content: []byte(strconv.Itoa(params.ImageHeight))},
+	}
+	if len(params.Cursor) > 0 {
+		content = append(content, waBinary.Node{Tag: "after", Content: []byte(params.Cursor)})
+	}
+	resp, err := cli.sendIQ(infoQuery{
+		Namespace: "w:biz:catalog",
+		Type:      "get",
+		To:        types.ServerJID,
+		Content: []waBinary.Node{{
+			Tag: "product_catalog",
+			Attrs: waBinary.Attrs{
+				"jid":               jid,
+				"allow_shop_source": "true",
+			},
+			Content: content,
+		}},
+	})
+	if errors.Is(err, ErrIQNotFound) {
+		return nil, fmt.Errorf("%w: %v", ErrNotBusinessAccount, err)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get business catalog: %w", err)
+	}
+	catalogNode, ok := resp.GetOptionalChildByTag("product_catalog")
+	if !ok {
+		return nil, ErrNotBusinessAccount
+	}
+	var page types.ProductCatalogPage
+	for _, child := range catalogNode.GetChildren() {
+		switch child.Tag {
+		case "product":
+			page.Products = append(page.Products, parseProductNode(child))
+		case "paging":
+			page.NextCursor = nodeText(child.GetChildByTag("after"))
+		}
+	}
+	return &page, nil
+}
+
+func parseProductNode(node waBinary.Node) types.ProductInfo {
+	var product types.ProductInfo
+	product.IsHidden = node.AttrGetter().OptionalBool("is_hidden")
+	for _, child := range node.GetChildren() {
+		switch child.Tag {
+		case "id":
+			product.ID = nodeText(child)
+		case "retailer_id":
+			product.RetailerID = nodeText(child)
+		case "name":
+			product.Name = nodeText(child)
+		case "description":
+			product.Description = nodeText(child)
+		case "url":
+			product.URL = nodeText(child)
+		case "currency":
+			product.Currency = nodeText(child)
+		case "price":
+			product.Price, _ = strconv.ParseInt(nodeText(child), 10, 64)
+		case "media":
+			for _, image := range child.GetChildren() {
+				if image.Tag != "image" {
+					continue
+				}
+				url := nodeText(image.GetChildByTag("request_image_url"))
+				if len(url) == 0 {
+					url = nodeText(image.GetChildByTag("original_image_url"))
+				}
+				if len(url) > 0 {
+					product.ImageURLs = append(product.ImageURLs, url)
+				}
+			}
+		}
+	}
+	return product
+}
+
+func parseCatalogReference(msg *waProto.Message) *types.CatalogReference {
+	if product := msg.GetProductMessage(); product != nil {
+		ownerJID, _ := types.ParseJID(product.GetBusinessOwnerJid())
+		return &types.CatalogReference{
+			BusinessOwnerJID: ownerJID,
+			ProductID:        product.GetProduct().GetProductId(),
+			RetailerID:       product.GetProduct().GetRetailerId(),
+		}
+	} else if order := msg.GetOrderMessage(); order != nil {
+		sellerJID, _ := types.ParseJID(order.GetSellerJid())
+		return &types.CatalogReference{
+			BusinessOwnerJID: sellerJID,
+			OrderID:          order.GetOrderId(),
+			OrderToken:       order.GetToken(),
+		}
+	}
+	return nil
+}
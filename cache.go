@@ -0,0 +1,47 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+// contactCache is implemented by contact stores that keep an in-memory cache, like the SQL store.
+type contactCache interface {
+	FlushContactCache()
+	ContactCacheSize() int
+}
+
+func (cli *Client) getContactCache() contactCache {
+	if cli.Store == nil {
+		return nil
+	}
+	cache, _ := cli.Store.Contacts.(contactCache)
+	return cache
+}
+
+func (cli *Client) contactCacheSize() int {
+	if cache := cli.getContactCache(); cache != nil {
+		return cache.ContactCacheSize()
+	}
+	return 0
+}
+
+// FlushCaches removes everything from the client's in-memory caches: device lists, group info
+// and contacts (if the device store caches them). Nothing is lost, as the data will just be
+// fetched from the device store or the server again the next time it's needed.
+func (cli *Client) FlushCaches() {
+	cli.deviceCacheLock.Lock()
+	cli.deviceCache.Clear()
+	cli.deviceCacheLock.Unlock()
+	cli.setDeviceCacheSize(0)
+
+	cli.groupCacheLock.Lock()
+	cli.groupCache.Clear()
+	cli.groupCacheLock.Unlock()
+	cli.setGroupCacheSize(0)
+
+	if cache := cli.getContactCache(); cache != nil {
+		cache.FlushContactCache()
+	}
+}
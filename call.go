@@ -0,0 +1,93 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"fmt"
+	"time"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func (cli *Client) handleCallEvent(node *waBinary.Node) {
+	go cli.sendAck(node)
+
+	children := node.GetChildren()
+	if len(children) != 1 {
+		cli.dispatchEvent(&events.UnknownCallEvent{Node: node})
+		return
+	}
+	ag := node.AttrGetter()
+	child := children[0]
+	cag := child.AttrGetter()
+	basicMeta := types.BasicCallMeta{
+		From:        ag.JID("from"),
+		Timestamp:   time.Unix(ag.Int64("t"), 0),
+		CallCreator: cag.JID("call-creator"),
+		CallID:      cag.String("call-id"),
+	}
+	if !ag.OK() || !cag.OK() {
+		cli.Log.Warnf("Failed to parse call element %s: %v", node.XMLString(), waBinary.ErrorList(append(ag.Errors, cag.Errors...)))
+		cli.dispatchEvent(&events.UnknownCallEvent{Node: node})
+		return
+	}
+	switch child.Tag {
+	case "offer":
+		evt := &events.CallOffer{
+			BasicCallMeta: basicMeta,
+			Type:          types.CallTypeVoice,
+			Data:          &child,
+		}
+		if _, isVideo := child.GetOptionalChildByTag("video"); isVideo {
+			evt.Type = types.CallTypeVideo
+		}
+		if cli.AutoRejectCalls {
+			go func() {
+				err := cli.RejectCall(basicMeta.CallCreator, basicMeta.CallID)
+				if err != nil {
+					cli.Log.Warnf("Failed to automatically reject call %s from %s: %v", basicMeta.CallID, basicMeta.CallCreator, err)
+				}
+			}()
+		}
+		cli.dispatchEvent(evt)
+	case "accept":
+		cli.dispatchEvent(&events.CallAccept{
+			BasicCallMeta: basicMeta,
+			Data:          &child,
+		})
+	case "terminate":
+		cli.dispatchEvent(&events.CallTerminate{
+			BasicCallMeta: basicMeta,
+			Reason:        cag.OptionalString("reason"),
+			Data:          &child,
+		})
+	default:
+		cli.dispatchEvent(&events.UnknownCallEvent{Node: node})
+	}
+}
+
+// RejectCall rejects an incoming call. The callFrom parameter should be the CallCreator from the CallOffer event.
+func (cli *Client) RejectCall(callFrom types.JID, callID string) error {
+	if cli.Store.ID == nil {
+		return ErrNotLoggedIn
+	}
+	ownID, callFrom := cli.Store.ID.ToNonAD(), callFrom.ToNonAD()
+	err := cli.sendNode(waBinary.Node{
+		Tag:   "call",
+		Attrs: waBinary.Attrs{"id": GenerateMessageID(), "from": ownID, "to": callFrom},
+		Content: []waBinary.Node{{
+			Tag:   "reject",
+			Attrs: waBinary.Attrs{"call-id": callID, "call-creator": callFrom, "count": "0"},
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send call reject: %w", err)
+	}
+	return nil
+}
@@ -7,27 +7,39 @@
 package whatsmeow
 
 import (
+	"container/list"
 	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
 
 	"go.mau.fi/whatsmeow/appstate"
 	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
 	"go.mau.fi/whatsmeow/socket"
 	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
 	"go.mau.fi/whatsmeow/types/events"
 	"go.mau.fi/whatsmeow/util/keys"
 	waLog "go.mau.fi/whatsmeow/util/log"
+	"go.mau.fi/whatsmeow/util/lru"
 )
 
 // EventHandler is a function that can handle events from WhatsApp.
 type EventHandler func(evt interface{})
+
+type wrappedEventHandler struct {
+	fn           EventHandler
+	id           uint32
+	registeredAt string
+}
 type nodeHandler func(node *waBinary.Node)
 
 // Client contains everything necessary to connect to and interact with the WhatsApp web API.
@@ -37,18 +49,51 @@ type Client struct {
 	recvLog waLog.Logger
 	sendLog waLog.Logger
 
+	// EnableRawNodeLogging makes the client log all sent and received nodes as indented XML at the debug level.
+	// Encrypted payloads, keys and auth tokens are redacted unless DisableRawNodeRedaction is also set.
+	// The nodes are logged through the RawNode/Recv and RawNode/Send submodules of the client logger.
+	EnableRawNodeLogging    bool
+	DisableRawNodeRedaction bool
+
 	socket     *socket.NoiseSocket
 	socketLock sync.Mutex
 
+	pastNetworkStats     socket.NetworkStats
+	pastNetworkStatsLock sync.Mutex
+
 	isExpectedDisconnect  bool
 	EnableAutoReconnect   bool
 	LastSuccessfulConnect time.Time
 	AutoReconnectErrors   int
+	// banExpiryReconnect reconnects after a temporary ban expires if auto-reconnect is enabled.
+	banExpiryReconnect     *time.Timer
+	banExpiryReconnectLock sync.Mutex
 
 	IsLoggedIn bool
 
-	appStateProc     *appstate.Processor
-	appStateSyncLock sync.Mutex
+	// ReregisterOnLogout makes the client start pairing again (reusing the existing keys) when the server says
+	// the session is no longer valid. A QR event will be emitted after the LoggedOut event so the user can re-scan.
+	ReregisterOnLogout bool
+
+	// InitialPresence is sent automatically right after the connection is authenticated, if set.
+	// Leaving it empty means no presence is sent, and you should call SendPresence yourself.
+	// Note that staying unavailable means the server won't send you presence updates of other users.
+	InitialPresence types.Presence
+
+	appStateProc        *appstate.Processor
+	appStateSyncLock    sync.Mutex
+	appStateFetches     map[appstate.WAPatchName]*appStateFetch
+	appStateResyncs     map[appstate.WAPatchName]struct{}
+	appStateFetchesLock sync.Mutex
+
+	// AutoRecoverAppState makes the client automatically do a full resync of an app state type when its local state
+	// is found to be corrupted (see events.AppStateSyncError). Each type is only resynced automatically once per
+	// connection, so a state that stays broken after resyncing doesn't cause a loop.
+	AutoRecoverAppState bool
+
+	appStateKeyRequests     map[string]time.Time
+	stalledAppStates        map[appstate.WAPatchName]struct{}
+	appStateKeyRequestsLock sync.Mutex
 
 	mediaConn     *MediaConn
 	mediaConnLock sync.Mutex
@@ -56,12 +101,161 @@ type Client struct {
 	responseWaiters     map[string]chan<- *waBinary.Node
 	responseWaitersLock sync.Mutex
 
+	// MaxConcurrentIQs limits the number of info queries that can be waiting for a response at the same time.
+	// Further queries are queued until a slot frees up or their timeout/context expires. Zero means no limit.
+	MaxConcurrentIQs int
+	iqSemaphore      chan struct{}
+	iqSemaphoreLock  sync.Mutex
+
 	messageRetries     map[string]int
 	messageRetriesLock sync.Mutex
 
-	nodeHandlers  map[string]nodeHandler
-	handlerQueue  chan *waBinary.Node
-	eventHandlers []EventHandler
+	pendingPeerMessages     map[types.MessageID]chan struct{}
+	pendingPeerMessagesLock sync.Mutex
+	primaryDeviceStatus     uint32
+
+	historySyncRequests     map[types.MessageID]*historySyncRequest
+	historySyncLimits       map[types.JID]time.Time
+	historySyncRequestsLock sync.Mutex
+
+	// DedupCacheSize is the number of recently handled messages to remember, so that messages redelivered by the
+	// server (e.g. after reconnecting) don't emit duplicate events. Zero disables deduplication.
+	DedupCacheSize int
+	dedupCache     *lru.Cache[messageDedupKey, struct{}]
+	dedupLock      sync.Mutex
+
+	// EnablePersistentOutbox makes SendMessage store messages in the device store until the server
+	// acknowledges them. Unacknowledged messages are resent with the same ID after (re)connecting.
+	EnablePersistentOutbox bool
+	// MaxOutboxAttempts is the number of times a message from the outbox is resent before it's dropped.
+	// Defaults to DefaultMaxOutboxAttempts. Zero or negative means no limit.
+	MaxOutboxAttempts int
+
+	// DisableHandlerPanicRecovery can be set to true to let panics in event handlers crash the program.
+	// By default, panics are recovered, logged and emitted as events.HandlerPanic, and the remaining
+	// handlers still receive the event.
+	DisableHandlerPanicRecovery bool
+
+	// AutoRejectCalls can be set to true to reject all incoming calls automatically.
+	// The CallOffer events are still emitted for rejected calls.
+	AutoRejectCalls bool
+
+	// WSEndpoints is a list of websocket URLs to connect to. They're tried in order until one of them can be dialed,
+	// but endpoints that have failed recently are moved to the end of the list. Defaults to socket.URL if empty.
+	WSEndpoints       []string
+	endpointFailures  map[string]int
+	connectedEndpoint string
+	endpointLock      sync.Mutex
+
+	// UnhandledNodeHandler is called with top-level nodes that weren't handled by any built-in handler,
+	// such as nodes with unknown tags, notifications of unknown types and unknown requests from the server.
+	// It's called from the node handler goroutine, so it should return quickly.
+	UnhandledNodeHandler func(node *waBinary.Node)
+
+	// PreSendHook is called by SendMessage with every outgoing message right before it's encrypted and sent.
+	// The returned message is sent instead of the original one (returning nil sends the original), and
+	// returning an error aborts sending. The ID of the message is available using PreSendMessageID(ctx).
+	//
+	// The hook is responsible for keeping the message valid: e.g. when appending text to messages that already
+	// have a ContextInfo (replies, mentions), the existing fields must be preserved by the hook.
+	PreSendHook func(ctx context.Context, to types.JID, msg *waProto.Message) (*waProto.Message, error)
+
+	// MetricsHook is called whenever one of the counters returned by Stats changes.
+	MetricsHook MetricsHook
+	metrics     clientMetrics
+
+	// MessageWorkers is the number of goroutines used to decrypt and handle incoming messages.
+	// Messages in the same chat are always handled in order, while different chats can be handled in parallel.
+	// Values below 2 handle all messages in the node handler goroutine. Changes take effect on the next connect.
+	MessageWorkers int
+	// ReceiptBatchWindow enables batching delivery receipts when set to a positive duration. Receipts for messages
+	// from the same sender in the same chat are collected for up to this long (or until ReceiptBatchMaxSize messages)
+	// and sent as a single receipt node. Receipts are only queued after the message event has been dispatched.
+	// Zero sends a receipt for every message immediately.
+	ReceiptBatchWindow time.Duration
+	// ReceiptBatchMaxSize is the number of messages after which a receipt batch is sent without waiting for the
+	// rest of ReceiptBatchWindow. Zero means no limit.
+	ReceiptBatchMaxSize int
+	pendingReceipts     map[receiptBatchKey]*receiptBatch
+	pendingReceiptsLock sync.Mutex
+
+	// BadMACRecoveryThreshold is the number of consecutive bad MAC errors from a single device after which the Signal
+	// session with that device is deleted and a new one is requested (see events.SessionRecovered).
+	// Zero disables automatic session recovery.
+	BadMACRecoveryThreshold int
+	// MaxSessionRecoveries is the maximum number of times the session with a single device is reset within
+	// SessionRecoveryWindow. Further bad MAC errors are only handled with normal retry receipts.
+	MaxSessionRecoveries  int
+	SessionRecoveryWindow time.Duration
+	badMACs               map[types.JID]*badMACState
+	badMACsLock           sync.Mutex
+
+	// sendQueue makes sure messages to the same chat are sent in the order SendMessage was called.
+	sendQueue sendSequencer
+	// signalLocks prevents concurrent access to the Signal session or sender key of a single address.
+	signalLocks keyedMutex
+
+	nodeHandlers      map[string]nodeHandler
+	handlerQueue      chan *waBinary.Node
+	eventHandlers     []wrappedEventHandler
+	eventHandlersLock sync.RWMutex
+	nextHandlerID     uint32
+	eventQueue        chan interface{}
+	eventQueueFull    EventQueueFullBehavior
+	droppedEvents     uint32
+
+	// offlineProcessed counts the nodes from the offline queue that have been handled since connecting.
+	offlineProcessed uint32
+
+	// nowFunc returns the current time. It's only replaced in tests to freeze the clock.
+	nowFunc func() time.Time
+
+	// GroupInfoCacheTTL enables caching the results of GetGroupInfo when set to a positive duration.
+	// Cached entries are invalidated by group change notifications. Entries older than the TTL are
+	// still returned, but a refresh is started in the background.
+	GroupInfoCacheTTL time.Duration
+	// GroupInfoCacheMaxSize is the maximum number of groups to keep in the group info cache.
+	// When the limit is reached, the least recently used group is dropped. Zero means no limit.
+	GroupInfoCacheMaxSize int
+	groupCache            *lru.Cache[types.JID, *groupCacheEntry]
+	groupCacheLock        sync.Mutex
+
+	// DeviceCacheTTL is how long the device lists of message recipients are cached. Cached lists are invalidated
+	// by device change notifications and can be invalidated manually with InvalidateDeviceCache. Group messages
+	// are resent once with fresh device lists if the server says the participant list was outdated.
+	// Zero disables the cache, which means device lists are fetched for every sent message.
+	DeviceCacheTTL time.Duration
+	// DeviceCacheMaxSize is the maximum number of users whose device lists are cached.
+	// When the limit is reached, the least recently used list is dropped. Zero means no limit.
+	DeviceCacheMaxSize    int
+	deviceCache           *lru.Cache[types.JID, *deviceCacheEntry]
+	deviceCacheLock       sync.Mutex
+	pendingGroupSends     map[types.MessageID]*pendingGroupSend
+	pendingGroupSendsLock sync.Mutex
+
+	// MaxPresenceSubscriptions is the maximum number of presence subscriptions to remember and renew after
+	// reconnecting. When the limit is reached, the oldest subscription is dropped. Zero means no limit.
+	MaxPresenceSubscriptions int
+	presenceSubs             map[types.JID]*list.Element
+	presenceLRU              *list.List
+	presenceSubsLock         sync.Mutex
+
+	privacySettingsCache *types.PrivacySettings
+	privacySettingsLock  sync.Mutex
+
+	defaultDisappearingTimer     *time.Duration
+	defaultDisappearingTimerLock sync.Mutex
+
+	// MediaDownloadRetries is the number of times to retry downloading media through the whole
+	// media host list if all hosts fail. The default is 0, which means each host is tried once.
+	MediaDownloadRetries int
+
+	// SendNativeFlowMessages makes SendButtons and SendList use the newer InteractiveMessage format with
+	// native flow buttons instead of the deprecated ButtonsMessage and ListMessage formats.
+	SendNativeFlowMessages bool
+
+	// VideoThumbnailer is used by BuildVideoMessage and SendMedia to get the video dimensions, duration and thumbnail.
+	VideoThumbnailer VideoThumbnailer
 
 	uniqueID  string
 	idCounter uint64
@@ -75,6 +269,16 @@ const handlerQueueSize = 2048
 //
 // The logger can be nil, it will default to a no-op logger.
 func NewClient(deviceStore *store.Device, log waLog.Logger) *Client {
+	return NewClientWithOptions(deviceStore, log, ClientOptions{})
+}
+
+// NewClientWithOptions initializes a new WhatsApp web client with the given options.
+// See NewClient for the other parameters.
+//
+// For DispatchOrdered, a dispatcher goroutine is started which stays alive as long as the client.
+// Event handlers in ordered mode must not wait for other events, as those won't be dispatched
+// until the current handler returns.
+func NewClientWithOptions(deviceStore *store.Device, log waLog.Logger, opts ClientOptions) *Client {
 	if log == nil {
 		log = waLog.Noop
 	}
@@ -83,15 +287,39 @@ func NewClient(deviceStore *store.Device, log waLog.Logger) *Client {
 	cli := &Client{
 		Store:           deviceStore,
 		Log:             log,
-		recvLog:         log.Sub("Recv"),
-		sendLog:         log.Sub("Send"),
+		recvLog:         log.Sub("RawNode").Sub("Recv"),
+		sendLog:         log.Sub("RawNode").Sub("Send"),
 		uniqueID:        fmt.Sprintf("%d.%d-", randomBytes[0], randomBytes[1]),
 		responseWaiters: make(map[string]chan<- *waBinary.Node),
-		eventHandlers:   make([]EventHandler, 0, 1),
+		eventHandlers:   make([]wrappedEventHandler, 0, 1),
 		messageRetries:  make(map[string]int),
+		groupCache:      lru.New[types.JID, *groupCacheEntry](DefaultGroupInfoCacheMaxSize),
+		deviceCache:     lru.New[types.JID, *deviceCacheEntry](DefaultDeviceCacheMaxSize),
+		presenceSubs:    make(map[types.JID]*list.Element),
+		presenceLRU:     list.New(),
 		handlerQueue:    make(chan *waBinary.Node, handlerQueueSize),
 		appStateProc:    appstate.NewProcessor(deviceStore, log.Sub("AppState")),
+		nowFunc:         time.Now,
 	}
+	cli.MaxPresenceSubscriptions = DefaultMaxPresenceSubscriptions
+	cli.DedupCacheSize = DefaultDedupCacheSize
+	cli.MaxOutboxAttempts = DefaultMaxOutboxAttempts
+	cli.DeviceCacheTTL = DefaultDeviceCacheTTL
+	cli.DeviceCacheMaxSize = DefaultDeviceCacheMaxSize
+	cli.GroupInfoCacheMaxSize = DefaultGroupInfoCacheMaxSize
+	cli.ReceiptBatchMaxSize = DefaultReceiptBatchMaxSize
+	cli.BadMACRecoveryThreshold = DefaultBadMACRecoveryThreshold
+	cli.MaxSessionRecoveries = DefaultMaxSessionRecoveries
+	cli.SessionRecoveryWindow = DefaultSessionRecoveryWindow
+	cli.badMACs = make(map[types.JID]*badMACState)
+	cli.pendingReceipts = make(map[receiptBatchKey]*receiptBatch)
+	cli.pendingGroupSends = make(map[types.MessageID]*pendingGroupSend)
+	cli.appStateKeyRequests = make(map[string]time.Time)
+	cli.pendingPeerMessages = make(map[types.MessageID]chan struct{})
+	cli.historySyncRequests = make(map[types.MessageID]*historySyncRequest)
+	cli.historySyncLimits = make(map[types.JID]time.Time)
+	cli.stalledAppStates = make(map[appstate.WAPatchName]struct{})
+	cli.appStateFetches = make(map[appstate.WAPatchName]*appStateFetch)
 	cli.nodeHandlers = map[string]nodeHandler{
 		"message":      cli.handleEncryptedMessage,
 		"receipt":      cli.handleReceipt,
@@ -100,6 +328,18 @@ func NewClient(deviceStore *store.Device, log waLog.Logger) *Client {
 		"failure":      cli.handleConnectFailure,
 		"stream:error": cli.handleStreamError,
 		"iq":           cli.handleIQ,
+		"presence":     cli.handlePresence,
+		"call":         cli.handleCallEvent,
+		"ib":           cli.handleIB,
+		"ack":          cli.handleAck,
+	}
+	if opts.DispatchMode == DispatchOrdered {
+		if opts.EventQueueSize <= 0 {
+			opts.EventQueueSize = DefaultEventQueueSize
+		}
+		cli.eventQueue = make(chan interface{}, opts.EventQueueSize)
+		cli.eventQueueFull = opts.EventQueueFull
+		go cli.eventDispatcherLoop()
 	}
 	return cli
 }
@@ -117,9 +357,8 @@ func (cli *Client) Connect() error {
 		}
 	}
 
-	fs := socket.NewFrameSocket(cli.Log.Sub("Socket"), socket.WAConnHeader)
-	if err := fs.Connect(); err != nil {
-		fs.Close(0)
+	fs, err := cli.dialEndpoints()
+	if err != nil {
 		return err
 	} else if err = cli.doHandshake(fs, *keys.NewKeyPair()); err != nil {
 		fs.Close(0)
@@ -133,6 +372,8 @@ func (cli *Client) Connect() error {
 		defer cli.socketLock.Unlock()
 		if cli.socket == ns {
 			cli.socket = nil
+			cli.dropPendingReceipts()
+			cli.addPastNetworkStats(ns)
 			if !cli.isExpectedDisconnect {
 				cli.Log.Debugf("Emitting Disconnected event")
 				go cli.dispatchEvent(&events.Disconnected{})
@@ -155,6 +396,7 @@ func (cli *Client) autoReconnect() {
 	}
 	for {
 		cli.AutoReconnectErrors++
+		cli.incrCounter(&cli.metrics.reconnects, MetricReconnects, 1)
 		autoReconnectDelay := time.Duration(cli.AutoReconnectErrors) * 2 * time.Second
 		cli.Log.Debugf("Automatically reconnecting after %v", autoReconnectDelay)
 		time.Sleep(autoReconnectDelay)
@@ -175,9 +417,11 @@ func (cli *Client) IsConnected() bool {
 }
 
 func (cli *Client) Disconnect() {
+	cli.cancelBanExpiryReconnect()
 	if cli.socket == nil {
 		return
 	}
+	cli.flushPendingReceipts()
 	cli.socketLock.Lock()
 	cli.disconnect()
 	cli.socketLock.Unlock()
@@ -189,18 +433,91 @@ func (cli *Client) disconnect() {
 		cli.socket.SetOnDisconnect(nil)
 		cli.socket.OnFrame = nil
 		cli.socket.Close(websocket.CloseNormalClosure)
+		oldSocket := cli.socket
 		cli.socket = nil
+		cli.addPastNetworkStats(oldSocket)
+		cli.dropPendingReceipts()
 	}
 }
 
+// NetworkStats contains the amount of data sent and received by a client.
+type NetworkStats struct {
+	Current socket.NetworkStats // The counters of the current connection. All zero if not connected.
+	Total   socket.NetworkStats // The counters of all connections made by the client, including the current one.
+}
+
+func (cli *Client) addPastNetworkStats(ns *socket.NoiseSocket) {
+	cli.pastNetworkStatsLock.Lock()
+	cli.pastNetworkStats = cli.pastNetworkStats.Add(ns.Stats())
+	cli.pastNetworkStatsLock.Unlock()
+}
+
+// NetworkStats returns the number of bytes and frames sent and received through the websocket.
+//
+// The current counters start from zero after each successful handshake.
+func (cli *Client) NetworkStats() NetworkStats {
+	var stats NetworkStats
+	// The socket lock also makes sure the stats of a closing socket aren't counted twice,
+	// as they're moved to the past stats while holding it.
+	cli.socketLock.Lock()
+	defer cli.socketLock.Unlock()
+	if cli.socket != nil {
+		stats.Current = cli.socket.Stats()
+	}
+	cli.pastNetworkStatsLock.Lock()
+	stats.Total = cli.pastNetworkStats.Add(stats.Current)
+	cli.pastNetworkStatsLock.Unlock()
+	return stats
+}
+
 // AddEventHandler registers a new function to receive all events emitted by this client.
-func (cli *Client) AddEventHandler(handler EventHandler) {
-	cli.eventHandlers = append(cli.eventHandlers, handler)
+//
+// Handlers are called synchronously in the order they were registered. The returned ID can be
+// passed to RemoveEventHandler to unregister the handler.
+func (cli *Client) AddEventHandler(handler EventHandler) uint32 {
+	return cli.addEventHandler(handler, 2)
+}
+
+// addEventHandler registers an event handler. The skip parameter is passed to runtime.Caller
+// to find where the handler was registered from for logging panics.
+func (cli *Client) addEventHandler(handler EventHandler, skip int) uint32 {
+	id := atomic.AddUint32(&cli.nextHandlerID, 1)
+	registeredAt := "unknown location"
+	if _, file, line, ok := runtime.Caller(skip); ok {
+		registeredAt = fmt.Sprintf("%s:%d", file, line)
+	}
+	cli.eventHandlersLock.Lock()
+	cli.eventHandlers = append(cli.eventHandlers, wrappedEventHandler{fn: handler, id: id, registeredAt: registeredAt})
+	cli.eventHandlersLock.Unlock()
+	return id
+}
+
+// RemoveEventHandler removes a previously registered event handler function.
+// The return value is true if a handler with the given ID was found and removed.
+//
+// This is safe to call from inside an event handler. If an event is being dispatched while the
+// handler is removed, the handler may still receive that event, but it won't get any later ones.
+func (cli *Client) RemoveEventHandler(id uint32) bool {
+	cli.eventHandlersLock.Lock()
+	defer cli.eventHandlersLock.Unlock()
+	for index, handler := range cli.eventHandlers {
+		if handler.id == id {
+			// Build a new slice instead of modifying the old one in place, so that dispatches
+			// already iterating over the old slice aren't affected.
+			newHandlers := make([]wrappedEventHandler, 0, len(cli.eventHandlers)-1)
+			newHandlers = append(newHandlers, cli.eventHandlers[:index]...)
+			cli.eventHandlers = append(newHandlers, cli.eventHandlers[index+1:]...)
+			return true
+		}
+	}
+	return false
 }
 
 // RemoveEventHandlers removes all event handlers that have been registered with AddEventHandler
 func (cli *Client) RemoveEventHandlers() {
-	cli.eventHandlers = make([]EventHandler, 0, 1)
+	cli.eventHandlersLock.Lock()
+	cli.eventHandlers = make([]wrappedEventHandler, 0, 1)
+	cli.eventHandlersLock.Unlock()
 }
 
 func (cli *Client) handleFrame(data []byte) {
@@ -216,13 +533,13 @@ func (cli *Client) handleFrame(data []byte) {
 		cli.Log.Debugf("Errored frame hex: %s", hex.EncodeToString(decompressed))
 		return
 	}
-	cli.recvLog.Debugf("%s", node.XMLString())
+	cli.logRawNode(cli.recvLog, node)
 	if node.Tag == "xmlstreamend" {
 		cli.Log.Warnf("Received stream end frame")
 		// TODO should we do something else?
 	} else if cli.receiveResponse(node) {
 		// handled
-	} else if _, ok := cli.nodeHandlers[node.Tag]; ok {
+	} else if _, ok := cli.nodeHandlers[node.Tag]; ok || cli.UnhandledNodeHandler != nil {
 		select {
 		case cli.handlerQueue <- node:
 		default:
@@ -236,28 +553,63 @@ func (cli *Client) handleFrame(data []byte) {
 	}
 }
 
+// handleUnhandledNode passes a node that wasn't handled by any built-in handler to UnhandledNodeHandler.
+func (cli *Client) handleUnhandledNode(node *waBinary.Node) {
+	if cli.UnhandledNodeHandler != nil {
+		cli.UnhandledNodeHandler(node)
+	} else {
+		cli.Log.Debugf("Didn't handle %s node %s", node.Tag, node.Attrs["id"])
+	}
+}
+
 func (cli *Client) handlerQueueLoop(ctx context.Context) {
+	var workers *messageWorkerPool
+	if cli.MessageWorkers > 1 {
+		workers = cli.startMessageWorkers(ctx, cli.MessageWorkers)
+	}
 	for {
 		select {
 		case node := <-cli.handlerQueue:
-			cli.nodeHandlers[node.Tag](node)
+			if workers != nil && node.Tag == "message" {
+				workers.submit(ctx, node)
+				continue
+			} else if workers != nil && node.Tag == "ib" {
+				// The offline sync completion event includes the number of processed nodes,
+				// so wait for the workers to finish with earlier messages first.
+				workers.wait(ctx)
+			}
+			cli.handleQueuedNode(node)
 		case <-ctx.Done():
 			return
 		}
 	}
 }
+
+func (cli *Client) handleQueuedNode(node *waBinary.Node) {
+	if handler, ok := cli.nodeHandlers[node.Tag]; ok {
+		handler(node)
+	} else {
+		cli.handleUnhandledNode(node)
+	}
+	if _, isOffline := node.Attrs["offline"]; isOffline {
+		atomic.AddUint32(&cli.offlineProcessed, 1)
+	}
+}
+
+// now returns the current time according to the client's clock.
+func (cli *Client) now() time.Time {
+	if cli.nowFunc == nil {
+		return time.Now()
+	}
+	return cli.nowFunc()
+}
+
 func (cli *Client) sendNode(node waBinary.Node) error {
 	payload, err := waBinary.Marshal(node)
 	if err != nil {
 		return fmt.Errorf("failed to marshal ping IQ: %w", err)
 	}
 
-	cli.sendLog.Debugf("%s", node.XMLString())
+	cli.logRawNode(cli.sendLog, &node)
 	return cli.socket.SendFrame(payload)
 }
-
-func (cli *Client) dispatchEvent(evt interface{}) {
-	for _, handler := range cli.eventHandlers {
-		handler(evt)
-	}
-}
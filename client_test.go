@@ -0,0 +1,79 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+func TestRemoveEventHandlerDuringDispatch(t *testing.T) {
+	cli := &Client{}
+	var calls []string
+	var selfID uint32
+	cli.AddEventHandler(func(evt interface{}) {
+		calls = append(calls, "first")
+	})
+	selfID = cli.AddEventHandler(func(evt interface{}) {
+		calls = append(calls, "self")
+		if !cli.RemoveEventHandler(selfID) {
+			t.Error("Expected handler to be removed")
+		}
+	})
+	cli.AddEventHandler(func(evt interface{}) {
+		calls = append(calls, "last")
+	})
+
+	cli.dispatchEvent(nil)
+	cli.dispatchEvent(nil)
+
+	expected := []string{"first", "self", "last", "first", "last"}
+	if len(calls) != len(expected) {
+		t.Fatalf("Expected calls %v, got %v", expected, calls)
+	}
+	for i := range expected {
+		if calls[i] != expected[i] {
+			t.Fatalf("Expected calls %v, got %v", expected, calls)
+		}
+	}
+	if cli.RemoveEventHandler(selfID) {
+		t.Error("Expected second removal of the same handler to fail")
+	}
+}
+
+func TestNetworkStatsDuringDisconnect(t *testing.T) {
+	url, results := fakeNoiseServer(t)
+	cli := NewClient(&store.Device{Log: waLog.Noop, RegistrationID: 1234}, waLog.Noop)
+	connectToFakeServer(t, cli, url)
+	if err := cli.SendPresence(types.PresenceUnavailable); err != nil {
+		t.Fatalf("Failed to send presence: %v", err)
+	}
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				// Must not race with the socket being cleared when disconnecting (run with -race)
+				cli.NetworkStats()
+			}
+		}
+	}()
+	cli.Disconnect()
+	<-results
+	close(stop)
+	<-stopped
+	if stats := cli.NetworkStats(); stats.Current.FramesWritten != 0 || stats.Total.FramesWritten != 1 {
+		t.Errorf("Expected the sent frame to be moved to the total after disconnecting, got %+v", stats)
+	}
+}
@@ -7,6 +7,8 @@
 package whatsmeow
 
 import (
+	"errors"
+	"sync/atomic"
 	"time"
 
 	waBinary "go.mau.fi/whatsmeow/binary"
@@ -27,17 +29,16 @@ func (cli *Client) handleStreamError(node *waBinary.Node) {
 				cli.Log.Errorf("Failed to reconnect after 515 code:", err)
 			}
 		}()
+	case "403":
+		cli.Log.Errorf("Got 403 stream error, the account has been banned: %s", node.XMLString())
+		cli.isExpectedDisconnect = true
+		go cli.dispatchEvent(&events.AccountBanned{OnConnect: false, Code: 403, Raw: node})
 	case "401":
 		conflict, ok := node.GetOptionalChildByTag("conflict")
 		conflictType := conflict.AttrGetter().String("type")
 		if ok && conflictType == "device_removed" {
-			cli.isExpectedDisconnect = true
 			cli.Log.Infof("Got device removed stream error, sending LoggedOut event and deleting session")
-			go cli.dispatchEvent(&events.LoggedOut{})
-			err := cli.Store.Delete()
-			if err != nil {
-				cli.Log.Warnf("Failed to delete store after device_removed error:", err)
-			}
+			cli.handleLoggedOut(false, events.ConnectFailureLoggedOut)
 		} else {
 			cli.Log.Errorf("Unknown stream error code 401: %s", node.XMLString())
 			go cli.dispatchEvent(&events.StreamError{Code: code, Raw: node})
@@ -50,41 +51,159 @@ func (cli *Client) handleStreamError(node *waBinary.Node) {
 
 func (cli *Client) handleConnectFailure(node *waBinary.Node) {
 	ag := node.AttrGetter()
-	reason := ag.String("reason")
-	if reason == "401" {
+	reasonStr := ag.String("reason")
+	reason := events.ConnectFailureReason(ag.Int("reason"))
+	if reason == events.ConnectFailureUnknownLogout {
+		cli.Log.Errorf("Got %d connect failure, the account has been banned", reason)
+		go cli.dispatchEvent(&events.AccountBanned{OnConnect: true, Code: int(reason), Raw: node})
+		cli.handleLoggedOut(true, reason)
+	} else if reason.IsLoggedOut() {
+		cli.Log.Infof("Got %d connect failure, sending LoggedOut event and deleting session", reason)
+		cli.handleLoggedOut(true, reason)
+	} else if reason == events.ConnectFailureTempBanned {
 		cli.isExpectedDisconnect = true
-		cli.Log.Infof("Got 401 connect failure, sending LoggedOut event and deleting session")
-		go cli.dispatchEvent(&events.LoggedOut{})
-		err := cli.Store.Delete()
-		if err != nil {
-			cli.Log.Warnf("Failed to delete store after 401 failure:", err)
+		evt := &events.TemporaryBan{
+			Code:   events.TempBanReason(ag.OptionalInt("code")),
+			Expire: time.Duration(ag.OptionalInt("expire")) * time.Second,
+		}
+		if evt.Expire > 0 {
+			evt.Until = cli.now().Add(evt.Expire)
+			cli.scheduleBanExpiryReconnect(evt.Expire)
 		}
+		cli.Log.Warnf("Got temporary ban connect failure: %s", evt)
+		go cli.dispatchEvent(evt)
+	} else if reason == events.ConnectFailureClientOutdated {
+		cli.isExpectedDisconnect = true
+		cli.Log.Errorf("Got client outdated connect failure, whatsmeow needs to be updated")
+		go cli.dispatchEvent(&events.ClientOutdated{})
 	} else {
 		cli.Log.Warnf("Unknown connect failure: %s", node.XMLString())
-		go cli.dispatchEvent(&events.ConnectFailure{Reason: reason, Raw: node})
+		go cli.dispatchEvent(&events.ConnectFailure{Reason: reasonStr, Raw: node})
+	}
+}
+
+// handleLoggedOut deletes the session after the server has said it's no longer valid.
+// If Client.ReregisterOnLogout is set, the client then reconnects to pair again using the existing keys,
+// unless the session was invalidated because the account was banned.
+func (cli *Client) handleLoggedOut(onConnect bool, reason events.ConnectFailureReason) {
+	cli.isExpectedDisconnect = true
+	go cli.dispatchEvent(&events.LoggedOut{OnConnect: onConnect, Reason: reason})
+	err := cli.Store.Delete()
+	if err != nil {
+		cli.Log.Warnf("Failed to delete store after logout: %v", err)
+	}
+	if !cli.ReregisterOnLogout || reason == events.ConnectFailureUnknownLogout {
+		// Registering again won't help if the account has been banned
+		return
+	}
+	// Clearing the ID makes GetClientPayload return a registration payload instead of a login payload.
+	cli.Store.ID = nil
+	cli.Store.Initialized = false
+	go func() {
+		cli.Log.Infof("Reconnecting to register as a new companion device")
+		cli.Disconnect()
+		err := cli.Connect()
+		if err != nil {
+			cli.Log.Errorf("Failed to reconnect for re-registration: %v", err)
+		}
+	}()
+}
+
+// scheduleBanExpiryReconnect makes the client reconnect after the given delay if auto-reconnect is enabled.
+func (cli *Client) scheduleBanExpiryReconnect(delay time.Duration) {
+	if !cli.EnableAutoReconnect {
+		return
+	}
+	cli.banExpiryReconnectLock.Lock()
+	defer cli.banExpiryReconnectLock.Unlock()
+	if cli.banExpiryReconnect != nil {
+		cli.banExpiryReconnect.Stop()
 	}
+	cli.Log.Infof("Reconnecting automatically after the temporary ban expires in %v", delay)
+	cli.banExpiryReconnect = time.AfterFunc(delay, func() {
+		err := cli.Connect()
+		if errors.Is(err, ErrAlreadyConnected) {
+			cli.Log.Debugf("Connect() said we're already connected after temporary ban expired")
+		} else if err != nil {
+			cli.Log.Errorf("Error reconnecting after temporary ban expired: %v", err)
+			cli.autoReconnect()
+		}
+	})
+}
+
+// cancelBanExpiryReconnect stops a reconnect scheduled by scheduleBanExpiryReconnect.
+func (cli *Client) cancelBanExpiryReconnect() {
+	cli.banExpiryReconnectLock.Lock()
+	if cli.banExpiryReconnect != nil {
+		cli.banExpiryReconnect.Stop()
+		cli.banExpiryReconnect = nil
+	}
+	cli.banExpiryReconnectLock.Unlock()
 }
 
 func (cli *Client) handleConnectSuccess(node *waBinary.Node) {
 	cli.Log.Infof("Successfully authenticated")
-	cli.LastSuccessfulConnect = time.Now()
+	connectedAt := cli.now()
+	cli.LastSuccessfulConnect = connectedAt
 	cli.AutoReconnectErrors = 0
 	cli.IsLoggedIn = true
+	atomic.StoreUint32(&cli.offlineProcessed, 0)
+	cli.resetAppStateResyncs()
 	go func() {
 		count, err := cli.Store.PreKeys.UploadedPreKeyCount()
 		if err != nil {
 			cli.Log.Errorf("Failed to get number of prekeys on server: %v", err)
 		} else if count < WantedPreKeyCount {
 			cli.uploadPreKeys(count)
+		} else {
+			cli.setPreKeyCount(count)
 		}
 		err = cli.SetPassive(false)
 		if err != nil {
 			cli.Log.Warnf("Failed to send post-connect passive IQ: %v", err)
 		}
+		if len(cli.InitialPresence) > 0 {
+			err = cli.SendPresence(cli.InitialPresence)
+			if err != nil {
+				cli.Log.Warnf("Failed to send initial presence: %v", err)
+			}
+		}
+		cli.resubscribePresences()
 		cli.dispatchEvent(&events.Connected{})
+		if cli.outboxEnabled() {
+			cli.replayOutbox(connectedAt)
+		}
 	}()
 }
 
+func (cli *Client) handleIB(node *waBinary.Node) {
+	for _, child := range node.GetChildren() {
+		ag := child.AttrGetter()
+		switch child.Tag {
+		case "offline_preview":
+			evt := &events.OfflineSyncPreview{
+				Total:          ag.OptionalInt("count"),
+				AppDataChanges: ag.OptionalInt("appdata"),
+				Messages:       ag.OptionalInt("message"),
+				Notifications:  ag.OptionalInt("notification"),
+				Receipts:       ag.OptionalInt("receipt"),
+			}
+			cli.Log.Debugf("Server has %d offline items pending (%d messages, %d receipts, %d notifications)",
+				evt.Total, evt.Messages, evt.Receipts, evt.Notifications)
+			cli.dispatchEvent(evt)
+		case "offline":
+			evt := &events.OfflineSyncCompleted{
+				Count:     ag.OptionalInt("count"),
+				Processed: int(atomic.SwapUint32(&cli.offlineProcessed, 0)),
+			}
+			cli.Log.Infof("Offline sync completed, processed %d items", evt.Processed)
+			cli.dispatchEvent(evt)
+		default:
+			cli.handleUnhandledNode(&child)
+		}
+	}
+}
+
 // SetPassive tells the WhatsApp server whether this device is passive or not.
 func (cli *Client) SetPassive(passive bool) error {
 	tag := "active"
@@ -106,8 +225,17 @@ func (cli *Client) SetPassive(passive bool) error {
 // SendPresence updates the user's presence status on WhatsApp.
 //
 // You should call this at least once after connecting so that the server has your pushname.
-// Otherwise, other users will see "-" as the name.
+// Otherwise, other users will see "-" as the name. Alternatively, set Client.InitialPresence
+// to have the presence sent automatically on every connect.
+//
+// Marking yourself as unavailable means you'll appear offline to other users, but it also means
+// the server won't send presence updates of other users to this device.
+//
+// Marking yourself as available requires a push name, so ErrNoPushName is returned if it's not set yet.
 func (cli *Client) SendPresence(state types.Presence) error {
+	if state == types.PresenceAvailable && len(cli.Store.PushName) == 0 {
+		return ErrNoPushName
+	}
 	return cli.sendNode(waBinary.Node{
 		Tag: "presence",
 		Attrs: waBinary.Attrs{
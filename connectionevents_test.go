@@ -0,0 +1,105 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+func TestSendPresenceWithoutPushName(t *testing.T) {
+	url, results := fakeNoiseServer(t)
+	cli := NewClient(&store.Device{Log: waLog.Noop, RegistrationID: 1234}, waLog.Noop)
+	fs := connectToFakeServer(t, cli, url)
+	if err := cli.SendPresence(types.PresenceAvailable); !errors.Is(err, ErrNoPushName) {
+		t.Errorf("Expected ErrNoPushName when going online without a push name, got %v", err)
+	}
+	if err := cli.SendPresence(types.PresenceUnavailable); err != nil {
+		t.Errorf("Expected going offline to work without a push name, got %v", err)
+	}
+	fs.Close(0)
+	if res := <-results; res.frames != 1 {
+		t.Errorf("Expected only the unavailable presence to be sent, got %d frames", res.frames)
+	}
+}
+
+func TestConnectFailureBans(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	cli := NewClient(&store.Device{Container: &fakeDeviceContainer{}}, waLog.Noop)
+	cli.nowFunc = func() time.Time { return now }
+	cli.ReregisterOnLogout = true
+	evts := make(chan interface{}, 10)
+	cli.AddEventHandler(func(evt interface{}) { evts <- evt })
+	nextEvent := func() interface{} {
+		select {
+		case evt := <-evts:
+			return evt
+		case <-time.After(time.Second):
+			t.Fatal("Timed out waiting for event")
+			return nil
+		}
+	}
+
+	cli.EnableAutoReconnect = true
+	cli.handleConnectFailure(&waBinary.Node{Tag: "failure", Attrs: waBinary.Attrs{"reason": "402", "code": "101", "expire": "3600"}})
+	tempBan, ok := nextEvent().(*events.TemporaryBan)
+	if !ok || tempBan.Code != events.TempBanSentToTooManyPeople || tempBan.Expire != time.Hour || !tempBan.Until.Equal(now.Add(time.Hour)) {
+		t.Errorf("Unexpected temporary ban event %+v", tempBan)
+	}
+	if !cli.isExpectedDisconnect {
+		t.Error("Expected temporary ban to disable immediate reconnecting")
+	}
+	if cli.banExpiryReconnect == nil {
+		t.Error("Expected reconnect to be scheduled after the ban expires")
+	}
+	cli.Disconnect()
+	if cli.banExpiryReconnect != nil {
+		t.Error("Expected Disconnect to cancel the scheduled reconnect")
+	}
+
+	cli.isExpectedDisconnect = false
+	cli.handleConnectFailure(&waBinary.Node{Tag: "failure", Attrs: waBinary.Attrs{"reason": "405"}})
+	if _, ok = nextEvent().(*events.ClientOutdated); !ok || !cli.isExpectedDisconnect {
+		t.Error("Expected ClientOutdated event and no reconnect")
+	}
+
+	cli.Store.ID = &types.JID{User: "1234567890", Server: types.DefaultUserServer}
+	cli.handleConnectFailure(&waBinary.Node{Tag: "failure", Attrs: waBinary.Attrs{"reason": "406"}})
+	var banned *events.AccountBanned
+	var loggedOut *events.LoggedOut
+	for i := 0; i < 2; i++ {
+		switch evt := nextEvent().(type) {
+		case *events.AccountBanned:
+			banned = evt
+		case *events.LoggedOut:
+			loggedOut = evt
+		}
+	}
+	if banned == nil || !banned.OnConnect || banned.Code != 406 {
+		t.Errorf("Unexpected account banned event %+v", banned)
+	}
+	if loggedOut == nil {
+		t.Error("Expected LoggedOut event after account ban")
+	}
+	if cli.Store.ID == nil {
+		t.Error("Expected client not to re-register after account ban")
+	}
+
+	cli.isExpectedDisconnect = false
+	cli.handleStreamError(&waBinary.Node{Tag: "stream:error", Attrs: waBinary.Attrs{"code": "403"}})
+	banned, ok = nextEvent().(*events.AccountBanned)
+	if !ok || banned.OnConnect || banned.Code != 403 || !cli.isExpectedDisconnect {
+		t.Errorf("Unexpected account banned event %+v", banned)
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/util/lru"
+)
+
+// DefaultDedupCacheSize is the default value of Client.DedupCacheSize.
+const DefaultDedupCacheSize = 1000
+
+type messageDedupKey struct {
+	Chat   types.JID
+	Sender types.JID
+	ID     types.MessageID
+}
+
+func newMessageDedupKey(info *types.MessageInfo) messageDedupKey {
+	return messageDedupKey{Chat: info.Chat.ToNonAD(), Sender: info.Sender.ToNonAD(), ID: info.ID}
+}
+
+// isDuplicateMessage checks if the given message has already been handled successfully.
+func (cli *Client) isDuplicateMessage(info *types.MessageInfo) bool {
+	cli.dedupLock.Lock()
+	defer cli.dedupLock.Unlock()
+	if cli.dedupCache == nil {
+		return false
+	}
+	_, ok := cli.dedupCache.Get(newMessageDedupKey(info))
+	return ok
+}
+
+// markMessageHandled remembers the given message so that redeliveries of it are ignored.
+//
+// Messages are only marked after they're decrypted and handled, so that redeliveries of
+// messages that failed to decrypt (e.g. after a retry receipt) are still processed.
+func (cli *Client) markMessageHandled(info *types.MessageInfo) {
+	cli.dedupLock.Lock()
+	defer cli.dedupLock.Unlock()
+	if cli.DedupCacheSize <= 0 {
+		return
+	} else if cli.dedupCache == nil {
+		cli.dedupCache = lru.New[messageDedupKey, struct{}](cli.DedupCacheSize)
+	} else {
+		cli.dedupCache.SetMaxSize(cli.DedupCacheSize)
+	}
+	cli.dedupCache.Put(newMessageDedupKey(info), struct{}{})
+}
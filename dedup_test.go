@@ -0,0 +1,57 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+)
+
+func makeTestMessageNode(id string) *waBinary.Node {
+	return &waBinary.Node{
+		Tag: "message",
+		Attrs: waBinary.Attrs{
+			"id":   id,
+			"from": types.NewADJID("9876543210", 0, 1),
+			"t":    "1640000000",
+		},
+	}
+}
+
+func TestDuplicateMessageDelivery(t *testing.T) {
+	ownID := types.NewJID("1234567890", types.DefaultUserServer)
+	cli := &Client{Store: &store.Device{ID: &ownID}, DedupCacheSize: 2}
+
+	info, err := cli.parseMessageInfo(makeTestMessageNode("ABCD"))
+	if err != nil {
+		t.Fatalf("Failed to parse message: %v", err)
+	} else if cli.isDuplicateMessage(info) {
+		t.Fatalf("First delivery of message was marked as duplicate")
+	}
+	cli.markMessageHandled(info)
+
+	redelivered, err := cli.parseMessageInfo(makeTestMessageNode("ABCD"))
+	if err != nil {
+		t.Fatalf("Failed to parse redelivered message: %v", err)
+	} else if !cli.isDuplicateMessage(redelivered) {
+		t.Errorf("Redelivered message wasn't marked as duplicate")
+	}
+
+	for _, id := range []string{"EFGH", "IJKL"} {
+		other, _ := cli.parseMessageInfo(makeTestMessageNode(id))
+		if cli.isDuplicateMessage(other) {
+			t.Errorf("Message %s was marked as duplicate before being handled", id)
+		}
+		cli.markMessageHandled(other)
+	}
+	if cli.isDuplicateMessage(info) {
+		t.Errorf("Message should have been evicted from the cache")
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"time"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// DefaultDeviceCacheTTL is the default value for Client.DeviceCacheTTL.
+const DefaultDeviceCacheTTL = 1 * time.Hour
+
+// DefaultDeviceCacheMaxSize is the default value for Client.DeviceCacheMaxSize.
+const DefaultDeviceCacheMaxSize = 50000
+
+// pendingGroupSendTimeout is how long a sent group message is remembered while waiting for the server ack.
+const pendingGroupSendTimeout = 2 * time.Minute
+
+type deviceCacheEntry struct {
+	devices   []types.JID
+	fetchedAt time.Time
+}
+
+type pendingGroupSend struct {
+	to      types.JID
+	message *waProto.Message
+	phash   string
+	sentAt  time.Time
+	retried bool
+}
+
+// getMessageDevices is like GetUserDevices, but uses the device list cache if it's enabled.
+func (cli *Client) getMessageDevices(jids []types.JID) ([]types.JID, error) {
+	if cli.DeviceCacheTTL <= 0 {
+		return cli.GetUserDevices(jids)
+	}
+	var devices, missing []types.JID
+	cli.deviceCacheLock.Lock()
+	for _, jid := range jids {
+		entry, ok := cli.deviceCache.Get(jid.ToNonAD())
+		if !ok || cli.now().Sub(entry.fetchedAt) > cli.DeviceCacheTTL {
+			missing = append(missing, jid)
+			continue
+		}
+		devices = cli.appendDevicesExceptOwn(devices, entry.devices)
+	}
+	cli.deviceCacheLock.Unlock()
+	if hits := len(jids) - len(missing); hits > 0 {
+		cli.incrCounter(&cli.metrics.deviceCacheHits, MetricDeviceCacheHits, uint64(hits))
+	}
+	if len(missing) == 0 {
+		return devices, nil
+	}
+	cli.incrCounter(&cli.metrics.deviceCacheMisses, MetricDeviceCacheMisses, uint64(len(missing)))
+	fetched, err := cli.fetchDeviceLists(missing)
+	if err != nil {
+		return nil, err
+	}
+	now := cli.now()
+	cli.deviceCacheLock.Lock()
+	cli.deviceCache.SetMaxSize(cli.DeviceCacheMaxSize)
+	for user, userDevices := range fetched {
+		cli.deviceCache.Put(user, &deviceCacheEntry{devices: userDevices, fetchedAt: now})
+		devices = cli.appendDevicesExceptOwn(devices, userDevices)
+	}
+	size := cli.deviceCache.Len()
+	cli.deviceCacheLock.Unlock()
+	cli.setDeviceCacheSize(size)
+	return devices, nil
+}
+
+func (cli *Client) appendDevicesExceptOwn(appendTo, devices []types.JID) []types.JID {
+	for _, device := range devices {
+		if cli.Store.ID == nil || device != *cli.Store.ID {
+			appendTo = append(appendTo, device)
+		}
+	}
+	return appendTo
+}
+
+// InvalidateDeviceCache removes the cached device list of the given user, so that the next message sent to them
+// fetches it from the server again. If a group JID is given, the device lists of all participants in the cached
+// group info are removed, along with the group info itself.
+func (cli *Client) InvalidateDeviceCache(jid types.JID) {
+	if jid.Server == types.GroupServer {
+		var users []types.JID
+		if info := cli.peekCachedGroupInfo(jid); info != nil {
+			for _, participant := range info.Participants {
+				users = append(users, participant.JID)
+			}
+		}
+		cli.invalidateGroupInfoCache(jid)
+		cli.invalidateDeviceLists(users...)
+	} else {
+		cli.invalidateDeviceLists(jid)
+	}
+}
+
+func (cli *Client) invalidateDeviceLists(users ...types.JID) {
+	if len(users) == 0 {
+		return
+	}
+	cli.deviceCacheLock.Lock()
+	for _, user := range users {
+		cli.deviceCache.Remove(user.ToNonAD())
+	}
+	size := cli.deviceCache.Len()
+	cli.deviceCacheLock.Unlock()
+	cli.setDeviceCacheSize(size)
+}
+
+func (cli *Client) handleDeviceNotification(node *waBinary.Node) {
+	from := node.AttrGetter().JID("from")
+	cli.Log.Debugf("Got device list change notification for %s", from)
+	cli.invalidateDeviceLists(from)
+}
+
+// trackGroupSend remembers a sent group message until the server acks it,
+// so that it can be resent if the ack says our participant list was outdated.
+func (cli *Client) trackGroupSend(id types.MessageID, pending *pendingGroupSend) {
+	if cli.DeviceCacheTTL <= 0 {
+		return
+	}
+	cli.pendingGroupSendsLock.Lock()
+	defer cli.pendingGroupSendsLock.Unlock()
+	for oldID, oldPending := range cli.pendingGroupSends {
+		if cli.now().Sub(oldPending.sentAt) > pendingGroupSendTimeout {
+			delete(cli.pendingGroupSends, oldID)
+		}
+	}
+	cli.pendingGroupSends[id] = pending
+}
+
+func (cli *Client) checkGroupSendAck(id types.MessageID, phash string) {
+	cli.pendingGroupSendsLock.Lock()
+	pending, ok := cli.pendingGroupSends[id]
+	delete(cli.pendingGroupSends, id)
+	cli.pendingGroupSendsLock.Unlock()
+	if !ok || len(phash) == 0 || phash == pending.phash {
+		return
+	} else if pending.retried {
+		cli.Log.Warnf("Server returned different participant list hash for %s to %s again, not retrying", id, pending.to)
+		return
+	}
+	cli.Log.Debugf("Server returned different participant list hash for %s to %s, resending with fresh device list", id, pending.to)
+	cli.InvalidateDeviceCache(pending.to)
+	go func() {
+		_, err := cli.sendGroupMessage(pending.to, id, pending.message, true)
+		if err != nil {
+			cli.Log.Warnf("Failed to resend %s to %s after participant list change: %v", id, pending.to, err)
+		}
+	}()
+}
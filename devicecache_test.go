@@ -0,0 +1,48 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/util/lru"
+)
+
+func TestDeviceCache(t *testing.T) {
+	ownDevice := types.NewADJID("1", 0, 2)
+	cli := &Client{
+		Store:          &store.Device{ID: &ownDevice},
+		DeviceCacheTTL: time.Hour,
+		deviceCache:    lru.New[types.JID, *deviceCacheEntry](0),
+	}
+	own := ownDevice.ToNonAD()
+	other := types.NewJID("2", types.DefaultUserServer)
+	cli.deviceCache.Put(own, &deviceCacheEntry{devices: []types.JID{types.NewADJID("1", 0, 0), ownDevice}, fetchedAt: time.Now()})
+	cli.deviceCache.Put(other, &deviceCacheEntry{devices: []types.JID{types.NewADJID("2", 0, 0)}, fetchedAt: time.Now()})
+
+	devices, err := cli.getMessageDevices([]types.JID{other, own})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	} else if len(devices) != 2 || devices[0].User != "2" || devices[1] != types.NewADJID("1", 0, 0) {
+		t.Errorf("Unexpected devices %v", devices)
+	}
+	stats := cli.Stats()
+	if stats.DeviceCacheHits != 2 || stats.DeviceCacheHitRate() != 1 {
+		t.Errorf("Unexpected cache stats %+v", stats)
+	}
+
+	cli.InvalidateDeviceCache(types.NewADJID("2", 0, 5))
+	if _, ok := cli.deviceCache.Peek(other); ok {
+		t.Error("Expected device list to be removed from cache")
+	}
+	if cli.Stats().DeviceCacheSize != 1 {
+		t.Errorf("Expected cache size to be 1, got %d", cli.Stats().DeviceCacheSize)
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func isValidDisappearingTimer(timer time.Duration) bool {
+	switch timer {
+	case DisappearingTimerOff, DisappearingTimer24Hours, DisappearingTimer7Days, DisappearingTimer90Days:
+		return true
+	default:
+		return false
+	}
+}
+
+func (cli *Client) setCachedDefaultDisappearingTimer(timer time.Duration) {
+	cli.defaultDisappearingTimerLock.Lock()
+	cli.defaultDisappearingTimer = &timer
+	cli.defaultDisappearingTimerLock.Unlock()
+}
+
+// SetDefaultDisappearingTimer changes the account-wide default disappearing message timer,
+// which is applied to new chats and groups created by the user.
+//
+// The timer must be one of the DisappearingTimer* constants. DisappearingTimerOff disables the default.
+func (cli *Client) SetDefaultDisappearingTimer(timer time.Duration) error {
+	if !isValidDisappearingTimer(timer) {
+		return fmt.Errorf("%w: %s", ErrInvalidDisappearingTimer, timer)
+	}
+	_, err := cli.sendIQ(infoQuery{
+		Namespace: "disappearing_mode",
+		Type:      "set",
+		To:        types.ServerJID,
+		Content: []waBinary.Node{{
+			Tag:   "disappearing_mode",
+			Attrs: waBinary.Attrs{"duration": strconv.Itoa(int(timer.Seconds()))},
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set default disappearing timer: %w", err)
+	}
+	cli.setCachedDefaultDisappearingTimer(timer)
+	return nil
+}
+
+// GetDefaultDisappearingTimer returns the account-wide default disappearing message timer.
+//
+// The value is cached after the first fetch and kept up to date by disappearing mode notifications.
+func (cli *Client) GetDefaultDisappearingTimer() (time.Duration, error) {
+	cli.defaultDisappearingTimerLock.Lock()
+	cached := cli.defaultDisappearingTimer
+	cli.defaultDisappearingTimerLock.Unlock()
+	if cached != nil {
+		return *cached, nil
+	}
+	resp, err := cli.sendIQ(infoQuery{
+		Namespace: "disappearing_mode",
+		Type:      "get",
+		To:        types.ServerJID,
+		Content:   []waBinary.Node{{Tag: "disappearing_mode"}},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to request default disappearing timer: %w", err)
+	}
+	modeNode, ok := resp.GetOptionalChildByTag("disappearing_mode")
+	if !ok {
+		return 0, fmt.Errorf("disappearing mode response didn't contain disappearing_mode element")
+	}
+	ag := modeNode.AttrGetter()
+	timer := time.Duration(ag.OptionalInt("duration")) * time.Second
+	if !ag.OK() {
+		return 0, fmt.Errorf("failed to parse disappearing mode response: %w", ag.Error())
+	}
+	cli.setCachedDefaultDisappearingTimer(timer)
+	return timer, nil
+}
+
+func (cli *Client) handleDisappearingModeNotification(node *waBinary.Node) {
+	modeNode, ok := node.GetOptionalChildByTag("disappearing_mode")
+	if !ok {
+		cli.Log.Debugf("Disappearing mode notification did not contain 'disappearing_mode' tag")
+		return
+	}
+	ag := modeNode.AttrGetter()
+	timer := time.Duration(ag.OptionalInt("duration")) * time.Second
+	ts := ag.OptionalInt64("t")
+	if ts == 0 {
+		ts = node.AttrGetter().OptionalInt64("t")
+	}
+	if !ag.OK() {
+		cli.Log.Warnf("Failed to parse disappearing mode notification: %v", ag.Error())
+		return
+	}
+	cli.setCachedDefaultDisappearingTimer(timer)
+	cli.dispatchEvent(&events.DisappearingTimerChanged{
+		Timer:     timer,
+		Timestamp: time.Unix(ts, 0),
+	})
+}
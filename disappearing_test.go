@@ -0,0 +1,61 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+func TestDisappearingTimerEvents(t *testing.T) {
+	cli := NewClient(&store.Device{}, waLog.Noop)
+	var evts []interface{}
+	cli.AddEventHandler(func(evt interface{}) {
+		switch evt.(type) {
+		case *events.EphemeralSetting, *events.DisappearingTimerChanged:
+			evts = append(evts, evt)
+		}
+	})
+
+	chat := types.NewJID("1234567890", types.DefaultUserServer)
+	cli.handleProtocolMessage(&types.MessageInfo{MessageSource: types.MessageSource{Chat: chat}}, &waProto.Message{
+		ProtocolMessage: &waProto.ProtocolMessage{
+			Type:                waProto.ProtocolMessage_EPHEMERAL_SETTING.Enum(),
+			EphemeralExpiration: proto.Uint32(uint32(DisappearingTimer7Days.Seconds())),
+		},
+	})
+	if len(evts) != 1 {
+		t.Fatalf("Expected only an EphemeralSetting event for a per-chat change, got %d events", len(evts))
+	} else if evt, ok := evts[0].(*events.EphemeralSetting); !ok || evt.Expiration != DisappearingTimer7Days {
+		t.Errorf("Unexpected event %+v", evts[0])
+	}
+
+	evts = nil
+	cli.handleDisappearingModeNotification(&waBinary.Node{
+		Tag:     "notification",
+		Attrs:   waBinary.Attrs{"type": "disappearing_mode", "t": "1700000000"},
+		Content: []waBinary.Node{{Tag: "disappearing_mode", Attrs: waBinary.Attrs{"duration": "86400"}}},
+	})
+	if len(evts) != 1 {
+		t.Fatalf("Expected a DisappearingTimerChanged event for an account default change, got %d events", len(evts))
+	} else if evt, ok := evts[0].(*events.DisappearingTimerChanged); !ok || evt.Timer != DisappearingTimer24Hours || evt.Timestamp.Unix() != 1700000000 {
+		t.Errorf("Unexpected event %+v", evts[0])
+	}
+	// The cached default must be used without sending an info query (which would panic without a connection).
+	if timer, err := cli.GetDefaultDisappearingTimer(); err != nil || timer != 24*time.Hour {
+		t.Errorf("Expected cached default timer of 24 hours, got %s (%v)", timer, err)
+	}
+}
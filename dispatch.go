@@ -0,0 +1,112 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"runtime/debug"
+	"sync/atomic"
+
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// EventDispatchMode specifies how events are passed to event handlers.
+type EventDispatchMode int
+
+const (
+	// DispatchInline calls event handlers directly from the goroutine that produced the event.
+	// Events from different sources (e.g. messages and app state syncs) may be handled concurrently.
+	DispatchInline EventDispatchMode = iota
+	// DispatchOrdered puts events in a bounded queue and calls event handlers from a single goroutine,
+	// so each event is fully handled before the next one is dispatched.
+	DispatchOrdered
+)
+
+// EventQueueFullBehavior specifies what to do when the event queue of an ordered dispatcher is full.
+type EventQueueFullBehavior int
+
+const (
+	// EventQueueBlock makes the code emitting the event wait until there's space in the queue.
+	// This means slow event handlers will eventually stop the client from reading from the websocket.
+	EventQueueBlock EventQueueFullBehavior = iota
+	// EventQueueDrop drops the event. An events.EventsDropped event is emitted when there's space in the queue again.
+	EventQueueDrop
+)
+
+// DefaultEventQueueSize is the default size of the event queue when using DispatchOrdered.
+const DefaultEventQueueSize = 1024
+
+// ClientOptions contains settings that can only be set when creating a client with NewClientWithOptions.
+type ClientOptions struct {
+	// DispatchMode selects whether event handlers are called inline or from a single ordered dispatcher goroutine.
+	DispatchMode EventDispatchMode
+	// EventQueueSize is the size of the event queue in ordered mode. Defaults to DefaultEventQueueSize.
+	EventQueueSize int
+	// EventQueueFull selects what to do with new events when the event queue is full in ordered mode.
+	EventQueueFull EventQueueFullBehavior
+}
+
+// EventQueueLength returns the number of events waiting to be dispatched.
+// This is always zero when using DispatchInline.
+func (cli *Client) EventQueueLength() int {
+	return len(cli.eventQueue)
+}
+
+func (cli *Client) dispatchEvent(evt interface{}) {
+	if cli.eventQueue == nil {
+		cli.callEventHandlers(evt)
+	} else if cli.eventQueueFull == EventQueueDrop {
+		select {
+		case cli.eventQueue <- evt:
+		default:
+			atomic.AddUint32(&cli.droppedEvents, 1)
+		}
+	} else {
+		cli.eventQueue <- evt
+	}
+}
+
+// eventDispatcherLoop handles events from the queue when using DispatchOrdered.
+// It runs for the lifetime of the client.
+func (cli *Client) eventDispatcherLoop() {
+	for evt := range cli.eventQueue {
+		cli.callEventHandlers(evt)
+		if dropped := atomic.SwapUint32(&cli.droppedEvents, 0); dropped > 0 {
+			cli.Log.Warnf("Dropped %d events because the event queue was full", dropped)
+			cli.callEventHandlers(&events.EventsDropped{Count: int(dropped)})
+		}
+	}
+}
+
+func (cli *Client) callEventHandlers(evt interface{}) {
+	cli.eventHandlersLock.RLock()
+	handlers := cli.eventHandlers
+	cli.eventHandlersLock.RUnlock()
+	for _, handler := range handlers {
+		cli.callEventHandler(handler, evt)
+	}
+}
+
+func (cli *Client) callEventHandler(handler wrappedEventHandler, evt interface{}) {
+	if !cli.DisableHandlerPanicRecovery {
+		defer func() {
+			if val := recover(); val != nil {
+				stack := debug.Stack()
+				cli.Log.Errorf("Event handler registered at %s panicked while handling %T: %v\n%s", handler.registeredAt, evt, val, stack)
+				// Don't report panics that happen while handling the panic event to avoid loops.
+				if _, isPanicEvt := evt.(*events.HandlerPanic); !isPanicEvt {
+					cli.callEventHandlers(&events.HandlerPanic{
+						Event:        evt,
+						Value:        val,
+						Stack:        stack,
+						RegisteredAt: handler.registeredAt,
+					})
+				}
+			}
+		}()
+	}
+	handler.fn(evt)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"strings"
+	"testing"
+
+	"go.mau.fi/whatsmeow/types/events"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+func TestHandlerPanicRecovery(t *testing.T) {
+	cli := &Client{Log: waLog.Noop}
+	var received int
+	var panics []*events.HandlerPanic
+	cli.AddEventHandler(func(evt interface{}) {
+		if _, ok := evt.(*events.Connected); ok {
+			panic("test panic")
+		}
+	})
+	cli.AddEventHandler(func(evt interface{}) {
+		switch typedEvt := evt.(type) {
+		case *events.HandlerPanic:
+			panics = append(panics, typedEvt)
+		case *events.Connected:
+			received++
+		}
+	})
+
+	cli.dispatchEvent(&events.Connected{})
+	cli.dispatchEvent(&events.Connected{})
+
+	if received != 2 {
+		t.Errorf("Expected second handler to receive 2 events, got %d", received)
+	}
+	if len(panics) != 2 {
+		t.Fatalf("Expected 2 panic events, got %d", len(panics))
+	}
+	if panics[0].Value != "test panic" {
+		t.Errorf("Unexpected recovered value %v", panics[0].Value)
+	}
+	if !strings.Contains(panics[0].RegisteredAt, "dispatch_test.go") {
+		t.Errorf("Expected registration site in dispatch_test.go, got %s", panics[0].RegisteredAt)
+	}
+}
@@ -10,9 +10,12 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"os"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
 
@@ -32,6 +35,7 @@ const (
 	MediaAudio    MediaType = "WhatsApp Audio Keys"
 	MediaDocument MediaType = "WhatsApp Document Keys"
 	MediaHistory  MediaType = "WhatsApp History Keys"
+	MediaAppState MediaType = "WhatsApp App State Keys"
 )
 
 // DownloadableMessage represents a protobuf message that contains attachment info.
@@ -70,14 +74,20 @@ var classToMediaType = map[protoreflect.Name]MediaType{
 }
 
 var mediaTypeToMMSType = map[MediaType]string{
-	MediaHistory: "md-msg-hist",
+	MediaImage:    "image",
+	MediaAudio:    "audio",
+	MediaVideo:    "video",
+	MediaDocument: "document",
+	MediaHistory:  "md-msg-hist",
+	MediaAppState: "md-app-state",
 }
 
 // DownloadAny loops through the downloadable parts of the given message and downloads the first non-nil item.
 func (cli *Client) DownloadAny(msg *waProto.Message) (data []byte, err error) {
 	downloadables := []DownloadableMessage{msg.GetImageMessage(), msg.GetAudioMessage(), msg.GetVideoMessage(), msg.GetDocumentMessage(), msg.GetStickerMessage()}
 	for _, downloadable := range downloadables {
-		if downloadable != nil {
+		// The getters return typed nil pointers, which aren't nil as interfaces, so check the message itself
+		if downloadable.ProtoReflect().IsValid() {
 			return cli.Download(downloadable)
 		}
 	}
@@ -85,6 +95,10 @@ func (cli *Client) DownloadAny(msg *waProto.Message) (data []byte, err error) {
 }
 
 // Download downloads the attachment from the given protobuf message.
+//
+// The attachment is downloaded from the URL in the message if there is one. If the URL is missing
+// (which is common with older messages), or if downloading from it fails (e.g. because the URL has expired
+// or the CDN host is down), the direct path is tried with each media host, see Client.MediaDownloadRetries.
 func (cli *Client) Download(msg DownloadableMessage) (data []byte, err error) {
 	mediaType, ok := classToMediaType[msg.ProtoReflect().Descriptor().Name()]
 	if !ok {
@@ -92,7 +106,16 @@ func (cli *Client) Download(msg DownloadableMessage) (data []byte, err error) {
 	}
 	urlable, ok := msg.(downloadableMessageWithURL)
 	if ok && len(urlable.GetUrl()) > 0 {
-		return downloadAndDecrypt(urlable.GetUrl(), msg.GetMediaKey(), mediaType, int(msg.GetFileLength()), msg.GetFileEncSha256(), msg.GetFileSha256())
+		data, err = downloadAndDecrypt(urlable.GetUrl(), msg.GetMediaKey(), mediaType, int(msg.GetFileLength()), msg.GetFileEncSha256(), msg.GetFileSha256())
+		if err == nil {
+			cli.incrCounter(&cli.metrics.mediaBytesDownloaded, MetricMediaBytesDownloaded, uint64(len(data)))
+			return data, nil
+		} else if len(msg.GetDirectPath()) == 0 {
+			return nil, err
+		}
+		// The URL may have expired or its CDN host may be down, so try the direct path with all media hosts
+		cli.Log.Debugf("Failed to download media from URL (%v), falling back to direct path", err)
+		return cli.downloadMediaWithPath(msg.GetDirectPath(), msg.GetFileEncSha256(), msg.GetFileSha256(), msg.GetMediaKey(), int(msg.GetFileLength()), mediaType, mediaTypeToMMSType[mediaType])
 	} else if len(msg.GetDirectPath()) > 0 {
 		return cli.downloadMediaWithPath(msg.GetDirectPath(), msg.GetFileEncSha256(), msg.GetFileSha256(), msg.GetMediaKey(), int(msg.GetFileLength()), mediaType, mediaTypeToMMSType[mediaType])
 	} else {
@@ -101,22 +124,32 @@ func (cli *Client) Download(msg DownloadableMessage) (data []byte, err error) {
 }
 
 func (cli *Client) downloadMediaWithPath(directPath string, encFileHash, fileHash, mediaKey []byte, fileLength int, mediaType MediaType, mmsType string) (data []byte, err error) {
-	err = cli.refreshMediaConn(false)
+	mediaConn, err := cli.refreshMediaConn(false)
 	if err != nil {
 		return nil, fmt.Errorf("failed to refresh media connections: %w", err)
+	} else if len(mediaConn.Hosts) == 0 {
+		return nil, fmt.Errorf("no media hosts available")
 	}
-	for i, host := range cli.mediaConn.Hosts {
-		mediaURL := fmt.Sprintf("https://%s%s&hash=%s&mms-type=%s&__wa-mms=", host.Hostname, directPath, base64.URLEncoding.EncodeToString(encFileHash), mmsType)
-		data, err = downloadAndDecrypt(mediaURL, mediaKey, mediaType, fileLength, encFileHash, fileHash)
-		// TODO there are probably some errors that shouldn't retry
-		if err != nil {
-			if i >= len(cli.mediaConn.Hosts)-1 {
-				return nil, fmt.Errorf("failed to download media from last host: %w", err)
+	for attempt := 0; attempt <= cli.MediaDownloadRetries; attempt++ {
+		for i, host := range mediaConn.Hosts {
+			mediaURL := fmt.Sprintf("https://%s%s&hash=%s&mms-type=%s&__wa-mms=", host.Hostname, directPath, base64.URLEncoding.EncodeToString(encFileHash), mmsType)
+			data, err = downloadAndDecrypt(mediaURL, mediaKey, mediaType, fileLength, encFileHash, fileHash)
+			if err == nil {
+				cli.incrCounter(&cli.metrics.mediaBytesDownloaded, MetricMediaBytesDownloaded, uint64(len(data)))
+				return data, nil
+			} else if errors.Is(err, ErrMediaDownloadFailedWith404) || errors.Is(err, ErrMediaDownloadFailedWith410) {
+				// The media doesn't exist anymore, so other hosts won't have it either
+				return nil, err
 			}
-			cli.Log.Warnf("Failed to download media: %s, trying with next host...", err)
+			if i < len(mediaConn.Hosts)-1 {
+				cli.Log.Warnf("Failed to download media from %s: %v, trying with next host...", host.Hostname, err)
+			}
+		}
+		if attempt < cli.MediaDownloadRetries {
+			cli.Log.Warnf("Failed to download media from all hosts: %v, retrying (%d/%d)...", err, attempt+1, cli.MediaDownloadRetries)
 		}
 	}
-	return
+	return nil, fmt.Errorf("failed to download media from last host: %w", err)
 }
 
 func downloadAndDecrypt(url string, mediaKey []byte, appInfo MediaType, fileLength int, fileEncSha256, fileSha256 []byte) (data []byte, err error) {
@@ -141,20 +174,27 @@ func getMediaKeys(mediaKey []byte, appInfo MediaType) (iv, cipherKey, macKey, re
 	return mediaKeyExpanded[:16], mediaKeyExpanded[16:48], mediaKeyExpanded[48:80], mediaKeyExpanded[80:]
 }
 
+func checkMediaDownloadStatus(resp *http.Response) error {
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return ErrMediaDownloadFailedWith404
+	case http.StatusGone:
+		return ErrMediaDownloadFailedWith410
+	default:
+		return fmt.Errorf("download failed with status code %d", resp.StatusCode)
+	}
+}
+
 func downloadEncryptedMedia(url string, checksum []byte) (file, mac []byte, err error) {
 	resp, err := http.Get(url)
 	if err != nil {
 		return nil, nil, err
 	}
 	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		if resp.StatusCode == http.StatusNotFound {
-			return nil, nil, ErrMediaDownloadFailedWith404
-		}
-		if resp.StatusCode == http.StatusGone {
-			return nil, nil, ErrMediaDownloadFailedWith410
-		}
-		return nil, nil, fmt.Errorf("download failed with status code %d", resp.StatusCode)
+	if err = checkMediaDownloadStatus(resp); err != nil {
+		return nil, nil, err
 	}
 	if resp.ContentLength <= 10 {
 		return nil, nil, ErrTooShortFile
@@ -178,3 +218,130 @@ func validateMedia(iv, file, macKey, mac []byte) error {
 	}
 	return nil
 }
+
+// downloadMediaStreamWithPath is like downloadMediaWithPath, but the file isn't buffered in memory. Instead, the
+// encrypted file is downloaded into a temporary file, and the returned reader decrypts it on the fly.
+//
+// The hash and MAC of the encrypted file are checked before returning. The hash and length of the decrypted data
+// are checked when the end of the reader is reached, so the reader must be read until io.EOF.
+func (cli *Client) downloadMediaStreamWithPath(directPath string, encFileHash, fileHash, mediaKey []byte, fileLength int, mediaType MediaType, mmsType string) (io.ReadCloser, error) {
+	mediaConn, err := cli.refreshMediaConn(false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh media connections: %w", err)
+	} else if len(mediaConn.Hosts) == 0 {
+		return nil, fmt.Errorf("no media hosts available")
+	}
+	iv, cipherKey, macKey, _ := getMediaKeys(mediaKey, mediaType)
+	for attempt := 0; attempt <= cli.MediaDownloadRetries; attempt++ {
+		for i, host := range mediaConn.Hosts {
+			mediaURL := fmt.Sprintf("https://%s%s&hash=%s&mms-type=%s&__wa-mms=", host.Hostname, directPath, base64.URLEncoding.EncodeToString(encFileHash), mmsType)
+			var file *os.File
+			var length int64
+			file, length, err = downloadEncryptedMediaToFile(mediaURL, encFileHash, iv, macKey)
+			if err == nil {
+				cli.incrCounter(&cli.metrics.mediaBytesDownloaded, MetricMediaBytesDownloaded, uint64(fileLength))
+				return decryptMediaStream(file, length, iv, cipherKey, fileLength, fileHash), nil
+			} else if errors.Is(err, ErrMediaDownloadFailedWith404) || errors.Is(err, ErrMediaDownloadFailedWith410) {
+				return nil, err
+			}
+			if i < len(mediaConn.Hosts)-1 {
+				cli.Log.Warnf("Failed to download media from %s: %v, trying with next host...", host.Hostname, err)
+			}
+		}
+		if attempt < cli.MediaDownloadRetries {
+			cli.Log.Warnf("Failed to download media from all hosts: %v, retrying (%d/%d)...", err, attempt+1, cli.MediaDownloadRetries)
+		}
+	}
+	return nil, fmt.Errorf("failed to download media from last host: %w", err)
+}
+
+// downloadEncryptedMediaToFile downloads the given encrypted media into a temporary file and checks its hash and MAC.
+// The returned length is the length of the ciphertext, i.e. the file size without the MAC at the end.
+func downloadEncryptedMediaToFile(url string, checksum, iv, macKey []byte) (file *os.File, length int64, err error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	if err = checkMediaDownloadStatus(resp); err != nil {
+		return nil, 0, err
+	}
+	file, err = os.CreateTemp("", "whatsmeow-media-*")
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = file.Close()
+			_ = os.Remove(file.Name())
+			file = nil
+		}
+	}()
+	hasher := sha256.New()
+	size, err := io.Copy(io.MultiWriter(file, hasher), resp.Body)
+	if err != nil {
+		return
+	} else if size <= 10 {
+		err = ErrTooShortFile
+		return
+	} else if len(checksum) == 32 && !hmac.Equal(hasher.Sum(nil), checksum) {
+		err = ErrInvalidMediaEncSHA256
+		return
+	}
+	// The MAC is in the last 10 bytes, so it can only be checked after the whole file has been downloaded.
+	length = size - 10
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return
+	}
+	h := hmac.New(sha256.New, macKey)
+	h.Write(iv)
+	if _, err = io.CopyN(h, file, length); err != nil {
+		return
+	}
+	mac := make([]byte, 10)
+	if _, err = io.ReadFull(file, mac); err != nil {
+		return
+	} else if !hmac.Equal(h.Sum(nil)[:10], mac) {
+		err = ErrInvalidMediaHMAC
+		return
+	}
+	_, err = file.Seek(0, io.SeekStart)
+	return
+}
+
+type decryptedMediaStream struct {
+	*io.PipeReader
+	file *os.File
+}
+
+// Close stops the decryption and removes the temporary file.
+func (stream *decryptedMediaStream) Close() error {
+	_ = stream.PipeReader.Close()
+	_ = stream.file.Close()
+	return os.Remove(stream.file.Name())
+}
+
+type byteCounter int64
+
+func (bc *byteCounter) Write(p []byte) (int, error) {
+	*bc += byteCounter(len(p))
+	return len(p), nil
+}
+
+func decryptMediaStream(file *os.File, length int64, iv, cipherKey []byte, fileLength int, fileSha256 []byte) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		hasher := sha256.New()
+		var written byteCounter
+		err := cbcutil.DecryptStream(cipherKey, iv, io.LimitReader(file, length), io.MultiWriter(pw, hasher, &written))
+		if err != nil {
+			err = fmt.Errorf("failed to decrypt file: %w", err)
+		} else if int(written) != fileLength {
+			err = fmt.Errorf("%w: expected %d, got %d", ErrFileLengthMismatch, fileLength, written)
+		} else if len(fileSha256) == 32 && !hmac.Equal(hasher.Sum(nil), fileSha256) {
+			err = ErrInvalidMediaSHA256
+		}
+		_ = pw.CloseWithError(err)
+	}()
+	return &decryptedMediaStream{PipeReader: pr, file: file}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+// fakeMediaServer is a media host that accepts uploads and serves them from their direct path.
+type fakeMediaServer struct {
+	*httptest.Server
+	files     map[string][]byte
+	failFirst int // The number of downloads to fail with a server error before serving files.
+	downloads int
+	lock      sync.Mutex
+}
+
+func (fms *fakeMediaServer) Host() string {
+	return strings.TrimPrefix(fms.URL, "https://")
+}
+
+func (fms *fakeMediaServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fms.lock.Lock()
+	defer fms.lock.Unlock()
+	if r.Method == http.MethodPost {
+		data, _ := io.ReadAll(r.Body)
+		token := r.URL.Path[strings.LastIndexByte(r.URL.Path, '/')+1:]
+		fms.files["/direct/"+token] = data
+		_ = json.NewEncoder(w).Encode(&UploadResponse{
+			URL:        fms.URL + "/direct/" + token + "?mms-type=document",
+			DirectPath: "/direct/" + token + "?ccb=11-4",
+		})
+		return
+	}
+	fms.downloads++
+	if fms.downloads <= fms.failFirst {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	data, ok := fms.files[r.URL.Path]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	_, _ = w.Write(data)
+}
+
+// newFakeMediaServer starts a fake media host and makes the default HTTP client trust it for the rest of the test.
+func newFakeMediaServer(t *testing.T) *fakeMediaServer {
+	fms := &fakeMediaServer{files: make(map[string][]byte)}
+	fms.Server = httptest.NewTLSServer(fms)
+	t.Cleanup(fms.Close)
+	origClient := http.DefaultClient
+	http.DefaultClient = fms.Client()
+	t.Cleanup(func() { http.DefaultClient = origClient })
+	return fms
+}
+
+func setTestMediaHosts(cli *Client, hosts ...*fakeMediaServer) {
+	mediaConn := &MediaConn{Auth: "auth", TTL: 3600, FetchedAt: time.Now()}
+	for _, host := range hosts {
+		mediaConn.Hosts = append(mediaConn.Hosts, MediaConnHost{Hostname: host.Host()})
+	}
+	cli.mediaConn = mediaConn
+}
+
+func TestDownloadFallsBackToOtherHosts(t *testing.T) {
+	good := newFakeMediaServer(t)
+	flaky := newFakeMediaServer(t)
+	flaky.failFirst = 1 << 30
+	cli := NewClient(&store.Device{}, waLog.Noop)
+	data := bytes.Repeat([]byte("document data "), 100)
+	setTestMediaHosts(cli, good)
+	uploaded, err := cli.Upload(context.Background(), data, MediaDocument)
+	if err != nil {
+		t.Fatalf("Failed to upload: %v", err)
+	}
+	doc := &waProto.DocumentMessage{
+		// The URL points at a host that's currently failing with server errors
+		Url:           proto.String(strings.Replace(uploaded.URL, good.Host(), flaky.Host(), 1)),
+		DirectPath:    proto.String(uploaded.DirectPath),
+		MediaKey:      uploaded.MediaKey,
+		FileEncSha256: uploaded.FileEncSHA256,
+		FileSha256:    uploaded.FileSHA256,
+		FileLength:    proto.Uint64(uint64(len(data))),
+	}
+
+	setTestMediaHosts(cli, flaky, good)
+	downloaded, err := cli.Download(doc)
+	if err != nil {
+		t.Fatalf("Expected download to fall back to the working media host, got %v", err)
+	} else if !bytes.Equal(downloaded, data) {
+		t.Error("Downloaded data doesn't match uploaded data")
+	}
+	if flaky.downloads != 2 || good.downloads != 1 {
+		t.Errorf("Expected the URL and the direct path on the failing host to be tried first, got %d/%d downloads", flaky.downloads, good.downloads)
+	}
+
+	// If every host fails once, only MediaDownloadRetries makes the download succeed
+	good.failFirst = good.downloads + 1
+	setTestMediaHosts(cli, good)
+	if _, err = cli.Download(doc); err == nil {
+		t.Error("Expected download to fail without retries")
+	}
+	good.failFirst = good.downloads + 1
+	cli.MediaDownloadRetries = 1
+	if downloaded, err = cli.Download(doc); err != nil || !bytes.Equal(downloaded, data) {
+		t.Errorf("Expected download to succeed after retrying, got %v", err)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"go.mau.fi/whatsmeow/socket"
+)
+
+// ConnectedEndpoint returns the websocket URL that the client is currently connected to,
+// or an empty string if the client isn't connected.
+func (cli *Client) ConnectedEndpoint() string {
+	if !cli.IsConnected() {
+		return ""
+	}
+	cli.endpointLock.Lock()
+	defer cli.endpointLock.Unlock()
+	return cli.connectedEndpoint
+}
+
+// orderedEndpoints returns WSEndpoints sorted by the number of consecutive failures,
+// keeping the configured order for endpoints with the same number of failures.
+func (cli *Client) orderedEndpoints() []string {
+	if len(cli.WSEndpoints) == 0 {
+		return []string{socket.URL}
+	}
+	endpoints := make([]string, len(cli.WSEndpoints))
+	copy(endpoints, cli.WSEndpoints)
+	cli.endpointLock.Lock()
+	defer cli.endpointLock.Unlock()
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		return cli.endpointFailures[endpoints[i]] < cli.endpointFailures[endpoints[j]]
+	})
+	return endpoints
+}
+
+func (cli *Client) markEndpointResult(endpoint string, success bool) {
+	cli.endpointLock.Lock()
+	defer cli.endpointLock.Unlock()
+	if cli.endpointFailures == nil {
+		cli.endpointFailures = make(map[string]int)
+	}
+	if success {
+		delete(cli.endpointFailures, endpoint)
+		cli.connectedEndpoint = endpoint
+	} else {
+		cli.endpointFailures[endpoint]++
+	}
+}
+
+// dialEndpoints tries to open a websocket to each endpoint until one of them succeeds.
+func (cli *Client) dialEndpoints() (*socket.FrameSocket, error) {
+	var errs []error
+	for _, endpoint := range cli.orderedEndpoints() {
+		fs := socket.NewFrameSocket(cli.Log.Sub("Socket"), socket.WAConnHeader)
+		fs.URL = endpoint
+		err := fs.Connect()
+		if err == nil {
+			cli.markEndpointResult(endpoint, true)
+			return fs, nil
+		}
+		fs.Close(0)
+		cli.markEndpointResult(endpoint, false)
+		if len(cli.WSEndpoints) > 1 {
+			cli.Log.Warnf("Failed to connect to %s: %v", endpoint, err)
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", endpoint, err))
+	}
+	if len(errs) == 1 {
+		return nil, errors.Unwrap(errs[0])
+	}
+	return nil, fmt.Errorf("failed to connect to any endpoint: %w", errors.Join(errs...))
+}
@@ -0,0 +1,28 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOrderedEndpoints(t *testing.T) {
+	cli := &Client{WSEndpoints: []string{"wss://a", "wss://b", "wss://c"}}
+	cli.markEndpointResult("wss://a", false)
+	cli.markEndpointResult("wss://a", false)
+	cli.markEndpointResult("wss://b", false)
+	endpoints := cli.orderedEndpoints()
+	if strings.Join(endpoints, " ") != "wss://c wss://b wss://a" {
+		t.Errorf("Unexpected endpoint order %v", endpoints)
+	}
+	cli.markEndpointResult("wss://a", true)
+	endpoints = cli.orderedEndpoints()
+	if strings.Join(endpoints, " ") != "wss://a wss://c wss://b" {
+		t.Errorf("Unexpected endpoint order after success %v", endpoints)
+	}
+}
@@ -8,6 +8,12 @@ package whatsmeow
 
 import (
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/types"
 )
 
 // Miscellaneous errors
@@ -19,19 +25,133 @@ var (
 	ErrIQDisconnected       = errors.New("websocket disconnected before info query returned response")
 
 	ErrAlreadyConnected = errors.New("websocket is already connected")
+	ErrNoPushName       = errors.New("can't send presence without push name set")
+	ErrNotLoggedIn      = errors.New("the store doesn't contain a device JID")
+
+	ErrPrimaryDeviceOffline = errors.New("the primary device didn't respond in time, it's probably offline")
+
+	ErrUnknownHistorySyncAnchor = errors.New("history sync anchor message is unknown")
+	ErrHistorySyncLimitReached  = errors.New("the primary device has no older history to send for this chat")
+
+	ErrNoAppStateKey         = errors.New("no app state keys found, can't send app state patch")
+	ErrAppStatePatchRejected = errors.New("server rejected app state patch")
+	ErrAppStatePatchConflict = errors.New("app state patch conflicts with newer changes on the server")
+
+	ErrNoReactionStore = errors.New("the device store doesn't have a reaction store")
+
+	ErrNoMsgSecretStore              = errors.New("the device store doesn't have a message secret store")
+	ErrOriginalMessageSecretNotFound = errors.New("original message secret key not found")
+	ErrNoPollStore                   = errors.New("the device store doesn't have a poll store")
+	ErrUnknownPoll                   = errors.New("poll is not known")
+	ErrNotPollUpdateMessage          = errors.New("given message isn't a poll update message")
 )
 
+// IQError is returned by info queries that the server responded to with an error element.
+//
+// The error can be compared to ErrIQError and the ErrIQ* code errors below with errors.Is.
+type IQError struct {
+	Code      int
+	Text      string
+	ErrorNode *waBinary.Node
+	RawNode   *waBinary.Node
+}
+
+// Common errors returned by info queries for use with errors.Is
+var (
+	ErrIQNotModified    error = &IQError{Code: 304, Text: "not-modified"}
+	ErrIQBadRequest     error = &IQError{Code: 400, Text: "bad-request"}
+	ErrIQNotAuthorized  error = &IQError{Code: 401, Text: "not-authorized"}
+	ErrIQForbidden      error = &IQError{Code: 403, Text: "forbidden"}
+	ErrIQNotFound       error = &IQError{Code: 404, Text: "item-not-found"}
+	ErrIQNotAcceptable  error = &IQError{Code: 406, Text: "not-acceptable"}
+	ErrIQConflict       error = &IQError{Code: 409, Text: "conflict"}
+	ErrIQGone           error = &IQError{Code: 410, Text: "gone"}
+	ErrIQResourceLimit  error = &IQError{Code: 419, Text: "resource-limit"}
+	ErrIQInternalServer error = &IQError{Code: 500, Text: "internal-server-error"}
+)
+
+func parseIQError(node *waBinary.Node) error {
+	var err IQError
+	err.RawNode = node
+	errorNode, ok := node.GetOptionalChildByTag("error")
+	if ok {
+		err.ErrorNode = &errorNode
+		ag := errorNode.AttrGetter()
+		err.Code = ag.OptionalInt("code")
+		err.Text = ag.OptionalString("text")
+	}
+	return &err
+}
+
+func (iqe *IQError) Error() string {
+	if iqe.Code == 0 {
+		if iqe.ErrorNode != nil {
+			return fmt.Sprintf("%s: %s", ErrIQError.Error(), iqe.ErrorNode.XMLString())
+		} else if iqe.RawNode != nil {
+			return fmt.Sprintf("%s: %s", ErrIQError.Error(), iqe.RawNode.XMLString())
+		}
+		return ErrIQError.Error()
+	}
+	return fmt.Sprintf("%s %d: %s", ErrIQError.Error(), iqe.Code, iqe.Text)
+}
+
+// Is returns true if the other error is ErrIQError or an IQError with the same code.
+func (iqe *IQError) Is(other error) bool {
+	if other == ErrIQError {
+		return true
+	}
+	otherIQE, ok := other.(*IQError)
+	if !ok {
+		return false
+	} else if iqe.Code != 0 {
+		return otherIQE.Code == iqe.Code
+	} else {
+		return otherIQE.Code == 0 && otherIQE.Text == iqe.Text
+	}
+}
+
+// Some errors that Client.GetProfilePictureInfo can return
 var (
 	ErrProfilePictureUnauthorized = errors.New("the user has hidden their profile picture from you")
+	ErrProfilePictureNotChanged   = errors.New("the profile picture has not changed")
 )
 
+// ErrStatusTooLong is returned by Client.SetStatusMessage if the text is longer than MaxStatusMessageLength.
+var ErrStatusTooLong = errors.New("status message is too long")
+
 // Some errors that Client.SendMessage can return
 var (
 	ErrBroadcastListUnsupported = errors.New("sending to broadcast lists is not yet supported")
 	ErrUnknownServer            = errors.New("can't send message to unknown server")
 	ErrRecipientADJID           = errors.New("message recipient must be normal (non-AD) JID")
+	ErrMentionNotUser           = errors.New("mentioned JID must be a user")
+	ErrGroupAnnounceOnly        = errors.New("only admins can send messages to this group")
+	ErrTooManySendExtras        = errors.New("only one extra parameter may be provided to SendMessage")
 )
 
+// ErrOutboxAttemptsExceeded is the error in events.MessageRetried when a message is dropped from the outbox
+// because it has already been resent Client.MaxOutboxAttempts times.
+var ErrOutboxAttemptsExceeded = errors.New("message was resent from the outbox too many times")
+
+// MissingPreKeysError is returned by Client.SendMessage if none of the recipient devices could be encrypted for,
+// because they didn't have a Signal session yet and no prekeys could be fetched to establish one.
+//
+// If only some of the devices are missing prekeys, the message is sent to the other ones and the missing devices
+// are listed in SendResponse.MissingDevices instead.
+type MissingPreKeysError struct {
+	// The devices that the message wasn't encrypted for, and the reason why fetching prekeys failed for each one.
+	Devices map[types.JID]error
+}
+
+func (mpe *MissingPreKeysError) Error() string {
+	devices := make([]string, 0, len(mpe.Devices))
+	for jid := range mpe.Devices {
+		devices = append(devices, jid.String())
+	}
+	sort.Strings(devices)
+	return fmt.Sprintf("message wasn't sent: no prekeys available for %d devices: %s", len(devices), strings.Join(devices, ", "))
+}
+
 // Some errors that Client.Download can return
 var (
 	ErrMediaDownloadFailedWith404 = errors.New("download failed with status code 404")
@@ -45,3 +165,42 @@ var (
 	ErrUnknownMediaType           = errors.New("unknown media type")
 	ErrNothingDownloadableFound   = errors.New("didn't find any attachments in message")
 )
+
+// Some errors that the media sending methods can return
+var (
+	ErrUnsupportedMediaType = errors.New("unsupported media type")
+	ErrStickerNotWebP       = errors.New("sticker must be a WebP image")
+	ErrStickerTooLarge      = errors.New("sticker file is too large")
+	ErrStickerBadHeader     = errors.New("failed to parse WebP sticker header")
+)
+
+// Some errors that the interactive message sending methods can return
+var (
+	ErrNoButtons             = errors.New("interactive message must have at least one button or list row")
+	ErrTooManyButtons        = fmt.Errorf("interactive message can't have more than %d buttons", MaxQuickReplyButtons)
+	ErrTooManyListRows       = fmt.Errorf("list message can't have more than %d rows", MaxListRows)
+	ErrMissingButtonID       = errors.New("interactive message buttons and list rows must have an ID")
+	ErrMissingListButtonText = errors.New("list message must have a button text")
+)
+
+// Some errors that the business methods can return
+var (
+	ErrNotBusinessAccount = errors.New("that user is not a business account")
+)
+
+// Some errors that the newsletter methods can return
+var (
+	ErrNewsletterNotFound = errors.New("that newsletter does not exist")
+)
+
+// Some errors that the group methods can return
+var (
+	ErrGroupNotAdmin               = errors.New("you must be an admin of the group to do that")
+	ErrInvalidDisappearingTimer    = errors.New("invalid disappearing timer, must be off, 24 hours, 7 days or 90 days")
+	ErrGroupInviteLinkUnauthorized = errors.New("you don't have the permission to get the group's invite link")
+	ErrInviteLinkInvalid           = errors.New("that group invite link is not valid")
+	ErrInviteLinkRevoked           = errors.New("that group invite link has been revoked")
+	ErrGroupNotFound               = errors.New("that group does not exist")
+	ErrGroupInviteExpired          = errors.New("that group invite has expired")
+	ErrGroupJoinApprovalPending    = errors.New("the group requires admin approval to join, the request is now pending")
+)
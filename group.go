@@ -7,26 +7,546 @@
 package whatsmeow
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
 	"go.mau.fi/whatsmeow/types"
 	"go.mau.fi/whatsmeow/types/events"
 )
 
-// GetGroupInfo requests basic info about a group chat from the WhatsApp servers.
-func (cli *Client) GetGroupInfo(jid types.JID) (*types.GroupInfo, error) {
-	res, err := cli.sendIQ(infoQuery{
+// InviteLinkPrefix is the prefix of all group invite links.
+const InviteLinkPrefix = "https://chat.whatsapp.com/"
+
+func (cli *Client) sendGroupIQ(iqType string, jid types.JID, content waBinary.Node) (*waBinary.Node, error) {
+	return cli.sendIQ(infoQuery{
 		Namespace: "w:g2",
-		Type:      "get",
+		Type:      iqType,
 		To:        jid,
+		Content:   []waBinary.Node{content},
+	})
+}
+
+// GetGroupInviteLink requests the invite link to the group from the WhatsApp servers.
+//
+// If reset is true, then the old invite link will be revoked and a new one will be generated.
+func (cli *Client) GetGroupInviteLink(jid types.JID, reset bool) (string, error) {
+	iqType := "get"
+	if reset {
+		iqType = "set"
+	}
+	resp, err := cli.sendGroupIQ(iqType, jid, waBinary.Node{Tag: "invite"})
+	if errors.Is(err, ErrIQNotAuthorized) {
+		return "", fmt.Errorf("%w: %v", ErrGroupInviteLinkUnauthorized, err)
+	} else if errors.Is(err, ErrIQNotFound) {
+		return "", fmt.Errorf("%w: %v", ErrGroupNotFound, err)
+	} else if err != nil {
+		return "", fmt.Errorf("failed to request group invite link: %w", err)
+	}
+	code, ok := resp.GetChildByTag("invite").Attrs["code"].(string)
+	if !ok {
+		return "", fmt.Errorf("didn't find invite code in response")
+	}
+	return InviteLinkPrefix + code, nil
+}
+
+func parseInviteCode(code string) string {
+	return strings.TrimPrefix(code, InviteLinkPrefix)
+}
+
+// GetGroupInfoFromLink resolves the given invite link and asks the WhatsApp servers for info about the group.
+// This will not cause the user to join the group.
+//
+// Both the full link (https://chat.whatsapp.com/...) and the bare code are accepted.
+func (cli *Client) GetGroupInfoFromLink(code string) (*types.GroupInfo, error) {
+	resp, err := cli.sendGroupIQ("get", types.GroupServerJID, waBinary.Node{
+		Tag:   "invite",
+		Attrs: waBinary.Attrs{"code": parseInviteCode(code)},
+	})
+	if errors.Is(err, ErrIQGone) {
+		return nil, fmt.Errorf("%w: %v", ErrInviteLinkRevoked, err)
+	} else if errors.Is(err, ErrIQNotAcceptable) {
+		return nil, fmt.Errorf("%w: %v", ErrInviteLinkInvalid, err)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to request invite link info: %w", err)
+	}
+	groupNode, ok := resp.GetOptionalChildByTag("group")
+	if !ok {
+		return nil, fmt.Errorf("invite link info request didn't return group info")
+	}
+	return cli.parseGroupNode(&groupNode)
+}
+
+// JoinGroupWithLink joins the group using the given invite link.
+//
+// If the group requires admins to approve new members, the returned error will be
+// ErrGroupJoinApprovalPending and the JID will be of the group that the request was sent to.
+func (cli *Client) JoinGroupWithLink(code string) (types.JID, error) {
+	resp, err := cli.sendGroupIQ("set", types.GroupServerJID, waBinary.Node{
+		Tag:   "invite",
+		Attrs: waBinary.Attrs{"code": parseInviteCode(code)},
+	})
+	if errors.Is(err, ErrIQGone) {
+		return types.EmptyJID, fmt.Errorf("%w: %v", ErrInviteLinkRevoked, err)
+	} else if errors.Is(err, ErrIQNotAcceptable) {
+		return types.EmptyJID, fmt.Errorf("%w: %v", ErrInviteLinkInvalid, err)
+	} else if err != nil {
+		return types.EmptyJID, fmt.Errorf("failed to join group with invite link: %w", err)
+	}
+	if approvalNode, ok := resp.GetOptionalChildByTag("membership_approval_request"); ok {
+		return approvalNode.AttrGetter().JID("jid"), ErrGroupJoinApprovalPending
+	}
+	groupNode, ok := resp.GetOptionalChildByTag("group")
+	if !ok {
+		return types.EmptyJID, fmt.Errorf("join group response didn't contain group element")
+	}
+	return groupNode.AttrGetter().JID("jid"), nil
+}
+
+// JoinGroupWithInvite joins a group using an invite message (as opposed to an invite link).
+//
+// The parameters correspond to the fields of a GroupInviteMessage: the group JID, the user who
+// sent the invite, the invite code and the expiration timestamp of the invite.
+func (cli *Client) JoinGroupWithInvite(jid, inviter types.JID, code string, expiration int64) error {
+	if expiration > 0 && time.Unix(expiration, 0).Before(cli.now()) {
+		return ErrGroupInviteExpired
+	}
+	_, err := cli.sendGroupIQ("set", jid, waBinary.Node{
+		Tag: "accept",
+		Attrs: waBinary.Attrs{
+			"code":       code,
+			"expiration": strconv.FormatInt(expiration, 10),
+			"admin":      inviter,
+		},
+	})
+	if errors.Is(err, ErrIQGone) {
+		return fmt.Errorf("%w: %v", ErrGroupInviteExpired, err)
+	} else if err != nil {
+		return fmt.Errorf("failed to accept group invite: %w", err)
+	}
+	return nil
+}
+
+// JoinGroupWithInviteMessage is a helper for JoinGroupWithInvite that takes the fields from a GroupInviteMessage.
+//
+// The inviter is the sender of the message containing the invite.
+func (cli *Client) JoinGroupWithInviteMessage(inviter types.JID, msg *waProto.GroupInviteMessage) error {
+	jid, err := types.ParseJID(msg.GetGroupJid())
+	if err != nil {
+		return fmt.Errorf("failed to parse group JID in invite: %w", err)
+	}
+	return cli.JoinGroupWithInvite(jid, inviter.ToNonAD(), msg.GetInviteCode(), msg.GetInviteExpiration())
+}
+
+// ReqCreateGroup contains the parameters for CreateGroup.
+type ReqCreateGroup struct {
+	// The name of the group.
+	Name string
+	// The participants to add to the group. The current user doesn't need to be included.
+	Participants []types.JID
+	// A create key can be provided to deduplicate the group create request. If empty, a random key is generated.
+	CreateKey string
+	// The disappearing message timer for the new group. Must be one of the DisappearingTimer* constants.
+	DisappearingTimer *time.Duration
+	// If DisappearingTimer is nil, UseDefaultDisappearingTimer can be set to use the account's default timer
+	// (see GetDefaultDisappearingTimer) like the official clients do. The default is cached after it's fetched once.
+	UseDefaultDisappearingTimer bool
+
+	// Set IsParent to create a community instead of a normal group.
+	types.GroupParent
+	// Set LinkedParentJID to create a group inside a community.
+	types.GroupLinkedParent
+}
+
+// CreateGroup creates a group (or a community) on WhatsApp with the given parameters.
+func (cli *Client) CreateGroup(req ReqCreateGroup) (*types.GroupInfo, error) {
+	if len(req.CreateKey) == 0 {
+		req.CreateKey = GenerateMessageID()
+	}
+	if req.DisappearingTimer == nil && req.UseDefaultDisappearingTimer && !req.IsParent {
+		timer, err := cli.GetDefaultDisappearingTimer()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get default disappearing timer for new group: %w", err)
+		}
+		req.DisappearingTimer = &timer
+	}
+	content := make([]waBinary.Node, 0, len(req.Participants)+2)
+	if req.DisappearingTimer != nil && *req.DisappearingTimer != DisappearingTimerOff {
+		if !isValidDisappearingTimer(*req.DisappearingTimer) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidDisappearingTimer, *req.DisappearingTimer)
+		}
+		content = append(content, waBinary.Node{
+			Tag:   "ephemeral",
+			Attrs: waBinary.Attrs{"expiration": strconv.Itoa(int(req.DisappearingTimer.Seconds()))},
+		})
+	}
+	for _, participant := range req.Participants {
+		content = append(content, waBinary.Node{
+			Tag:   "participant",
+			Attrs: waBinary.Attrs{"jid": participant.ToNonAD()},
+		})
+	}
+	if req.IsParent {
+		if len(req.DefaultMembershipApprovalMode) == 0 {
+			req.DefaultMembershipApprovalMode = "request_required"
+		}
+		content = append(content, waBinary.Node{
+			Tag:   "parent",
+			Attrs: waBinary.Attrs{"default_membership_approval_mode": req.DefaultMembershipApprovalMode},
+		})
+	} else if !req.LinkedParentJID.IsEmpty() {
+		content = append(content, waBinary.Node{
+			Tag:   "linked_parent",
+			Attrs: waBinary.Attrs{"jid": req.LinkedParentJID},
+		})
+	}
+	resp, err := cli.sendGroupIQ("set", types.GroupServerJID, waBinary.Node{
+		Tag: "create",
+		Attrs: waBinary.Attrs{
+			"subject": req.Name,
+			"key":     req.CreateKey,
+		},
+		Content: content,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create group: %w", err)
+	}
+	groupNode, ok := resp.GetOptionalChildByTag("group")
+	if !ok {
+		return nil, fmt.Errorf("group create response didn't contain group element")
+	}
+	return cli.parseGroupNode(&groupNode)
+}
+
+// CreateCommunity creates a community with the given name.
+//
+// Communities can't contain participants directly: groups are linked to them with LinkGroup.
+func (cli *Client) CreateCommunity(name string) (*types.GroupInfo, error) {
+	return cli.CreateGroup(ReqCreateGroup{
+		Name:        name,
+		GroupParent: types.GroupParent{IsParent: true},
+	})
+}
+
+// LinkGroup adds an existing group as a sub-group of the given community.
+func (cli *Client) LinkGroup(parent, child types.JID) error {
+	_, err := cli.sendGroupIQ("set", parent, waBinary.Node{
+		Tag: "links",
 		Content: []waBinary.Node{{
-			Tag:   "query",
-			Attrs: waBinary.Attrs{"request": "interactive"},
+			Tag:   "link",
+			Attrs: waBinary.Attrs{"link_type": "sub_group"},
+			Content: []waBinary.Node{{
+				Tag:   "group",
+				Attrs: waBinary.Attrs{"jid": child},
+			}},
 		}},
 	})
 	if err != nil {
+		return wrapGroupAdminError(err, "link group")
+	}
+	cli.invalidateGroupInfoCache(child)
+	return nil
+}
+
+// UnlinkGroup removes a sub-group from the given community.
+func (cli *Client) UnlinkGroup(parent, child types.JID) error {
+	_, err := cli.sendGroupIQ("set", parent, waBinary.Node{
+		Tag:   "unlink",
+		Attrs: waBinary.Attrs{"unlink_type": "sub_group"},
+		Content: []waBinary.Node{{
+			Tag:   "group",
+			Attrs: waBinary.Attrs{"jid": child},
+		}},
+	})
+	if err != nil {
+		return wrapGroupAdminError(err, "unlink group")
+	}
+	cli.invalidateGroupInfoCache(child)
+	return nil
+}
+
+// GetSubGroups gets the sub-groups of the given community.
+//
+// To find the community that a group belongs to, use the LinkedParentJID field in GetGroupInfo.
+func (cli *Client) GetSubGroups(community types.JID) ([]*types.GroupLinkTarget, error) {
+	resp, err := cli.sendGroupIQ("get", community, waBinary.Node{Tag: "sub_groups"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to request sub-groups: %w", err)
+	}
+	groups, ok := resp.GetOptionalChildByTag("sub_groups")
+	if !ok {
+		return nil, fmt.Errorf("sub-group response didn't contain sub_groups element")
+	}
+	children := groups.GetChildren()
+	parsed := make([]*types.GroupLinkTarget, 0, len(children))
+	for _, child := range children {
+		if child.Tag != "group" {
+			continue
+		}
+		ag := child.AttrGetter()
+		target := &types.GroupLinkTarget{
+			JID: types.NewJID(ag.String("id"), types.GroupServer),
+			GroupName: types.GroupName{
+				Name:      ag.OptionalString("subject"),
+				NameSetAt: time.Unix(ag.OptionalInt64("s_t"), 0),
+			},
+		}
+		_, target.IsDefaultSubGroup = child.GetOptionalChildByTag("default_sub_group")
+		if !ag.OK() {
+			return nil, fmt.Errorf("failed to parse sub-group: %w", ag.Error())
+		}
+		parsed = append(parsed, target)
+	}
+	return parsed, nil
+}
+
+func wrapGroupAdminError(err error, action string) error {
+	if errors.Is(err, ErrIQNotAuthorized) || errors.Is(err, ErrIQForbidden) {
+		return fmt.Errorf("%w: %v", ErrGroupNotAdmin, err)
+	}
+	return fmt.Errorf("failed to %s: %w", action, err)
+}
+
+// SetGroupName updates the name (subject) of the given group.
+//
+// The server confirms the change with a group notification, which is emitted as an events.GroupInfo event.
+func (cli *Client) SetGroupName(jid types.JID, name string) error {
+	_, err := cli.sendGroupIQ("set", jid, waBinary.Node{
+		Tag:     "subject",
+		Content: []byte(name),
+	})
+	if err != nil {
+		return wrapGroupAdminError(err, "set group name")
+	}
+	cli.updateCachedGroupInfo(jid, func(info *types.GroupInfo) {
+		info.Name = name
+		info.NameSetAt = cli.now()
+		info.NameSetBy = cli.Store.ID.ToNonAD()
+	})
+	return nil
+}
+
+// SetGroupTopic updates the topic (description) of the given group. Setting an empty topic removes the topic.
+//
+// The protocol requires the ID of the previous topic, so that concurrent edits don't overwrite each other.
+// If previousID is empty, the ID is taken from the cached group info, or the group info is fetched from the server.
+// The server confirms the change with a group notification, which is emitted as an events.GroupInfo event.
+func (cli *Client) SetGroupTopic(jid types.JID, topic, previousID string) error {
+	if len(previousID) == 0 {
+		if cachedInfo := cli.peekCachedGroupInfo(jid); cachedInfo != nil {
+			previousID = cachedInfo.TopicID
+		} else {
+			info, err := cli.getGroupInfo(jid)
+			if err != nil {
+				return err
+			}
+			previousID = info.TopicID
+		}
+	}
+	newID := GenerateMessageID()
+	attrs := waBinary.Attrs{"id": newID}
+	if len(previousID) > 0 {
+		attrs["prev"] = previousID
+	}
+	content := waBinary.Node{Tag: "description", Attrs: attrs}
+	if len(topic) > 0 {
+		content.Content = []waBinary.Node{{Tag: "body", Content: []byte(topic)}}
+	} else {
+		attrs["delete"] = "true"
+	}
+	_, err := cli.sendGroupIQ("set", jid, content)
+	if err != nil {
+		return wrapGroupAdminError(err, "set group topic")
+	}
+	cli.updateCachedGroupInfo(jid, func(info *types.GroupInfo) {
+		info.Topic = topic
+		info.TopicID = newID
+		info.TopicSetAt = cli.now()
+		info.TopicSetBy = cli.Store.ID.ToNonAD()
+	})
+	return nil
+}
+
+// The disappearing message timer values that WhatsApp allows.
+const (
+	DisappearingTimerOff     = time.Duration(0)
+	DisappearingTimer24Hours = 24 * time.Hour
+	DisappearingTimer7Days   = 7 * 24 * time.Hour
+	DisappearingTimer90Days  = 90 * 24 * time.Hour
+)
+
+// SetGroupEphemeralTimer updates the disappearing message timer of the given group.
+//
+// The timer must be one of the DisappearingTimer* constants. DisappearingTimerOff disables disappearing messages.
+func (cli *Client) SetGroupEphemeralTimer(jid types.JID, timer time.Duration) error {
+	if !isValidDisappearingTimer(timer) {
+		return fmt.Errorf("%w: %s", ErrInvalidDisappearingTimer, timer)
+	}
+	content := waBinary.Node{Tag: "not_ephemeral"}
+	if timer != DisappearingTimerOff {
+		content = waBinary.Node{
+			Tag:   "ephemeral",
+			Attrs: waBinary.Attrs{"expiration": strconv.Itoa(int(timer.Seconds()))},
+		}
+	}
+	_, err := cli.sendGroupIQ("set", jid, content)
+	if err != nil {
+		return wrapGroupAdminError(err, "set group disappearing timer")
+	}
+	return nil
+}
+
+// SetGroupAnnounce changes whether only admins can send messages in the given group.
+func (cli *Client) SetGroupAnnounce(jid types.JID, announce bool) error {
+	tag := "not_announcement"
+	if announce {
+		tag = "announcement"
+	}
+	_, err := cli.sendGroupIQ("set", jid, waBinary.Node{Tag: tag})
+	if err != nil {
+		return wrapGroupAdminError(err, "set group announce mode")
+	}
+	cli.updateCachedGroupInfo(jid, func(info *types.GroupInfo) {
+		info.IsAnnounce = announce
+	})
+	return nil
+}
+
+// SetGroupLocked changes whether only admins can edit the info (name, topic, photo) of the given group.
+func (cli *Client) SetGroupLocked(jid types.JID, locked bool) error {
+	tag := "unlocked"
+	if locked {
+		tag = "locked"
+	}
+	_, err := cli.sendGroupIQ("set", jid, waBinary.Node{Tag: tag})
+	if err != nil {
+		return wrapGroupAdminError(err, "set group locked mode")
+	}
+	cli.updateCachedGroupInfo(jid, func(info *types.GroupInfo) {
+		info.IsLocked = locked
+	})
+	return nil
+}
+
+// SetGroupJoinApprovalMode changes whether new members joining the given group with an invite link
+// need to be approved by an admin.
+func (cli *Client) SetGroupJoinApprovalMode(jid types.JID, approvalRequired bool) error {
+	state := "off"
+	if approvalRequired {
+		state = "on"
+	}
+	_, err := cli.sendGroupIQ("set", jid, waBinary.Node{
+		Tag: "membership_approval_mode",
+		Content: []waBinary.Node{{
+			Tag:   "group_join",
+			Attrs: waBinary.Attrs{"state": state},
+		}},
+	})
+	if err != nil {
+		return wrapGroupAdminError(err, "set group join approval mode")
+	}
+	return nil
+}
+
+// SetGroupMemberAddMode changes who is allowed to add new members to the given group.
+func (cli *Client) SetGroupMemberAddMode(jid types.JID, mode types.GroupMemberAddMode) error {
+	_, err := cli.sendGroupIQ("set", jid, waBinary.Node{
+		Tag:     "member_add_mode",
+		Content: []byte(mode),
+	})
+	if err != nil {
+		return wrapGroupAdminError(err, "set group member add mode")
+	}
+	return nil
+}
+
+// SetGroupPhoto updates the photo of the given group and returns the new picture ID.
+//
+// The photo should be a square JPEG. Passing nil removes the photo, in which case the returned ID is empty.
+func (cli *Client) SetGroupPhoto(jid types.JID, jpeg []byte) (string, error) {
+	var content []waBinary.Node
+	if jpeg != nil {
+		content = []waBinary.Node{{
+			Tag:     "picture",
+			Attrs:   waBinary.Attrs{"type": "image"},
+			Content: jpeg,
+		}}
+	}
+	resp, err := cli.sendIQ(infoQuery{
+		Namespace: "w:profile:picture",
+		Type:      "set",
+		To:        jid,
+		Content:   content,
+	})
+	if err != nil {
+		return "", wrapGroupAdminError(err, "set group photo")
+	}
+	if jpeg == nil {
+		return "", nil
+	}
+	pictureID, ok := resp.GetChildByTag("picture").Attrs["id"].(string)
+	if !ok {
+		return "", fmt.Errorf("didn't find picture ID in response")
+	}
+	return pictureID, nil
+}
+
+// GetJoinedGroups returns the list of groups the user is participating in.
+func (cli *Client) GetJoinedGroups() ([]*types.GroupInfo, error) {
+	resp, err := cli.sendGroupIQ("get", types.GroupServerJID, waBinary.Node{
+		Tag: "participating",
+		Content: []waBinary.Node{
+			{Tag: "participants"},
+			{Tag: "description"},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to request joined groups: %w", err)
+	}
+	groups, ok := resp.GetOptionalChildByTag("groups")
+	if !ok {
+		return nil, fmt.Errorf("joined groups response didn't contain groups element")
+	}
+	children := groups.GetChildren()
+	infos := make([]*types.GroupInfo, 0, len(children))
+	for _, child := range children {
+		if child.Tag != "group" {
+			cli.Log.Debugf("Unexpected child in group list response: %s", child.XMLString())
+			continue
+		}
+		parsed, parseErr := cli.parseGroupNode(&child)
+		if parseErr != nil {
+			cli.Log.Warnf("Error parsing group in joined group list: %v", parseErr)
+			continue
+		}
+		cli.cacheGroupInfo(parsed)
+		infos = append(infos, parsed)
+	}
+	return infos, nil
+}
+
+// GetGroupInfo requests basic info about a group chat from the WhatsApp servers.
+//
+// If Client.GroupInfoCacheTTL is set, the result may come from the group info cache.
+func (cli *Client) GetGroupInfo(jid types.JID) (*types.GroupInfo, error) {
+	if cli.GroupInfoCacheTTL <= 0 {
+		return cli.getGroupInfo(jid)
+	}
+	return cli.getCachedGroupInfo(jid)
+}
+
+func (cli *Client) getGroupInfo(jid types.JID) (*types.GroupInfo, error) {
+	res, err := cli.sendGroupIQ("get", jid, waBinary.Node{
+		Tag:   "query",
+		Attrs: waBinary.Attrs{"request": "interactive"},
+	})
+	if errors.Is(err, ErrIQNotFound) {
+		return nil, fmt.Errorf("%w: %v", ErrGroupNotFound, err)
+	} else if err != nil {
 		return nil, fmt.Errorf("failed to request group info: %w", err)
 	}
 
@@ -39,16 +559,19 @@ func (cli *Client) GetGroupInfo(jid types.JID) (*types.GroupInfo, error) {
 	if !ok {
 		return nil, fmt.Errorf("group info request didn't return group info")
 	}
+	return cli.parseGroupNode(&groupNode)
+}
 
+func (cli *Client) parseGroupNode(groupNode *waBinary.Node) (*types.GroupInfo, error) {
 	var group types.GroupInfo
 	ag := groupNode.AttrGetter()
 
 	group.JID = types.NewJID(ag.String("id"), types.GroupServer)
-	group.OwnerJID = ag.JID("creator")
+	group.OwnerJID = ag.OptionalJIDOrEmpty("creator")
 
 	group.Name = ag.String("subject")
 	group.NameSetAt = time.Unix(ag.Int64("s_t"), 0)
-	group.NameSetBy = ag.JID("s_o")
+	group.NameSetBy = ag.OptionalJIDOrEmpty("s_o")
 
 	group.GroupCreated = time.Unix(ag.Int64("creation"), 0)
 
@@ -59,25 +582,47 @@ func (cli *Client) GetGroupInfo(jid types.JID) (*types.GroupInfo, error) {
 		childAG := child.AttrGetter()
 		switch child.Tag {
 		case "participant":
+			pType := childAG.OptionalString("type")
 			participant := types.GroupParticipant{
-				IsAdmin: childAG.OptionalString("type") == "admin",
-				JID:     childAG.JID("jid"),
+				IsAdmin:      pType == "admin" || pType == "superadmin",
+				IsSuperAdmin: pType == "superadmin",
+				JID:          childAG.JID("jid"),
 			}
 			group.Participants = append(group.Participants, participant)
 		case "description":
 			body, bodyOK := child.GetOptionalChildByTag("body")
 			if bodyOK {
-				group.Topic, _ = body.Content.(string)
+				switch content := body.Content.(type) {
+				case string:
+					group.Topic = content
+				case []byte:
+					group.Topic = string(content)
+				}
 				group.TopicID = childAG.String("id")
-				group.TopicSetBy = childAG.JID("participant")
-				group.TopicSetAt = time.Unix(childAG.Int64("t"), 0)
+				group.TopicSetBy = childAG.OptionalJIDOrEmpty("participant")
+				group.TopicSetAt = time.Unix(childAG.OptionalInt64("t"), 0)
 			}
 		case "announcement":
 			group.IsAnnounce = true
 		case "locked":
 			group.IsLocked = true
+		case "ephemeral":
+			group.IsEphemeral = true
+			group.DisappearingTimer = uint32(childAG.Uint64("expiration"))
+		case "membership_approval_mode":
+			joinNode, ok := child.GetOptionalChildByTag("group_join")
+			group.IsJoinApprovalRequired = ok && joinNode.AttrGetter().OptionalString("state") == "on"
+		case "member_add_mode":
+			group.MemberAddMode = types.GroupMemberAddMode(nodeText(child))
+		case "linked_parent":
+			group.LinkedParentJID = childAG.JID("jid")
+		case "parent":
+			group.IsParent = true
+			group.DefaultMembershipApprovalMode = childAG.OptionalString("default_membership_approval_mode")
+		case "default_sub_group":
+			group.IsDefaultSubGroup = true
 		default:
-			cli.Log.Debugf("Unknown element in group node %s: %s", jid.String(), child.XMLString())
+			cli.Log.Debugf("Unknown element in group node %s: %s", group.JID.String(), child.XMLString())
 		}
 		if !childAG.OK() {
 			cli.Log.Warnf("Possibly failed to parse %s element in group node: %+v", child.Tag, childAG.Errors)
@@ -96,7 +641,11 @@ func parseParticipantList(node *waBinary.Node) (participants []types.GroupPartic
 			continue
 		}
 		pType, _ := child.Attrs["type"].(string)
-		participants = append(participants, types.GroupParticipant{JID: jid, IsAdmin: pType == "admin"})
+		participants = append(participants, types.GroupParticipant{
+			JID:          jid,
+			IsAdmin:      pType == "admin" || pType == "superadmin",
+			IsSuperAdmin: pType == "superadmin",
+		})
 	}
 	return
 }
@@ -124,10 +673,49 @@ func parseGroupChange(node *waBinary.Node) (*events.GroupInfo, error) {
 			evt.PrevParticipantVersionID = cag.String("prev_v_id")
 			evt.ParticipantVersionID = cag.String("v_id")
 			evt.Leave = parseParticipantList(&child)
+		case "subject":
+			evt.Name = &types.GroupName{
+				Name:      cag.String("subject"),
+				NameSetAt: time.Unix(cag.Int64("s_t"), 0),
+				NameSetBy: cag.OptionalJIDOrEmpty("s_o"),
+			}
+		case "description":
+			var topic string
+			if body, ok := child.GetOptionalChildByTag("body"); ok {
+				switch content := body.Content.(type) {
+				case string:
+					topic = content
+				case []byte:
+					topic = string(content)
+				}
+			}
+			evt.Topic = &types.GroupTopic{
+				Topic:      topic,
+				TopicID:    cag.String("id"),
+				TopicSetAt: evt.Timestamp,
+			}
+			if evt.Sender != nil {
+				evt.Topic.TopicSetBy = *evt.Sender
+			}
 		case "locked":
 			evt.Locked = &types.GroupLocked{IsLocked: true}
 		case "unlocked":
 			evt.Locked = &types.GroupLocked{IsLocked: false}
+		case "ephemeral":
+			evt.Ephemeral = &types.GroupEphemeral{
+				IsEphemeral:       true,
+				DisappearingTimer: uint32(cag.Uint64("expiration")),
+			}
+		case "not_ephemeral":
+			evt.Ephemeral = &types.GroupEphemeral{IsEphemeral: false}
+		case "membership_approval_mode":
+			joinNode, ok := child.GetOptionalChildByTag("group_join")
+			evt.MembershipApprovalMode = &types.GroupMembershipApprovalMode{
+				IsJoinApprovalRequired: ok && joinNode.AttrGetter().OptionalString("state") == "on",
+			}
+		case "member_add_mode":
+			mode := types.GroupMemberAddMode(nodeText(child))
+			evt.MemberAddMode = &mode
 		case "announcement":
 			evt.Announce = &types.GroupAnnounce{
 				IsAnnounce:        true,
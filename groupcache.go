@@ -0,0 +1,111 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+// DefaultGroupInfoCacheMaxSize is the default value for Client.GroupInfoCacheMaxSize.
+const DefaultGroupInfoCacheMaxSize = 10000
+
+type groupCacheEntry struct {
+	info       *types.GroupInfo
+	fetchedAt  time.Time
+	refreshing bool
+}
+
+func copyGroupInfo(info *types.GroupInfo) *types.GroupInfo {
+	infoCopy := *info
+	infoCopy.Participants = make([]types.GroupParticipant, len(info.Participants))
+	copy(infoCopy.Participants, info.Participants)
+	return &infoCopy
+}
+
+func (cli *Client) getCachedGroupInfo(jid types.JID) (*types.GroupInfo, error) {
+	cli.groupCacheLock.Lock()
+	entry, ok := cli.groupCache.Get(jid)
+	if ok {
+		info := copyGroupInfo(entry.info)
+		if cli.now().Sub(entry.fetchedAt) > cli.GroupInfoCacheTTL && !entry.refreshing {
+			entry.refreshing = true
+			go cli.refreshGroupInfo(jid, entry)
+		}
+		cli.groupCacheLock.Unlock()
+		return info, nil
+	}
+	cli.groupCacheLock.Unlock()
+
+	info, err := cli.getGroupInfo(jid)
+	if err != nil {
+		return nil, err
+	}
+	cli.cacheGroupInfo(info)
+	return copyGroupInfo(info), nil
+}
+
+// peekCachedGroupInfo returns the cached group info without fetching or refreshing it.
+func (cli *Client) peekCachedGroupInfo(jid types.JID) *types.GroupInfo {
+	cli.groupCacheLock.Lock()
+	defer cli.groupCacheLock.Unlock()
+	entry, ok := cli.groupCache.Peek(jid)
+	if !ok {
+		return nil
+	}
+	return entry.info
+}
+
+func (cli *Client) refreshGroupInfo(jid types.JID, entry *groupCacheEntry) {
+	info, err := cli.getGroupInfo(jid)
+	cli.groupCacheLock.Lock()
+	defer cli.groupCacheLock.Unlock()
+	entry.refreshing = false
+	if err != nil {
+		cli.Log.Warnf("Failed to refresh cached group info of %s: %v", jid, err)
+		return
+	}
+	// Don't resurrect the entry if it was invalidated while the refresh was in progress.
+	if current, _ := cli.groupCache.Peek(jid); current == entry {
+		cli.groupCache.Put(jid, &groupCacheEntry{info: info, fetchedAt: cli.now()})
+	}
+}
+
+func (cli *Client) cacheGroupInfo(info *types.GroupInfo) {
+	if cli.GroupInfoCacheTTL <= 0 {
+		return
+	}
+	cli.groupCacheLock.Lock()
+	cli.groupCache.SetMaxSize(cli.GroupInfoCacheMaxSize)
+	cli.groupCache.Put(info.JID, &groupCacheEntry{info: info, fetchedAt: cli.now()})
+	size := cli.groupCache.Len()
+	cli.groupCacheLock.Unlock()
+	cli.setGroupCacheSize(size)
+}
+
+func (cli *Client) invalidateGroupInfoCache(jid types.JID) {
+	cli.groupCacheLock.Lock()
+	cli.groupCache.Remove(jid)
+	size := cli.groupCache.Len()
+	cli.groupCacheLock.Unlock()
+	cli.setGroupCacheSize(size)
+}
+
+// updateCachedGroupInfo applies a change made by the current user to the cached info of the group, if it's cached.
+func (cli *Client) updateCachedGroupInfo(jid types.JID, update func(info *types.GroupInfo)) {
+	cli.groupCacheLock.Lock()
+	defer cli.groupCacheLock.Unlock()
+	entry, ok := cli.groupCache.Peek(jid)
+	if !ok {
+		return
+	}
+	// Cached infos may be shared with callers of peekCachedGroupInfo, so they're never modified in place.
+	newInfo := copyGroupInfo(entry.info)
+	update(newInfo)
+	entry.info = newInfo
+}
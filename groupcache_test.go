@@ -0,0 +1,51 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/util/lru"
+)
+
+func TestGroupCacheLimit(t *testing.T) {
+	cli := &Client{
+		Store:                 &store.Device{},
+		GroupInfoCacheTTL:     time.Hour,
+		GroupInfoCacheMaxSize: 2,
+		groupCache:            lru.New[types.JID, *groupCacheEntry](0),
+		deviceCache:           lru.New[types.JID, *deviceCacheEntry](0),
+	}
+	groups := []types.JID{
+		types.NewJID("1", types.GroupServer),
+		types.NewJID("2", types.GroupServer),
+		types.NewJID("3", types.GroupServer),
+	}
+	cli.cacheGroupInfo(&types.GroupInfo{JID: groups[0]})
+	cli.cacheGroupInfo(&types.GroupInfo{JID: groups[1]})
+	// Reading the first group makes the second one the least recently used entry
+	if _, err := cli.getCachedGroupInfo(groups[0]); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	cli.cacheGroupInfo(&types.GroupInfo{JID: groups[2]})
+	if cli.peekCachedGroupInfo(groups[1]) != nil {
+		t.Error("Expected least recently used group to be evicted")
+	}
+	if cli.peekCachedGroupInfo(groups[0]) == nil || cli.peekCachedGroupInfo(groups[2]) == nil {
+		t.Error("Expected recently used groups to stay cached")
+	}
+	if size := cli.Stats().GroupCacheSize; size != 2 {
+		t.Errorf("Expected group cache size to be 2, got %d", size)
+	}
+	cli.FlushCaches()
+	if cli.peekCachedGroupInfo(groups[0]) != nil || cli.Stats().GroupCacheSize != 0 {
+		t.Error("Expected group cache to be empty after flushing")
+	}
+}
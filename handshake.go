@@ -11,13 +11,14 @@ import (
 	"context"
 	"crypto/rand"
 	"fmt"
-	mathRand "math/rand"
 
 	"google.golang.org/protobuf/proto"
 
 	waProto "go.mau.fi/whatsmeow/binary/proto"
 	"go.mau.fi/whatsmeow/socket"
+	"go.mau.fi/whatsmeow/store"
 	"go.mau.fi/whatsmeow/util/keys"
+	"go.mau.fi/whatsmeow/util/random"
 )
 
 // doHandshake implements the Noise_XX_25519_AESGCM_SHA256 handshake for the WhatsApp web API.
@@ -51,7 +52,7 @@ func (cli *Client) doHandshake(fs *socket.FrameSocket, ephemeralKP keys.KeyPair)
 	serverEphemeralArr := *(*[32]byte)(serverEphemeral)
 
 	nh.Authenticate(serverEphemeral)
-	err = nh.MixSharedSecretIntoKey(*ephemeralKP.Priv, serverEphemeralArr)
+	err = nh.MixSharedSecret(&ephemeralKP, serverEphemeralArr)
 	if err != nil {
 		return fmt.Errorf("failed to mix server ephemeral key in: %w", err)
 	}
@@ -62,7 +63,7 @@ func (cli *Client) doHandshake(fs *socket.FrameSocket, ephemeralKP keys.KeyPair)
 	} else if len(staticDecrypted) != 32 {
 		return fmt.Errorf("unexpected length of server static plaintext %d (expected 32)", len(staticDecrypted))
 	}
-	err = nh.MixSharedSecretIntoKey(*ephemeralKP.Priv, *(*[32]byte)(staticDecrypted))
+	err = nh.MixSharedSecret(&ephemeralKP, *(*[32]byte)(staticDecrypted))
 	if err != nil {
 		return fmt.Errorf("failed to mix server static key in: %w", err)
 	}
@@ -89,12 +90,13 @@ func (cli *Client) doHandshake(fs *socket.FrameSocket, ephemeralKP keys.KeyPair)
 		return fmt.Errorf("cert key doesn't match decrypted static")
 	}
 
-	if cli.Store.NoiseKey == nil {
+	if cli.Store.NoiseKey == nil && cli.Store.ExternalNoiseKey == nil {
 		cli.Store.NoiseKey = keys.NewKeyPair()
 	}
+	noiseKey := cli.Store.GetNoiseKey()
 
-	encryptedPubkey := nh.Encrypt(cli.Store.NoiseKey.Pub[:])
-	err = nh.MixSharedSecretIntoKey(*cli.Store.NoiseKey.Priv, serverEphemeralArr)
+	encryptedPubkey := nh.Encrypt(noiseKey.PublicKey()[:])
+	err = nh.MixSharedSecret(noiseKey, serverEphemeralArr)
 	if err != nil {
 		return fmt.Errorf("failed to mix noise private key in: %w", err)
 	}
@@ -103,13 +105,20 @@ func (cli *Client) doHandshake(fs *socket.FrameSocket, ephemeralKP keys.KeyPair)
 		cli.Store.IdentityKey = keys.NewKeyPair()
 	}
 	if cli.Store.SignedPreKey == nil {
-		cli.Store.SignedPreKey = cli.Store.IdentityKey.CreateSignedPreKey(1)
+		cli.Store.SignedPreKey, err = keys.NewSignedPreKey(cli.Store.GetIdentitySigner(), 1)
+		if err != nil {
+			return fmt.Errorf("failed to generate signed pre-key: %w", err)
+		}
 	}
 	if cli.Store.RegistrationID == 0 {
-		cli.Store.RegistrationID = mathRand.Uint32()
+		cli.Store.RegistrationID = random.RegistrationID()
 	}
 
-	clientFinishPayloadBytes, err := proto.Marshal(cli.Store.GetClientPayload())
+	clientFinishPayload := cli.Store.GetClientPayload()
+	if err = store.ValidateClientPayload(clientFinishPayload); err != nil {
+		return fmt.Errorf("invalid client payload: %w", err)
+	}
+	clientFinishPayloadBytes, err := proto.Marshal(clientFinishPayload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal client finish payload: %w", err)
 	}
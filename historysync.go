@@ -0,0 +1,339 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// HistorySyncProgress describes which part of the user's history a history sync blob contains.
+type HistorySyncProgress struct {
+	Type waProto.HistorySync_HistorySyncHistorySyncType // e.g. INITIAL_BOOTSTRAP, RECENT, FULL or PUSH_NAME
+	// The number of this chunk. The phone doesn't say how many chunks there will be in total.
+	ChunkOrder int
+	// How much of the history has been sent so far, in percent.
+	Progress int
+}
+
+// GetHistorySyncProgress returns the type and progress information of the given history sync blob.
+func GetHistorySyncProgress(data *waProto.HistorySync) HistorySyncProgress {
+	return HistorySyncProgress{
+		Type:       data.GetSyncType(),
+		ChunkOrder: int(data.GetChunkOrder()),
+		Progress:   int(data.GetProgress()),
+	}
+}
+
+// HistoryMessageType describes what kind of entry a message in a history sync is.
+type HistoryMessageType int
+
+const (
+	// HistoryMessageNormal is a normal message with content.
+	HistoryMessageNormal HistoryMessageType = iota
+	// HistoryMessageRevoked is a message that was deleted for everyone.
+	HistoryMessageRevoked
+	// HistoryMessagePlaceholder is a message whose content the phone doesn't have, e.g. because it couldn't be decrypted.
+	HistoryMessagePlaceholder
+	// HistoryMessageSystem is a system message like a group change or a security code change. See HistoryMessage.StubType.
+	HistoryMessageSystem
+)
+
+// String returns a human-readable name of the message type.
+func (hmt HistoryMessageType) String() string {
+	switch hmt {
+	case HistoryMessageNormal:
+		return "normal"
+	case HistoryMessageRevoked:
+		return "revoked"
+	case HistoryMessagePlaceholder:
+		return "placeholder"
+	case HistoryMessageSystem:
+		return "system"
+	default:
+		return fmt.Sprintf("unknown (%d)", int(hmt))
+	}
+}
+
+func getHistoryMessageType(webMsg *waProto.WebMessageInfo) HistoryMessageType {
+	switch webMsg.GetMessageStubType() {
+	case waProto.WebMessageInfo_UNKNOWN:
+		if webMsg.GetMessage() == nil {
+			return HistoryMessagePlaceholder
+		}
+		return HistoryMessageNormal
+	case waProto.WebMessageInfo_REVOKE:
+		return HistoryMessageRevoked
+	case waProto.WebMessageInfo_CIPHERTEXT, waProto.WebMessageInfo_FUTUREPROOF,
+		waProto.WebMessageInfo_PAYMENT_CIPHERTEXT, waProto.WebMessageInfo_PAYMENT_FUTUREPROOF:
+		return HistoryMessagePlaceholder
+	default:
+		return HistoryMessageSystem
+	}
+}
+
+// HistoryConversation contains the metadata of a single conversation in a history sync.
+type HistoryConversation struct {
+	JID                  types.JID
+	Name                 string
+	UnreadCount          int
+	Archived             bool
+	ReadOnly             bool
+	LastMessageTimestamp time.Time
+	EphemeralExpiration  time.Duration // The disappearing message timer, or zero if disappearing messages are disabled.
+
+	// The raw conversation data, including the raw messages.
+	Raw *waProto.Conversation
+}
+
+// HistoryMessage is a single message from a history sync.
+type HistoryMessage struct {
+	// The message and its metadata in the same format as live Message events.
+	// For placeholders and system messages, Message.Message may be nil.
+	*events.Message
+
+	Type           HistoryMessageType
+	StubType       waProto.WebMessageInfo_WebMessageInfoStubType
+	StubParameters []string
+	Status         waProto.WebMessageInfo_WebMessageInfoStatus
+	Starred        bool
+	Reactions      []types.Reaction
+
+	Raw *waProto.WebMessageInfo
+}
+
+// IterateHistorySync calls the given function for each message in the given history sync blob, one conversation at
+// a time and in the order the phone sent them (usually newest first). Each message is only converted when it's
+// passed to the function, so the whole history doesn't have to be held in memory twice.
+//
+// If the function returns an error, the iteration stops and the error is returned. Messages that can't be parsed
+// (e.g. because of invalid JIDs) are skipped.
+func (cli *Client) IterateHistorySync(data *waProto.HistorySync, fn func(conv *HistoryConversation, msg *HistoryMessage) error) error {
+	for _, rawConv := range data.GetConversations() {
+		chatJID, err := types.ParseJID(rawConv.GetId())
+		if err != nil {
+			cli.Log.Warnf("Failed to parse chat JID %q in history sync: %v", rawConv.GetId(), err)
+			continue
+		}
+		conv := &HistoryConversation{
+			JID:                 chatJID,
+			Name:                rawConv.GetName(),
+			UnreadCount:         int(rawConv.GetUnreadCount()),
+			Archived:            rawConv.GetArchived(),
+			ReadOnly:            rawConv.GetReadOnly(),
+			EphemeralExpiration: time.Duration(rawConv.GetEphemeralExpiration()) * time.Second,
+			Raw:                 rawConv,
+		}
+		if rawConv.LastMsgTimestamp != nil {
+			conv.LastMessageTimestamp = time.Unix(int64(rawConv.GetLastMsgTimestamp()), 0)
+		}
+		for _, rawMsg := range rawConv.GetMessages() {
+			msg, err := cli.ParseHistoryMessage(chatJID, rawMsg.GetMessage())
+			if err != nil {
+				cli.Log.Warnf("Failed to parse message in history sync of %s: %v", chatJID, err)
+				continue
+			}
+			if err = fn(conv, msg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ParseHistoryMessage converts a message from a history sync into the same format as live message events.
+//
+// If the chat JID is empty, it's parsed from the message key.
+func (cli *Client) ParseHistoryMessage(chat types.JID, webMsg *waProto.WebMessageInfo) (*HistoryMessage, error) {
+	evt, err := cli.ParseWebMessage(chat, webMsg)
+	if err != nil {
+		return nil, err
+	}
+	msg := &HistoryMessage{
+		Message:        evt,
+		Type:           getHistoryMessageType(webMsg),
+		StubType:       webMsg.GetMessageStubType(),
+		StubParameters: webMsg.GetMessageStubParameters(),
+		Status:         webMsg.GetStatus(),
+		Starred:        webMsg.GetStarred(),
+		Raw:            webMsg,
+	}
+	if msg.Type == HistoryMessageRevoked {
+		msg.Info.IsRevoke = true
+	}
+	for _, reaction := range webMsg.GetReactions() {
+		sender, err := cli.parseHistoryMessageSender(evt.Info.Chat, reaction.GetKey(), "")
+		if err != nil {
+			cli.Log.Debugf("Failed to parse sender of reaction to %s in %s: %v", evt.Info.ID, evt.Info.Chat, err)
+			continue
+		}
+		parsedReaction := types.Reaction{Sender: sender, Emoji: reaction.GetText()}
+		if reaction.SenderTimestampMs != nil {
+			parsedReaction.Timestamp = time.UnixMilli(reaction.GetSenderTimestampMs())
+		}
+		msg.Reactions = append(msg.Reactions, parsedReaction)
+	}
+	return msg, nil
+}
+
+// ParseWebMessage converts a WebMessageInfo (e.g. from a history sync) into a Message event.
+//
+// If the chat JID is empty, it's parsed from the message key.
+func (cli *Client) ParseWebMessage(chat types.JID, webMsg *waProto.WebMessageInfo) (*events.Message, error) {
+	var err error
+	if chat.IsEmpty() {
+		chat, err = types.ParseJID(webMsg.GetKey().GetRemoteJid())
+		if err != nil {
+			return nil, fmt.Errorf("no chat JID provided and failed to parse remote JID: %w", err)
+		}
+	}
+	info := types.MessageInfo{
+		MessageSource: types.MessageSource{
+			Chat:     chat,
+			IsFromMe: webMsg.GetKey().GetFromMe(),
+			IsGroup:  chat.Server == types.GroupServer || chat.Server == types.BroadcastServer,
+		},
+		ID:        webMsg.GetKey().GetId(),
+		PushName:  webMsg.GetPushName(),
+		Timestamp: time.Unix(int64(webMsg.GetMessageTimestamp()), 0),
+		Multicast: webMsg.GetMulticast(),
+	}
+	info.Sender, err = cli.parseHistoryMessageSender(chat, webMsg.GetKey(), webMsg.GetParticipant())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sender of %s: %w", info.ID, err)
+	}
+	return parseMessageEvent(&info, webMsg.GetMessage()), nil
+}
+
+func (cli *Client) parseHistoryMessageSender(chat types.JID, key *waProto.MessageKey, participant string) (types.JID, error) {
+	if key.GetFromMe() {
+		if cli.Store.ID == nil {
+			return types.EmptyJID, ErrNotLoggedIn
+		}
+		return cli.Store.ID.ToNonAD(), nil
+	} else if chat.Server == types.DefaultUserServer {
+		return chat, nil
+	}
+	if len(participant) == 0 {
+		participant = key.GetParticipant()
+	}
+	if len(participant) == 0 {
+		return types.EmptyJID, fmt.Errorf("message key doesn't have a participant")
+	}
+	return types.ParseJID(participant)
+}
+
+// MaxHistorySyncOnDemandCount is the maximum number of messages that the primary device sends in response to
+// a single RequestHistorySync call.
+const MaxHistorySyncOnDemandCount = 50
+
+// historySyncRequestTimeout is how long an on-demand history sync request is remembered while waiting for the phone
+// to respond.
+const historySyncRequestTimeout = 10 * time.Minute
+
+type historySyncRequest struct {
+	chat        types.JID
+	before      time.Time
+	requestedAt time.Time
+}
+
+// RequestHistorySync asks the primary device to send up to count messages in the given chat that are older than the
+// given anchor message. The anchor should be the oldest message in the chat that is already known, either from a
+// previous history sync or from a live Message event. If count is zero or more than MaxHistorySyncOnDemandCount,
+// MaxHistorySyncOnDemandCount messages are requested.
+//
+// The messages are sent asynchronously as an events.HistorySync event with OnDemand set to true.
+// ErrUnknownHistorySyncAnchor is returned if the anchor is missing or doesn't belong to the chat.
+//
+// The phone only keeps a limited amount of history for on-demand syncs. If it responds without any messages in the
+// chat, the limit has been reached, and further requests that aren't anchored to a newer message in the same chat
+// return ErrHistorySyncLimitReached without asking the phone again.
+func (cli *Client) RequestHistorySync(chat types.JID, before *types.MessageInfo, count int) error {
+	if cli.Store.ID == nil {
+		return ErrNotLoggedIn
+	}
+	chat = chat.ToNonAD()
+	if before == nil || len(before.ID) == 0 || before.Timestamp.IsZero() {
+		return ErrUnknownHistorySyncAnchor
+	} else if before.Chat.ToNonAD() != chat {
+		return fmt.Errorf("%w: message %s is in %s, not %s", ErrUnknownHistorySyncAnchor, before.ID, before.Chat, chat)
+	}
+	if count <= 0 || count > MaxHistorySyncOnDemandCount {
+		count = MaxHistorySyncOnDemandCount
+	}
+
+	id := GenerateMessageID()
+	cli.historySyncRequestsLock.Lock()
+	if limit, ok := cli.historySyncLimits[chat]; ok && !before.Timestamp.After(limit) {
+		cli.historySyncRequestsLock.Unlock()
+		return ErrHistorySyncLimitReached
+	}
+	now := cli.now()
+	for reqID, req := range cli.historySyncRequests {
+		if now.Sub(req.requestedAt) > historySyncRequestTimeout {
+			delete(cli.historySyncRequests, reqID)
+		}
+	}
+	cli.historySyncRequests[id] = &historySyncRequest{chat: chat, before: before.Timestamp, requestedAt: now}
+	cli.historySyncRequestsLock.Unlock()
+
+	msg := buildHistorySyncRequest(chat, before, count)
+	cli.trackPeerMessage(id)
+	err := cli.sendPeerMessage(cli.Store.ID.ToNonAD(), id, msg)
+	if err != nil {
+		cli.untrackPeerMessage(id)
+		cli.historySyncRequestsLock.Lock()
+		delete(cli.historySyncRequests, id)
+		cli.historySyncRequestsLock.Unlock()
+	}
+	return err
+}
+
+func buildHistorySyncRequest(chat types.JID, before *types.MessageInfo, count int) *waProto.Message {
+	return &waProto.Message{
+		ProtocolMessage: &waProto.ProtocolMessage{
+			Type: waProto.ProtocolMessage_PEER_DATA_OPERATION_REQUEST_MESSAGE.Enum(),
+			PeerDataOperationRequestMessage: &waProto.PeerDataOperationRequestMessage{
+				PeerDataOperationRequestType: waProto.PeerDataOperationRequestMessage_HISTORY_SYNC_ON_DEMAND.Enum(),
+				HistorySyncOnDemandRequest: &waProto.HistorySyncOnDemandRequest{
+					ChatJid:              proto.String(chat.String()),
+					OldestMsgId:          proto.String(before.ID),
+					OldestMsgFromMe:      proto.Bool(before.IsFromMe),
+					OnDemandMsgCount:     proto.Int32(int32(count)),
+					OldestMsgTimestampMs: proto.Int64(before.Timestamp.UnixMilli()),
+				},
+			},
+		},
+	}
+}
+
+// handleOnDemandHistorySync remembers the history limit of the chat if the phone didn't have any older messages
+// to send in response to the given RequestHistorySync request.
+func (cli *Client) handleOnDemandHistorySync(requestID types.MessageID, data *waProto.HistorySync) {
+	cli.historySyncRequestsLock.Lock()
+	defer cli.historySyncRequestsLock.Unlock()
+	req, ok := cli.historySyncRequests[requestID]
+	if !ok {
+		return
+	}
+	delete(cli.historySyncRequests, requestID)
+	for _, conv := range data.GetConversations() {
+		if chat, err := types.ParseJID(conv.GetId()); err == nil && chat == req.chat && len(conv.GetMessages()) > 0 {
+			return
+		}
+	}
+	cli.Log.Debugf("Phone didn't send any messages in %s older than %s, assuming history limit was reached", req.chat, req.before)
+	if limit, ok := cli.historySyncLimits[req.chat]; !ok || req.before.After(limit) {
+		cli.historySyncLimits[req.chat] = req.before
+	}
+}
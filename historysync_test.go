@@ -0,0 +1,247 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+	"go.mau.fi/whatsmeow/util/keys"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+func TestIterateHistorySync(t *testing.T) {
+	ownID := types.NewADJID("1111111111", 0, 2)
+	cli := NewClient(&store.Device{ID: &ownID}, waLog.Noop)
+	data := &waProto.HistorySync{
+		SyncType:   waProto.HistorySync_RECENT.Enum(),
+		ChunkOrder: proto.Uint32(3),
+		Progress:   proto.Uint32(40),
+		Conversations: []*waProto.Conversation{{
+			Id:          proto.String("2222222222@s.whatsapp.net"),
+			UnreadCount: proto.Uint32(1),
+			Messages: []*waProto.HistorySyncMsg{{Message: &waProto.WebMessageInfo{
+				Key:              &waProto.MessageKey{Id: proto.String("DM1")},
+				MessageTimestamp: proto.Uint64(1700000000),
+				Message: &waProto.Message{EphemeralMessage: &waProto.FutureProofMessage{
+					Message: &waProto.Message{Conversation: proto.String("hello")},
+				}},
+				Reactions: []*waProto.Reaction{{
+					Key:               &waProto.MessageKey{FromMe: proto.Bool(true)},
+					Text:              proto.String("👍"),
+					SenderTimestampMs: proto.Int64(1700000001000),
+				}},
+			}}, {Message: &waProto.WebMessageInfo{
+				Key:             &waProto.MessageKey{Id: proto.String("DM2"), FromMe: proto.Bool(true)},
+				MessageStubType: waProto.WebMessageInfo_REVOKE.Enum(),
+			}}},
+		}, {
+			Id: proto.String("123456789-987654321@g.us"),
+			Messages: []*waProto.HistorySyncMsg{{Message: &waProto.WebMessageInfo{
+				Key:             &waProto.MessageKey{Id: proto.String("GR1")},
+				Participant:     proto.String("3333333333@s.whatsapp.net"),
+				MessageStubType: waProto.WebMessageInfo_CIPHERTEXT.Enum(),
+			}}, {Message: &waProto.WebMessageInfo{
+				// No participant, so the sender can't be determined
+				Key: &waProto.MessageKey{Id: proto.String("GR2")},
+			}}, {Message: &waProto.WebMessageInfo{
+				Key:                   &waProto.MessageKey{Id: proto.String("GR3"), Participant: proto.String("4444444444@s.whatsapp.net")},
+				MessageStubType:       waProto.WebMessageInfo_GROUP_CHANGE_SUBJECT.Enum(),
+				MessageStubParameters: []string{"New name"},
+			}}},
+		}},
+	}
+
+	progress := GetHistorySyncProgress(data)
+	if progress.Type != waProto.HistorySync_RECENT || progress.ChunkOrder != 3 || progress.Progress != 40 {
+		t.Errorf("Unexpected progress %+v", progress)
+	}
+
+	var msgs []*HistoryMessage
+	var convs []*HistoryConversation
+	err := cli.IterateHistorySync(data, func(conv *HistoryConversation, msg *HistoryMessage) error {
+		if len(convs) == 0 || convs[len(convs)-1] != conv {
+			convs = append(convs, conv)
+		}
+		msgs = append(msgs, msg)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(convs) != 2 || convs[0].UnreadCount != 1 || convs[1].JID.Server != types.GroupServer {
+		t.Fatalf("Unexpected conversations %+v", convs)
+	}
+	if len(msgs) != 4 {
+		t.Fatalf("Expected 4 parseable messages, got %d", len(msgs))
+	}
+
+	dm := msgs[0]
+	if dm.Type != HistoryMessageNormal || dm.Message.Message.GetConversation() != "hello" || !dm.Info.IsEphemeral ||
+		dm.Info.Sender != convs[0].JID || dm.Info.IsFromMe || !dm.Info.Timestamp.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("Unexpected normal message %+v", dm.Info)
+	}
+	if len(dm.Reactions) != 1 || dm.Reactions[0].Sender != ownID.ToNonAD() || dm.Reactions[0].Emoji != "👍" {
+		t.Errorf("Unexpected reactions %+v", dm.Reactions)
+	}
+	if msgs[1].Type != HistoryMessageRevoked || !msgs[1].Info.IsRevoke || msgs[1].Info.Sender != ownID.ToNonAD() {
+		t.Errorf("Unexpected revoked message %+v", msgs[1].Info)
+	}
+	if msgs[2].Type != HistoryMessagePlaceholder || msgs[2].Info.Sender.User != "3333333333" || !msgs[2].Info.IsGroup {
+		t.Errorf("Unexpected placeholder message %+v", msgs[2].Info)
+	}
+	if msgs[3].Type != HistoryMessageSystem || msgs[3].StubType != waProto.WebMessageInfo_GROUP_CHANGE_SUBJECT ||
+		msgs[3].Type.String() != "system" || msgs[3].StubParameters[0] != "New name" {
+		t.Errorf("Unexpected system message %+v", msgs[3])
+	}
+
+	stopErr := errors.New("stop")
+	calls := 0
+	err = cli.IterateHistorySync(data, func(*HistoryConversation, *HistoryMessage) error {
+		calls++
+		return stopErr
+	})
+	if !errors.Is(err, stopErr) || calls != 1 {
+		t.Errorf("Expected iteration to stop after the first error, got %v after %d calls", err, calls)
+	}
+}
+
+func TestBuildHistorySyncRequest(t *testing.T) {
+	chat := types.NewJID("2222222222", types.DefaultUserServer)
+	anchor := &types.MessageInfo{
+		MessageSource: types.MessageSource{Chat: chat, IsFromMe: true},
+		ID:            "OLDEST",
+		Timestamp:     time.UnixMilli(1700000000123),
+	}
+	protoMsg := buildHistorySyncRequest(chat, anchor, 20).GetProtocolMessage()
+	req := protoMsg.GetPeerDataOperationRequestMessage()
+	onDemand := req.GetHistorySyncOnDemandRequest()
+	if protoMsg.GetType() != waProto.ProtocolMessage_PEER_DATA_OPERATION_REQUEST_MESSAGE ||
+		req.GetPeerDataOperationRequestType() != waProto.PeerDataOperationRequestMessage_HISTORY_SYNC_ON_DEMAND {
+		t.Errorf("Unexpected request type %s/%s", protoMsg.GetType(), req.GetPeerDataOperationRequestType())
+	}
+	if onDemand.GetChatJid() != chat.String() || onDemand.GetOldestMsgId() != "OLDEST" || !onDemand.GetOldestMsgFromMe() ||
+		onDemand.GetOldestMsgTimestampMs() != 1700000000123 || onDemand.GetOnDemandMsgCount() != 20 {
+		t.Errorf("Unexpected on-demand history sync request %+v", onDemand)
+	}
+}
+
+// compressHistorySync encodes the given history sync the same way the phone does before uploading it.
+func compressHistorySync(t *testing.T, data *waProto.HistorySync) []byte {
+	raw, err := proto.Marshal(data)
+	if err != nil {
+		t.Fatalf("Failed to marshal history sync: %v", err)
+	}
+	var buf bytes.Buffer
+	writer := zlib.NewWriter(&buf)
+	_, _ = writer.Write(raw)
+	_ = writer.Close()
+	return buf.Bytes()
+}
+
+func TestRequestHistorySync(t *testing.T) {
+	url, results := fakeNoiseServer(t)
+	host := newFakeMediaServer(t)
+	ownDevice := types.NewADJID("1111111111", 0, 2)
+	cli := NewClient(&store.Device{
+		ID:             &ownDevice,
+		NoiseKey:       keys.NewKeyPair(),
+		IdentityKey:    keys.NewKeyPair(),
+		RegistrationID: 1234,
+		Account:        &waProto.ADVSignedDeviceIdentity{},
+		Sessions:       fakeSessionStore{},
+		Identities:     fakeIdentityStore{},
+	}, waLog.Noop)
+	setTestMediaHosts(cli, host)
+	historySyncs := make(chan *events.HistorySync, 1)
+	cli.AddEventHandler(func(evt interface{}) {
+		if hs, ok := evt.(*events.HistorySync); ok {
+			historySyncs <- hs
+		}
+	})
+	chat := types.NewJID("2222222222", types.DefaultUserServer)
+	anchor := &types.MessageInfo{MessageSource: types.MessageSource{Chat: chat}, ID: "OLDEST", Timestamp: time.Unix(1700000000, 0)}
+
+	if err := cli.RequestHistorySync(chat, nil, 10); !errors.Is(err, ErrUnknownHistorySyncAnchor) {
+		t.Errorf("Expected ErrUnknownHistorySyncAnchor without an anchor, got %v", err)
+	}
+	otherChat := *anchor
+	otherChat.Chat = types.NewJID("3333333333", types.DefaultUserServer)
+	if err := cli.RequestHistorySync(chat, &otherChat, 10); !errors.Is(err, ErrUnknownHistorySyncAnchor) {
+		t.Errorf("Expected ErrUnknownHistorySyncAnchor with an anchor from another chat, got %v", err)
+	}
+
+	// Establish a session with the primary device like it would exist after pairing
+	primaryIdentity := keys.NewKeyPair()
+	primaryDevice := types.NewADJID(ownDevice.User, 0, 0)
+	_, bundle, err := nodeToPreKeyBundle(waBinary.Node{
+		Tag:   "user",
+		Attrs: waBinary.Attrs{"jid": primaryDevice},
+		Content: []waBinary.Node{
+			{Tag: "registration", Content: []byte{0, 0, 0, 1}},
+			{Tag: "identity", Content: primaryIdentity.Pub[:]},
+			preKeyToNode(keys.NewPreKey(1)),
+			preKeyToNode(primaryIdentity.CreateSignedPreKey(1)),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to parse prekey bundle: %v", err)
+	} else if _, _, err = cli.encryptMessageForDevice([]byte("hi"), primaryDevice, bundle); err != nil {
+		t.Fatalf("Failed to establish session with primary device: %v", err)
+	}
+	fs := connectToFakeServer(t, cli, url)
+	if err = cli.RequestHistorySync(chat, anchor, 1000); err != nil {
+		t.Fatalf("Failed to request history sync: %v", err)
+	}
+	var requestID types.MessageID
+	for id := range cli.historySyncRequests {
+		requestID = id
+	}
+
+	// The phone has nothing older than the anchor, so it responds with an empty chunk
+	blob := compressHistorySync(t, &waProto.HistorySync{SyncType: waProto.HistorySync_ON_DEMAND.Enum()})
+	uploaded, err := cli.Upload(context.Background(), blob, MediaHistory)
+	if err != nil {
+		t.Fatalf("Failed to upload history sync: %v", err)
+	}
+	cli.handleHistorySyncNotification(&waProto.HistorySyncNotification{
+		DirectPath:        proto.String(uploaded.DirectPath),
+		MediaKey:          uploaded.MediaKey,
+		FileEncSha256:     uploaded.FileEncSHA256,
+		FileSha256:        uploaded.FileSHA256,
+		FileLength:        proto.Uint64(uint64(len(blob))),
+		SyncType:          waProto.HistorySyncNotification_ON_DEMAND.Enum(),
+		OriginalMessageId: proto.String(requestID),
+	})
+	if evt := <-historySyncs; !evt.OnDemand {
+		t.Error("Expected history sync to be marked as on-demand")
+	}
+	if err = cli.RequestHistorySync(chat, anchor, 10); !errors.Is(err, ErrHistorySyncLimitReached) {
+		t.Errorf("Expected ErrHistorySyncLimitReached after the phone had no older messages, got %v", err)
+	}
+	newerAnchor := *anchor
+	newerAnchor.Timestamp = anchor.Timestamp.Add(time.Hour)
+	if err = cli.RequestHistorySync(chat, &newerAnchor, 10); err != nil {
+		t.Errorf("Expected request with a newer anchor to be sent, got %v", err)
+	}
+
+	fs.Close(0)
+	if res := <-results; res.frames != 2 {
+		t.Errorf("Expected two history sync requests to be sent, got %d frames", res.frames)
+	}
+}
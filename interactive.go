@@ -0,0 +1,384 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// ParseInteractiveMessage extracts the text and buttons of a buttons, list, template or interactive message.
+//
+// It returns nil if the message isn't any of those types. This is called automatically for incoming messages,
+// and the result is available in the Interactive field of events.Message.
+func ParseInteractiveMessage(msg *waProto.Message) *types.InteractiveInfo {
+	if buttons := msg.GetButtonsMessage(); buttons != nil {
+		info := &types.InteractiveInfo{
+			Type:   types.InteractiveButtons,
+			Title:  buttons.GetText(),
+			Text:   buttons.GetContentText(),
+			Footer: buttons.GetFooterText(),
+		}
+		for _, button := range buttons.GetButtons() {
+			info.Buttons = append(info.Buttons, types.InteractiveButton{
+				ID:    button.GetButtonId(),
+				Title: button.GetButtonText().GetDisplayText(),
+			})
+		}
+		return info
+	} else if list := msg.GetListMessage(); list != nil {
+		info := &types.InteractiveInfo{
+			Type:       types.InteractiveList,
+			Title:      list.GetTitle(),
+			Text:       list.GetDescription(),
+			Footer:     list.GetFooterText(),
+			ButtonText: list.GetButtonText(),
+		}
+		for _, section := range list.GetSections() {
+			for _, row := range section.GetRows() {
+				info.Buttons = append(info.Buttons, types.InteractiveButton{
+					ID:          row.GetRowId(),
+					Title:       row.GetTitle(),
+					Description: row.GetDescription(),
+					Section:     section.GetTitle(),
+				})
+			}
+		}
+		return info
+	} else if template := msg.GetTemplateMessage(); template != nil {
+		hydrated := template.GetHydratedTemplate()
+		if hydrated == nil {
+			hydrated = template.GetHydratedFourRowTemplate()
+		}
+		info := &types.InteractiveInfo{
+			Type:   types.InteractiveTemplate,
+			Title:  hydrated.GetHydratedTitleText(),
+			Text:   hydrated.GetHydratedContentText(),
+			Footer: hydrated.GetHydratedFooterText(),
+		}
+		for _, button := range hydrated.GetHydratedButtons() {
+			if quickReply := button.GetQuickReplyButton(); quickReply != nil {
+				info.Buttons = append(info.Buttons, types.InteractiveButton{
+					ID:    quickReply.GetId(),
+					Title: quickReply.GetDisplayText(),
+				})
+			} else if url := button.GetUrlButton(); url != nil {
+				info.Buttons = append(info.Buttons, types.InteractiveButton{
+					Title: url.GetDisplayText(),
+					URL:   url.GetUrl(),
+				})
+			} else if call := button.GetCallButton(); call != nil {
+				info.Buttons = append(info.Buttons, types.InteractiveButton{
+					Title:       call.GetDisplayText(),
+					PhoneNumber: call.GetPhoneNumber(),
+				})
+			}
+		}
+		return info
+	} else if interactive := msg.GetInteractiveMessage(); interactive != nil {
+		info := &types.InteractiveInfo{
+			Type:   types.InteractiveNativeFlow,
+			Title:  interactive.GetHeader().GetTitle(),
+			Text:   interactive.GetBody().GetText(),
+			Footer: interactive.GetFooter().GetText(),
+		}
+		for _, button := range interactive.GetNativeFlowMessage().GetButtons() {
+			if button.GetName() == "single_select" {
+				info.ButtonText, info.Buttons = parseNativeFlowList(button, info.Buttons)
+			} else {
+				info.Buttons = append(info.Buttons, parseNativeFlowButton(button))
+			}
+		}
+		return info
+	}
+	return nil
+}
+
+// nativeFlowButtonParams contains the commonly used fields of the JSON parameters of native flow buttons.
+type nativeFlowButtonParams struct {
+	DisplayText string `json:"display_text"`
+	ID          string `json:"id"`
+	URL         string `json:"url"`
+	PhoneNumber string `json:"phone_number"`
+}
+
+func parseNativeFlowButton(button *waProto.NativeFlowButton) types.InteractiveButton {
+	var params nativeFlowButtonParams
+	// The params are a freeform JSON object, so buttons with unknown params just have an empty title.
+	_ = json.Unmarshal([]byte(button.GetButtonParamsJson()), &params)
+	return types.InteractiveButton{
+		ID:          params.ID,
+		Title:       params.DisplayText,
+		URL:         params.URL,
+		PhoneNumber: params.PhoneNumber,
+	}
+}
+
+func parseNativeFlowList(button *waProto.NativeFlowButton, appendTo []types.InteractiveButton) (string, []types.InteractiveButton) {
+	var params nativeFlowListParams
+	_ = json.Unmarshal([]byte(button.GetButtonParamsJson()), &params)
+	for _, section := range params.Sections {
+		for _, row := range section.Rows {
+			appendTo = append(appendTo, types.InteractiveButton{
+				ID:          row.ID,
+				Title:       row.Title,
+				Description: row.Description,
+				Section:     section.Title,
+			})
+		}
+	}
+	return params.Title, appendTo
+}
+
+// ParseInteractiveResponse extracts the selected button or list row from a buttons response,
+// list response or template button reply message.
+//
+// It returns nil if the message isn't any of those types. This is called automatically for incoming messages,
+// and the result is available in the InteractiveResponse field of events.Message.
+func ParseInteractiveResponse(msg *waProto.Message) *types.InteractiveResponse {
+	if resp := msg.GetButtonsResponseMessage(); resp != nil {
+		return &types.InteractiveResponse{
+			Type:         types.InteractiveButtons,
+			SelectedID:   resp.GetSelectedButtonId(),
+			SelectedText: resp.GetSelectedDisplayText(),
+			ReplyTo:      resp.GetContextInfo().GetStanzaId(),
+		}
+	} else if resp := msg.GetListResponseMessage(); resp != nil {
+		return &types.InteractiveResponse{
+			Type:         types.InteractiveList,
+			SelectedID:   resp.GetSingleSelectReply().GetSelectedRowId(),
+			SelectedText: resp.GetTitle(),
+			ReplyTo:      resp.GetContextInfo().GetStanzaId(),
+		}
+	} else if resp := msg.GetTemplateButtonReplyMessage(); resp != nil {
+		return &types.InteractiveResponse{
+			Type:          types.InteractiveTemplate,
+			SelectedID:    resp.GetSelectedId(),
+			SelectedText:  resp.GetSelectedDisplayText(),
+			SelectedIndex: int(resp.GetSelectedIndex()),
+			ReplyTo:       resp.GetContextInfo().GetStanzaId(),
+		}
+	}
+	return nil
+}
+
+// Limits for interactive messages that the WhatsApp servers enforce.
+const (
+	MaxQuickReplyButtons = 3
+	MaxListRows          = 10
+)
+
+// Button is a quick reply button for SendButtons.
+type Button struct {
+	ID    string // The ID that is sent back in the response when the button is pressed.
+	Title string // The text shown on the button.
+}
+
+// ListRow is a single selectable row in a list message.
+type ListRow struct {
+	ID          string // The ID that is sent back in the response when the row is selected.
+	Title       string
+	Description string
+}
+
+// ListSection is a titled group of rows in a list message.
+type ListSection struct {
+	Title string
+	Rows  []ListRow
+}
+
+// ListMessageRequest contains the parameters for SendList.
+type ListMessageRequest struct {
+	Title      string
+	Body       string
+	Footer     string
+	ButtonText string // The text of the button that opens the list. Required.
+	Sections   []ListSection
+}
+
+func validateButtons(buttons []Button) error {
+	if len(buttons) == 0 {
+		return ErrNoButtons
+	} else if len(buttons) > MaxQuickReplyButtons {
+		return fmt.Errorf("%w (got %d)", ErrTooManyButtons, len(buttons))
+	}
+	for _, button := range buttons {
+		if len(button.ID) == 0 {
+			return ErrMissingButtonID
+		}
+	}
+	return nil
+}
+
+func validateListRequest(req *ListMessageRequest) error {
+	if len(req.ButtonText) == 0 {
+		return ErrMissingListButtonText
+	}
+	rowCount := 0
+	for _, section := range req.Sections {
+		for _, row := range section.Rows {
+			if len(row.ID) == 0 {
+				return ErrMissingButtonID
+			}
+		}
+		rowCount += len(section.Rows)
+	}
+	if rowCount == 0 {
+		return ErrNoButtons
+	} else if rowCount > MaxListRows {
+		return fmt.Errorf("%w (got %d)", ErrTooManyListRows, rowCount)
+	}
+	return nil
+}
+
+func mustMarshalJSON(data interface{}) string {
+	out, err := json.Marshal(data)
+	if err != nil {
+		// The inputs are plain structs of strings, so marshaling can't fail.
+		panic(err)
+	}
+	return string(out)
+}
+
+// BuildButtonsMessage builds a message with the given body text and quick reply buttons.
+//
+// If nativeFlow is true, the message is built as an InteractiveMessage with native flow buttons
+// instead of the older ButtonsMessage format.
+func BuildButtonsMessage(body string, buttons []Button, nativeFlow bool) (*waProto.Message, error) {
+	if err := validateButtons(buttons); err != nil {
+		return nil, err
+	}
+	if nativeFlow {
+		flowButtons := make([]*waProto.NativeFlowButton, len(buttons))
+		for i, button := range buttons {
+			flowButtons[i] = &waProto.NativeFlowButton{
+				Name:             proto.String("quick_reply"),
+				ButtonParamsJson: proto.String(mustMarshalJSON(nativeFlowButtonParams{DisplayText: button.Title, ID: button.ID})),
+			}
+		}
+		return &waProto.Message{InteractiveMessage: &waProto.InteractiveMessage{
+			Body: &waProto.Body{Text: proto.String(body)},
+			InteractiveMessage: &waProto.InteractiveMessage_NativeFlowMessage{NativeFlowMessage: &waProto.NativeFlowMessage{
+				Buttons: flowButtons,
+			}},
+		}}, nil
+	}
+	protoButtons := make([]*waProto.Button, len(buttons))
+	for i, button := range buttons {
+		protoButtons[i] = &waProto.Button{
+			ButtonId:   proto.String(button.ID),
+			ButtonText: &waProto.ButtonText{DisplayText: proto.String(button.Title)},
+			Type:       waProto.Button_RESPONSE.Enum(),
+		}
+	}
+	return &waProto.Message{ButtonsMessage: &waProto.ButtonsMessage{
+		ContentText: proto.String(body),
+		HeaderType:  waProto.ButtonsMessage_EMPTY.Enum(),
+		Buttons:     protoButtons,
+	}}, nil
+}
+
+// nativeFlowListParams is the JSON parameter format of single_select native flow buttons.
+type nativeFlowListParams struct {
+	Title    string                  `json:"title"`
+	Sections []nativeFlowListSection `json:"sections"`
+}
+
+type nativeFlowListSection struct {
+	Title string              `json:"title,omitempty"`
+	Rows  []nativeFlowListRow `json:"rows"`
+}
+
+type nativeFlowListRow struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+}
+
+// BuildListMessage builds a list message from the given request.
+//
+// If nativeFlow is true, the message is built as an InteractiveMessage with a single_select native flow button
+// instead of the older ListMessage format.
+func BuildListMessage(req ListMessageRequest, nativeFlow bool) (*waProto.Message, error) {
+	if err := validateListRequest(&req); err != nil {
+		return nil, err
+	}
+	if nativeFlow {
+		params := nativeFlowListParams{Title: req.ButtonText, Sections: make([]nativeFlowListSection, len(req.Sections))}
+		for i, section := range req.Sections {
+			params.Sections[i] = nativeFlowListSection{Title: section.Title, Rows: make([]nativeFlowListRow, len(section.Rows))}
+			for j, row := range section.Rows {
+				params.Sections[i].Rows[j] = nativeFlowListRow(row)
+			}
+		}
+		msg := &waProto.InteractiveMessage{
+			Body: &waProto.Body{Text: proto.String(req.Body)},
+			InteractiveMessage: &waProto.InteractiveMessage_NativeFlowMessage{NativeFlowMessage: &waProto.NativeFlowMessage{
+				Buttons: []*waProto.NativeFlowButton{{
+					Name:             proto.String("single_select"),
+					ButtonParamsJson: proto.String(mustMarshalJSON(params)),
+				}},
+			}},
+		}
+		if len(req.Title) > 0 {
+			msg.Header = &waProto.Header{Title: proto.String(req.Title)}
+		}
+		if len(req.Footer) > 0 {
+			msg.Footer = &waProto.Footer{Text: proto.String(req.Footer)}
+		}
+		return &waProto.Message{InteractiveMessage: msg}, nil
+	}
+	sections := make([]*waProto.Section, len(req.Sections))
+	for i, section := range req.Sections {
+		rows := make([]*waProto.Row, len(section.Rows))
+		for j, row := range section.Rows {
+			rows[j] = &waProto.Row{
+				RowId:       proto.String(row.ID),
+				Title:       proto.String(row.Title),
+				Description: proto.String(row.Description),
+			}
+		}
+		sections[i] = &waProto.Section{Title: proto.String(section.Title), Rows: rows}
+	}
+	return &waProto.Message{ListMessage: &waProto.ListMessage{
+		Title:       proto.String(req.Title),
+		Description: proto.String(req.Body),
+		FooterText:  proto.String(req.Footer),
+		ButtonText:  proto.String(req.ButtonText),
+		ListType:    waProto.ListMessage_SINGLE_SELECT.Enum(),
+		Sections:    sections,
+	}}, nil
+}
+
+// SendButtons sends a message with the given body text and up to 3 quick reply buttons to the given chat.
+//
+// WhatsApp has deprecated the old ButtonsMessage format, and the official clients of non-business accounts
+// may not render it. Set Client.SendNativeFlowMessages to send the newer InteractiveMessage format instead.
+// Either way, interactive messages sent from normal (non-business) accounts may be shown as unsupported.
+func (cli *Client) SendButtons(chat types.JID, body string, buttons []Button) (SendResponse, error) {
+	msg, err := BuildButtonsMessage(body, buttons, cli.SendNativeFlowMessages)
+	if err != nil {
+		return SendResponse{}, err
+	}
+	return cli.SendMessage(chat, "", msg)
+}
+
+// SendList sends a list message with up to 10 rows to the given chat.
+//
+// The same format limitations as with SendButtons apply.
+func (cli *Client) SendList(chat types.JID, req ListMessageRequest) (SendResponse, error) {
+	msg, err := BuildListMessage(req, cli.SendNativeFlowMessages)
+	if err != nil {
+		return SendResponse{}, err
+	}
+	return cli.SendMessage(chat, "", msg)
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+func TestParseInteractiveMessage(t *testing.T) {
+	info := ParseInteractiveMessage(&waProto.Message{ListMessage: &waProto.ListMessage{
+		Title:      proto.String("Menu"),
+		ButtonText: proto.String("Open"),
+		Sections: []*waProto.Section{{
+			Title: proto.String("Drinks"),
+			Rows: []*waProto.Row{
+				{RowId: proto.String("tea"), Title: proto.String("Tea")},
+				{RowId: proto.String("coffee"), Title: proto.String("Coffee"), Description: proto.String("Hot")},
+			},
+		}},
+	}})
+	if info == nil || info.Type != types.InteractiveList || info.ButtonText != "Open" || len(info.Buttons) != 2 {
+		t.Fatalf("Unexpected list info %+v", info)
+	}
+	if info.Buttons[1] != (types.InteractiveButton{ID: "coffee", Title: "Coffee", Description: "Hot", Section: "Drinks"}) {
+		t.Errorf("Unexpected list row %+v", info.Buttons[1])
+	}
+
+	info = ParseInteractiveMessage(&waProto.Message{InteractiveMessage: &waProto.InteractiveMessage{
+		Body: &waProto.Body{Text: proto.String("Pick one")},
+		InteractiveMessage: &waProto.InteractiveMessage_NativeFlowMessage{NativeFlowMessage: &waProto.NativeFlowMessage{
+			Buttons: []*waProto.NativeFlowButton{{
+				Name:             proto.String("quick_reply"),
+				ButtonParamsJson: proto.String(`{"display_text":"Yes","id":"yes"}`),
+			}},
+		}},
+	}})
+	if info == nil || info.Text != "Pick one" || len(info.Buttons) != 1 || info.Buttons[0].ID != "yes" || info.Buttons[0].Title != "Yes" {
+		t.Errorf("Unexpected native flow info %+v", info)
+	}
+
+	if ParseInteractiveMessage(&waProto.Message{Conversation: proto.String("hi")}) != nil {
+		t.Error("Expected nil info for plain text message")
+	}
+}
+
+func TestParseInteractiveResponse(t *testing.T) {
+	resp := ParseInteractiveResponse(&waProto.Message{ListResponseMessage: &waProto.ListResponseMessage{
+		Title:             proto.String("Coffee"),
+		SingleSelectReply: &waProto.SingleSelectReply{SelectedRowId: proto.String("coffee")},
+		ContextInfo:       &waProto.ContextInfo{StanzaId: proto.String("ABCD")},
+	}})
+	expected := types.InteractiveResponse{Type: types.InteractiveList, SelectedID: "coffee", SelectedText: "Coffee", ReplyTo: "ABCD"}
+	if resp == nil || *resp != expected {
+		t.Errorf("Unexpected list response %+v", resp)
+	}
+}
+
+func TestBuildListMessageRoundTrip(t *testing.T) {
+	req := ListMessageRequest{
+		Body:       "Pick a drink",
+		ButtonText: "Menu",
+		Sections: []ListSection{{
+			Title: "Drinks",
+			Rows:  []ListRow{{ID: "tea", Title: "Tea"}, {ID: "coffee", Title: "Coffee", Description: "Hot"}},
+		}},
+	}
+	for _, nativeFlow := range []bool{false, true} {
+		msg, err := BuildListMessage(req, nativeFlow)
+		if err != nil {
+			t.Fatalf("Unexpected error (native flow: %t): %v", nativeFlow, err)
+		}
+		info := ParseInteractiveMessage(msg)
+		if info == nil || info.Text != "Pick a drink" || info.ButtonText != "Menu" || len(info.Buttons) != 2 {
+			t.Fatalf("Unexpected parsed list (native flow: %t): %+v", nativeFlow, info)
+		}
+		if info.Buttons[1] != (types.InteractiveButton{ID: "coffee", Title: "Coffee", Description: "Hot", Section: "Drinks"}) {
+			t.Errorf("Unexpected parsed row (native flow: %t): %+v", nativeFlow, info.Buttons[1])
+		}
+	}
+}
+
+func TestInteractiveMessageLimits(t *testing.T) {
+	_, err := BuildButtonsMessage("body", []Button{{ID: "1"}, {ID: "2"}, {ID: "3"}, {ID: "4"}}, false)
+	if !errors.Is(err, ErrTooManyButtons) {
+		t.Errorf("Expected ErrTooManyButtons, got %v", err)
+	}
+	_, err = BuildButtonsMessage("body", nil, true)
+	if !errors.Is(err, ErrNoButtons) {
+		t.Errorf("Expected ErrNoButtons, got %v", err)
+	}
+	rows := make([]ListRow, MaxListRows+1)
+	for i := range rows {
+		rows[i].ID = strconv.Itoa(i)
+	}
+	_, err = BuildListMessage(ListMessageRequest{ButtonText: "Menu", Sections: []ListSection{{Rows: rows}}}, false)
+	if !errors.Is(err, ErrTooManyListRows) {
+		t.Errorf("Expected ErrTooManyListRows, got %v", err)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	waBinary "go.mau.fi/whatsmeow/binary"
+)
+
+// DangerousInternalClient exposes some of the low-level methods of Client for experimenting with protocol
+// features that whatsmeow doesn't wrap yet. There are no stability guarantees: methods may change or be
+// removed in any release, and sending invalid nodes can get the connection (or even the account) banned.
+type DangerousInternalClient struct {
+	c *Client
+}
+
+// DangerousInfoQuery is an info query (IQ) request for DangerousInternalClient.SendIQ.
+//
+// If ID is empty, a unique request ID is generated. If Timeout is zero, it defaults to one minute.
+type DangerousInfoQuery = infoQuery
+
+// DangerousInternals returns a wrapper that gives access to low-level methods of the client.
+//
+// To receive nodes that the built-in handlers didn't consume, set Client.UnhandledNodeHandler.
+func (cli *Client) DangerousInternals() *DangerousInternalClient {
+	return &DangerousInternalClient{cli}
+}
+
+// SendIQ sends the given info query and waits for the response.
+//
+// The response is matched to the request by ID. An error is returned if the response has type "error",
+// if it doesn't arrive before the timeout or context cancellation, or if the websocket disconnects.
+func (ic *DangerousInternalClient) SendIQ(query DangerousInfoQuery) (*waBinary.Node, error) {
+	return ic.c.sendIQ(query)
+}
+
+// SendIQAsync sends the given info query and returns a channel that will receive the response.
+//
+// Unlike SendIQ, this doesn't have a timeout or limit concurrency, and the response node isn't checked for errors.
+func (ic *DangerousInternalClient) SendIQAsync(query DangerousInfoQuery) (<-chan *waBinary.Node, error) {
+	return ic.c.sendIQAsync(query)
+}
+
+// SendNode sends the given node to the server without waiting for any response.
+func (ic *DangerousInternalClient) SendNode(node waBinary.Node) error {
+	return ic.c.sendNode(node)
+}
+
+// GenerateRequestID generates a unique ID for a request node.
+func (ic *DangerousInternalClient) GenerateRequestID() string {
+	return ic.c.generateRequestID()
+}
@@ -26,6 +26,7 @@ var (
 
 func (cli *Client) keepAliveLoop(ctx context.Context) {
 	for {
+		// The interval is only jitter, so it doesn't need to be cryptographically secure.
 		interval := rand.Int63n(KeepAliveIntervalMax.Milliseconds()-KeepAliveIntervalMin.Milliseconds()) + KeepAliveIntervalMin.Milliseconds()
 		select {
 		case <-time.After(time.Duration(interval) * time.Millisecond):
@@ -55,6 +56,7 @@ func (cli *Client) sendKeepAlive(ctx context.Context) bool {
 	case <-time.After(KeepAliveResponseDeadline):
 		// TODO disconnect websocket?
 		cli.Log.Warnf("Keepalive timed out")
+		cli.incrCounter(&cli.metrics.keepAliveMisses, MetricKeepAliveMisses, 1)
 	case <-ctx.Done():
 		return false
 	}
@@ -1,6 +1,6 @@
 module go.mau.fi/whatsmeow/mdtest
 
-go 1.17
+go 1.21
 
 require (
 	github.com/mattn/go-sqlite3 v1.14.8
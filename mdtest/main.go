@@ -15,6 +15,8 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -44,10 +46,12 @@ func getDevice() *store.Device {
 		return nil
 	}
 	storeContainer := sqlstore.NewWithDB(db, "sqlite3", waLog.Stdout("Database", true))
-	err = storeContainer.Upgrade()
+	applied, err := storeContainer.UpgradeWithResult()
 	if err != nil {
 		log.Errorf("Failed to upgrade database: %v", err)
 		return nil
+	} else if len(applied) > 0 {
+		log.Infof("Applied database migrations %v", applied)
 	}
 	devices, err := storeContainer.GetAllDevices()
 	if err != nil {
@@ -70,6 +74,7 @@ func main() {
 	}
 
 	cli = whatsmeow.NewClient(device, waLog.Stdout("Client", true))
+	cli.EnableRawNodeLogging = true
 	err := cli.Connect()
 	if err != nil {
 		log.Errorf("Failed to connect: %v", err)
@@ -131,9 +136,133 @@ func handleCmd(cmd string, args []string) {
 		fmt.Printf("%+v\n", resp)
 	case "presence":
 		fmt.Println(cli.SendPresence(types.Presence(args[0])))
+	case "subscribepresence", "unsubscribepresence":
+		jid := types.NewJID(args[0], types.DefaultUserServer)
+		if cmd == "subscribepresence" {
+			fmt.Println(cli.SubscribePresence(jid))
+		} else {
+			fmt.Println(cli.UnsubscribePresence(jid))
+		}
 	case "chatpresence":
 		jid, _ := types.ParseJID(args[1])
 		fmt.Println(cli.SendChatPresence(types.ChatPresence(args[0]), jid))
+	case "setpushname":
+		fmt.Println(cli.SetPushName(strings.Join(args, " ")))
+	case "archive", "pin":
+		jid, _ := types.ParseJID(args[0])
+		enable := len(args) < 2 || args[1] != "false"
+		if cmd == "archive" {
+			fmt.Println(cli.SetChatArchived(jid, enable))
+		} else {
+			fmt.Println(cli.SetChatPinned(jid, enable))
+		}
+	case "mute":
+		jid, _ := types.ParseJID(args[0])
+		var until time.Time
+		if len(args) > 1 {
+			hours, err := strconv.Atoi(args[1])
+			if err != nil {
+				log.Errorf("Invalid duration: %v", err)
+				return
+			}
+			until = time.Now().Add(time.Duration(hours) * time.Hour)
+		}
+		fmt.Println(cli.SetChatMuted(jid, until))
+	case "createlabel":
+		if len(args) < 2 {
+			log.Errorf("Usage: createlabel <color> <name>")
+			return
+		}
+		color, _ := strconv.Atoi(args[0])
+		labelID, err := cli.CreateLabel(strings.Join(args[1:], " "), int32(color))
+		fmt.Println(labelID, err)
+	case "deletelabel":
+		fmt.Println(cli.DeleteLabel(args[0]))
+	case "labelchat":
+		if len(args) < 2 {
+			log.Errorf("Usage: labelchat <chat jid> <label id> [true/false]")
+			return
+		}
+		jid, _ := types.ParseJID(args[0])
+		fmt.Println(cli.SetChatLabel(jid, args[1], len(args) < 3 || args[2] != "false"))
+	case "markchatread":
+		jid, _ := types.ParseJID(args[0])
+		fmt.Println(cli.MarkChatRead(jid, len(args) < 2 || args[1] != "false", nil))
+	case "deletechat":
+		jid, _ := types.ParseJID(args[0])
+		fmt.Println(cli.DeleteChat(jid))
+	case "clearchat":
+		jid, _ := types.ParseJID(args[0])
+		fmt.Println(cli.ClearChat(jid, len(args) > 1 && args[1] == "keepstarred"))
+	case "rejectcall":
+		if len(args) < 2 {
+			log.Errorf("Usage: rejectcall <caller jid> <call id>")
+			return
+		}
+		jid, _ := types.ParseJID(args[0])
+		fmt.Println(cli.RejectCall(jid, args[1]))
+	case "networkstats":
+		fmt.Printf("%+v\n", cli.NetworkStats())
+	case "star":
+		if len(args) < 4 {
+			log.Errorf("Usage: star <chat jid> <sender jid> <message id> <true/false>")
+			return
+		}
+		chat, _ := types.ParseJID(args[0])
+		sender, _ := types.ParseJID(args[1])
+		fromMe := sender.User == cli.Store.ID.User
+		fmt.Println(cli.MarkMessageStarred(chat, sender, args[2], fromMe, args[3] != "false"))
+	case "setstatus":
+		fmt.Println(cli.SetStatusMessage(strings.Join(args, " ")))
+	case "privacysettings":
+		resp, err := cli.GetPrivacySettings()
+		fmt.Println(err)
+		fmt.Printf("%+v\n", resp)
+	case "statusprivacy":
+		resp, err := cli.GetStatusPrivacy()
+		fmt.Println(err)
+		fmt.Printf("%+v\n", resp)
+	case "searchcontacts":
+		if len(args) < 1 {
+			log.Errorf("Usage: searchcontacts <query>")
+			return
+		}
+		contacts, err := cli.Store.Contacts.SearchContacts(strings.Join(args, " "), 20)
+		if err != nil {
+			log.Errorf("Failed to search contacts: %v", err)
+		}
+		for _, contact := range contacts {
+			log.Infof("%s: %+v", contact.JID, contact)
+		}
+	case "getstatus":
+		status, err := cli.GetMyStatus()
+		if err != nil {
+			log.Errorf("Failed to get status: %v", err)
+		} else {
+			log.Infof("Current status: %s", status)
+		}
+	case "appstatekeys":
+		keys, err := cli.Store.AppStateKeys.GetAllAppStateSyncKeys()
+		if err != nil {
+			log.Errorf("Failed to get app state keys: %v", err)
+		}
+		for _, key := range keys {
+			log.Infof("%X: timestamp %d, fingerprint %X", key.ID, key.Timestamp, key.Fingerprint)
+		}
+	case "stats":
+		log.Infof("Client stats: %+v", cli.Stats())
+	case "getblocklist":
+		blocklist, err := cli.GetBlocklist()
+		fmt.Println(err)
+		fmt.Printf("%+v\n", blocklist)
+	case "block", "unblock":
+		action := whatsmeow.Block
+		if cmd == "unblock" {
+			action = whatsmeow.Unblock
+		}
+		blocklist, err := cli.UpdateBlocklist(types.NewJID(args[0], types.DefaultUserServer), action)
+		fmt.Println(err)
+		fmt.Printf("%+v\n", blocklist)
 	case "getuser":
 		var jids []types.JID
 		for _, jid := range args {
@@ -142,27 +271,111 @@ func handleCmd(cmd string, args []string) {
 		resp, err := cli.GetUserInfo(jids)
 		fmt.Println(err)
 		fmt.Printf("%+v\n", resp)
+	case "getbusiness":
+		profile, err := cli.GetBusinessProfile(types.NewJID(args[0], types.DefaultUserServer))
+		fmt.Println(err)
+		fmt.Printf("%+v\n", profile)
+	case "getcatalog":
+		var params whatsmeow.GetBusinessCatalogParams
+		if len(args) > 1 {
+			params.Cursor = args[1]
+		}
+		page, err := cli.GetBusinessCatalog(types.NewJID(args[0], types.DefaultUserServer), params)
+		fmt.Println(err)
+		fmt.Printf("%+v\n", page)
+	case "getnewsletter":
+		var info *types.NewsletterMetadata
+		var err error
+		if jid, parseErr := types.ParseJID(args[0]); parseErr == nil && jid.Server == types.NewsletterServer {
+			info, err = cli.GetNewsletterInfo(jid)
+		} else {
+			info, err = cli.GetNewsletterInfoWithInvite(args[0])
+		}
+		fmt.Println(err)
+		fmt.Printf("%+v\n", info)
+	case "follownewsletter", "unfollownewsletter":
+		jid, _ := types.ParseJID(args[0])
+		if cmd == "follownewsletter" {
+			fmt.Println(cli.FollowNewsletter(jid))
+		} else {
+			fmt.Println(cli.UnfollowNewsletter(jid))
+		}
+	case "newslettermessages":
+		jid, _ := types.ParseJID(args[0])
+		messages, err := cli.GetNewsletterMessages(jid, whatsmeow.GetNewsletterMessagesParams{})
+		fmt.Println(err)
+		for _, msg := range messages {
+			fmt.Printf("%d (%d views, %v): %+v\n", msg.ServerID, msg.ViewsCount, msg.ReactionCounts, msg.Message)
+		}
 	case "getavatar":
 		jid := types.NewJID(args[0], types.DefaultUserServer)
 		if len(args) > 1 && args[1] == "group" {
 			jid.Server = types.GroupServer
 			args = args[1:]
 		}
-		pic, err := cli.GetProfilePictureInfo(jid, len(args) > 1 && args[1] == "preview")
+		pic, err := cli.GetProfilePictureInfo(jid, whatsmeow.GetProfilePictureParams{
+			Preview: len(args) > 1 && args[1] == "preview",
+		})
 		fmt.Println(err)
 		fmt.Printf("%+v\n", pic)
 	case "getgroup":
 		resp, err := cli.GetGroupInfo(types.NewJID(args[0], types.GroupServer))
 		fmt.Println(err)
 		fmt.Printf("%+v\n", resp)
+	case "listgroups":
+		groups, err := cli.GetJoinedGroups()
+		fmt.Println(err)
+		for _, group := range groups {
+			fmt.Printf("%+v\n", group)
+		}
+	case "setgroupname":
+		fmt.Println(cli.SetGroupName(types.NewJID(args[0], types.GroupServer), strings.Join(args[1:], " ")))
+	case "setgrouptopic":
+		fmt.Println(cli.SetGroupTopic(types.NewJID(args[0], types.GroupServer), strings.Join(args[1:], " "), ""))
+	case "setannounce", "setlocked", "setjoinapproval":
+		jid := types.NewJID(args[0], types.GroupServer)
+		enable := len(args) < 2 || args[1] != "off"
+		switch cmd {
+		case "setannounce":
+			fmt.Println(cli.SetGroupAnnounce(jid, enable))
+		case "setlocked":
+			fmt.Println(cli.SetGroupLocked(jid, enable))
+		case "setjoinapproval":
+			fmt.Println(cli.SetGroupJoinApprovalMode(jid, enable))
+		}
+	case "setmemberaddmode":
+		fmt.Println(cli.SetGroupMemberAddMode(types.NewJID(args[0], types.GroupServer), types.GroupMemberAddMode(args[1])))
+	case "setdisappeartimer":
+		days, err := strconv.Atoi(args[1])
+		if err != nil {
+			log.Errorf("Invalid timer: %v", err)
+			return
+		}
+		fmt.Println(cli.SetGroupEphemeralTimer(types.NewJID(args[0], types.GroupServer), time.Duration(days)*24*time.Hour))
+	case "getinvitelink":
+		link, err := cli.GetGroupInviteLink(types.NewJID(args[0], types.GroupServer), len(args) > 1 && args[1] == "reset")
+		fmt.Println(err)
+		fmt.Println(link)
+	case "queryinvitelink":
+		resp, err := cli.GetGroupInfoFromLink(args[0])
+		fmt.Println(err)
+		fmt.Printf("%+v\n", resp)
+	case "joininvitelink":
+		groupID, err := cli.JoinGroupWithLink(args[0])
+		fmt.Println(err)
+		fmt.Println(groupID)
 	case "send", "gsend":
-		msg := &waProto.Message{Conversation: proto.String(strings.Join(args[1:], " "))}
+		msg, err := whatsmeow.NewTextMessage(strings.Join(args[1:], " "), nil)
+		if err != nil {
+			fmt.Println("Failed to build message:", err)
+			return
+		}
 		recipient := types.NewJID(args[0], types.DefaultUserServer)
 		if cmd == "gsend" {
 			recipient.Server = types.GroupServer
 		}
-		err := cli.SendMessage(recipient, "", msg)
-		fmt.Println("Send message response:", err)
+		resp, err := cli.SendMessage(recipient, "", msg)
+		fmt.Println("Send message response:", resp, err)
 	case "sendimg", "gsendimg":
 		data, err := os.ReadFile(args[1])
 		if err != nil {
@@ -188,8 +401,56 @@ func handleCmd(cmd string, args []string) {
 		if cmd == "gsendimg" {
 			recipient.Server = types.GroupServer
 		}
-		err = cli.SendMessage(recipient, "", msg)
-		fmt.Println("Send image error:", err)
+		resp, err := cli.SendMessage(recipient, "", msg)
+		fmt.Println("Send image response:", resp, err)
+	case "sendptt", "gsendptt":
+		data, err := os.ReadFile(args[1])
+		if err != nil {
+			fmt.Printf("Failed to read %s: %v\n", args[1], err)
+			return
+		}
+		recipient := types.NewJID(args[0], types.DefaultUserServer)
+		if cmd == "gsendptt" {
+			recipient.Server = types.GroupServer
+		}
+		resp, err := cli.SendAudio(recipient, data, true)
+		fmt.Println("Send voice note response:", resp, err)
+	case "sendsticker", "gsendsticker":
+		data, err := os.ReadFile(args[1])
+		if err != nil {
+			fmt.Printf("Failed to read %s: %v\n", args[1], err)
+			return
+		}
+		recipient := types.NewJID(args[0], types.DefaultUserServer)
+		if cmd == "gsendsticker" {
+			recipient.Server = types.GroupServer
+		}
+		resp, err := cli.SendSticker(recipient, data)
+		fmt.Println("Send sticker response:", resp, err)
+	case "sendmedia", "gsendmedia":
+		data, err := os.ReadFile(args[1])
+		if err != nil {
+			fmt.Printf("Failed to read %s: %v\n", args[1], err)
+			return
+		}
+		recipient := types.NewJID(args[0], types.DefaultUserServer)
+		if cmd == "gsendmedia" {
+			recipient.Server = types.GroupServer
+		}
+		resp, err := cli.SendMedia(recipient, data, strings.Join(args[2:], " "))
+		fmt.Println("Send media response:", resp, err)
+	case "senddoc", "gsenddoc":
+		data, err := os.ReadFile(args[1])
+		if err != nil {
+			fmt.Printf("Failed to read %s: %v\n", args[1], err)
+			return
+		}
+		recipient := types.NewJID(args[0], types.DefaultUserServer)
+		if cmd == "gsenddoc" {
+			recipient.Server = types.GroupServer
+		}
+		resp, err := cli.SendDocument(recipient, data, filepath.Base(args[1]), "")
+		fmt.Println("Send document response:", resp, err)
 	}
 }
 
@@ -222,10 +483,67 @@ func handler(rawEvt interface{}) {
 			}
 			fmt.Println("Saved image to", path)
 		}
+		doc := evt.Message.GetDocumentMessage()
+		if doc != nil {
+			data, err := cli.Download(doc)
+			if err != nil {
+				fmt.Println("Failed to download document:", err)
+				return
+			}
+			path := fmt.Sprintf("%s-%s", evt.Info.ID, filepath.Base(doc.GetFileName()))
+			err = os.WriteFile(path, data, 0600)
+			if err != nil {
+				fmt.Println("Failed to save document:", err)
+				return
+			}
+			fmt.Println("Saved document to", path)
+		}
+	case *events.NewsletterMessage:
+		log.Infof("Received newsletter message %d in %s: %+v", evt.ServerID, evt.NewsletterJID, evt.Message)
+	case *events.OfflineSyncPreview:
+		log.Infof("Server has %d offline items to send", evt.Total)
+	case *events.OfflineSyncCompleted:
+		log.Infof("Offline sync completed, handled %d/%d items", evt.Processed, evt.Count)
+	case *events.CallOffer:
+		log.Infof("Incoming %s call %s from %s", evt.Type, evt.CallID, evt.CallCreator)
+	case *events.CallTerminate:
+		log.Infof("Call %s from %s ended: %s", evt.CallID, evt.CallCreator, evt.Reason)
+	case *events.Revoke:
+		log.Infof("%s revoked message %s in %s", evt.Info.Sender, evt.MessageID, evt.Info.Chat)
+	case *events.EphemeralSetting:
+		log.Infof("Disappearing message timer in %s changed to %s", evt.Info.Chat, evt.Expiration)
+	case *events.Presence:
+		if evt.Unavailable {
+			if evt.LastSeen.IsZero() {
+				log.Infof("%s is now offline", evt.From)
+			} else {
+				log.Infof("%s is now offline (last seen: %s)", evt.From, evt.LastSeen)
+			}
+		} else {
+			log.Infof("%s is now online", evt.From)
+		}
 	case *events.Receipt:
-		log.Infof("Received receipt: %+v", evt)
+		if evt.IsFromMe && (evt.Type == events.ReceiptTypeRead || evt.Type == events.ReceiptTypeReadSelf) {
+			log.Infof("%v was read by %s on another device at %s", append([]string{evt.MessageID}, evt.PreviousIDs...), evt.Sender, evt.Timestamp)
+		} else {
+			log.Infof("Received receipt: %+v", evt)
+		}
 	case *events.AppState:
 		log.Debugf("App state event: %+v / %+v", evt.Index, evt.SyncActionValue)
+	case *events.AppStateKeysNotReceived:
+		log.Warnf("Phone didn't send %d requested keys for app state %s", len(evt.KeyIDs), evt.Name)
+	case *events.UserAbout:
+		log.Infof("%s changed their status to %q", evt.JID, evt.Status)
+	case *events.PrimaryDeviceOffline:
+		log.Warnf("Phone seems to be offline")
+	case *events.PrimaryDeviceOnline:
+		log.Infof("Phone is online again")
+	case *events.AppStateSyncProgress:
+		if evt.SnapshotBytesTotal > 0 {
+			log.Infof("Syncing app state %s: decoded %d/%d bytes of snapshot", evt.Name, evt.SnapshotBytesDecoded, evt.SnapshotBytesTotal)
+		} else {
+			log.Infof("Syncing app state %s: reached v%d after %d patches", evt.Name, evt.Version, evt.PatchesProcessed)
+		}
 	}
 }
 
@@ -40,17 +40,17 @@ func (mc *MediaConn) Expiry() time.Time {
 	return mc.FetchedAt.Add(time.Duration(mc.TTL) * time.Second)
 }
 
-func (cli *Client) refreshMediaConn(force bool) error {
+func (cli *Client) refreshMediaConn(force bool) (*MediaConn, error) {
 	cli.mediaConnLock.Lock()
 	defer cli.mediaConnLock.Unlock()
-	if cli.mediaConn == nil || force || time.Now().After(cli.mediaConn.Expiry()) {
+	if cli.mediaConn == nil || force || cli.now().After(cli.mediaConn.Expiry()) {
 		var err error
 		cli.mediaConn, err = cli.queryMediaConn()
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
-	return nil
+	return cli.mediaConn, nil
 }
 
 func (cli *Client) queryMediaConn() (*MediaConn, error) {
@@ -68,7 +68,7 @@ func (cli *Client) queryMediaConn() (*MediaConn, error) {
 	respMC := resp.GetChildren()[0]
 	var mc MediaConn
 	ag := respMC.AttrGetter()
-	mc.FetchedAt = time.Now()
+	mc.FetchedAt = cli.now()
 	mc.Auth = ag.String("auth")
 	mc.TTL = ag.Int("ttl")
 	mc.AuthTTL = ag.Int("auth_ttl")
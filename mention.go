@@ -0,0 +1,122 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"fmt"
+	"regexp"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+var mentionRegex = regexp.MustCompile(`\B@(\d{5,16})\b`)
+
+// ParseMentions finds all @<phone number> tokens in the given text and returns them as user JIDs.
+// Each user is only included once, in the order of their first mention.
+func ParseMentions(text string) []types.JID {
+	matches := mentionRegex.FindAllStringSubmatch(text, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+	jids := make([]types.JID, 0, len(matches))
+	seen := make(map[string]struct{}, len(matches))
+	for _, match := range matches {
+		if _, ok := seen[match[1]]; ok {
+			continue
+		}
+		seen[match[1]] = struct{}{}
+		jids = append(jids, types.NewJID(match[1], types.DefaultUserServer))
+	}
+	return jids
+}
+
+func normalizeMention(jid types.JID) (types.JID, error) {
+	jid = jid.ToNonAD()
+	switch jid.Server {
+	case types.DefaultUserServer:
+	case types.LegacyUserServer:
+		jid.Server = types.DefaultUserServer
+	default:
+		return jid, fmt.Errorf("%w (got %s)", ErrMentionNotUser, jid)
+	}
+	if len(jid.User) == 0 {
+		return jid, fmt.Errorf("%w (got %s)", ErrMentionNotUser, jid)
+	}
+	return jid, nil
+}
+
+// NewTextMessage builds a text message with the given mentions.
+//
+// If mentions is nil, the mentions are parsed from @<phone number> tokens in the text using
+// ParseMentions. The mentioned JIDs must be users: AD JIDs and legacy @c.us JIDs are
+// normalized to regular user JIDs, while groups and other non-user JIDs cause an error.
+//
+// Messages without any mentions are built as a plain conversation message.
+func NewTextMessage(text string, mentions []types.JID) (*waProto.Message, error) {
+	if mentions == nil {
+		mentions = ParseMentions(text)
+	}
+	if len(mentions) == 0 {
+		return &waProto.Message{Conversation: proto.String(text)}, nil
+	}
+	mentionStrings := make([]string, len(mentions))
+	for i, jid := range mentions {
+		normalized, err := normalizeMention(jid)
+		if err != nil {
+			return nil, err
+		}
+		mentionStrings[i] = normalized.String()
+	}
+	return &waProto.Message{
+		ExtendedTextMessage: &waProto.ExtendedTextMessage{
+			Text: proto.String(text),
+			ContextInfo: &waProto.ContextInfo{
+				MentionedJid: mentionStrings,
+			},
+		},
+	}, nil
+}
+
+type messageWithContextInfo interface {
+	GetContextInfo() *waProto.ContextInfo
+}
+
+// getContextInfo returns the ContextInfo of whichever message type is set in the given message.
+func getContextInfo(msg *waProto.Message) *waProto.ContextInfo {
+	contextables := []messageWithContextInfo{
+		msg.GetExtendedTextMessage(), msg.GetImageMessage(), msg.GetVideoMessage(), msg.GetAudioMessage(),
+		msg.GetDocumentMessage(), msg.GetStickerMessage(), msg.GetLocationMessage(), msg.GetContactMessage(),
+		msg.GetLiveLocationMessage(), msg.GetContactsArrayMessage(), msg.GetGroupInviteMessage(),
+		msg.GetButtonsMessage(), msg.GetListMessage(), msg.GetTemplateMessage(), msg.GetProductMessage(),
+		msg.GetInteractiveMessage(), msg.GetButtonsResponseMessage(), msg.GetListResponseMessage(),
+		msg.GetTemplateButtonReplyMessage(),
+	}
+	for _, contextable := range contextables {
+		if ctxInfo := contextable.GetContextInfo(); ctxInfo != nil {
+			return ctxInfo
+		}
+	}
+	return nil
+}
+
+func parseMentionedJIDs(ctxInfo *waProto.ContextInfo) []types.JID {
+	rawMentions := ctxInfo.GetMentionedJid()
+	if len(rawMentions) == 0 {
+		return nil
+	}
+	mentions := make([]types.JID, 0, len(rawMentions))
+	for _, rawJID := range rawMentions {
+		jid, err := types.ParseJID(rawJID)
+		if err == nil {
+			mentions = append(mentions, jid)
+		}
+	}
+	return mentions
+}
@@ -32,9 +32,22 @@ import (
 var pbSerializer = store.SignalProtobufSerializer
 
 func (cli *Client) handleEncryptedMessage(node *waBinary.Node) {
+	if from, ok := node.Attrs["from"].(types.JID); ok && from.Server == types.NewsletterServer {
+		cli.handleNewsletterMessage(node)
+		return
+	}
 	info, err := cli.parseMessageInfo(node)
 	if err != nil {
 		cli.Log.Warnf("Failed to parse message: %v", err)
+	} else if cli.isDuplicateMessage(info) {
+		// The message was already handled, but the server redelivered it, probably because the ack didn't go through.
+		cli.Log.Debugf("Ignoring duplicate message %s from %s", info.ID, info.SourceString())
+		if cli.ReceiptBatchWindow > 0 {
+			// Batched receipts are dropped when disconnecting, so the receipt may not have been sent either.
+			cli.queueMessageReceipt(info, node)
+		} else {
+			go cli.sendAck(node)
+		}
 	} else {
 		if len(info.PushName) > 0 && info.PushName != "-" {
 			go cli.updatePushName(info.Sender, info, info.PushName)
@@ -59,6 +72,9 @@ func (cli *Client) parseMessageSource(node *waBinary.Node) (source types.Message
 				source.IsFromMe = true
 			}
 		}
+		if cachedInfo := cli.peekCachedGroupInfo(from); cachedInfo != nil {
+			source.IsCommunityAnnouncement = cachedInfo.IsCommunityAnnouncementGroup()
+		}
 	} else if from.User == cli.Store.ID.User {
 		source.IsFromMe = true
 		source.Sender = from
@@ -67,6 +83,8 @@ func (cli *Client) parseMessageSource(node *waBinary.Node) (source types.Message
 			source.Chat = from
 		} else {
 			source.Chat = recipient
+			// Receipts from our own other devices may point at a group chat in the recipient attribute.
+			source.IsGroup = recipient.Server == types.GroupServer || recipient.Server == types.BroadcastServer
 		}
 	} else {
 		source.Chat = from
@@ -87,6 +105,7 @@ func (cli *Client) parseMessageInfo(node *waBinary.Node) (*types.MessageInfo, er
 	if !ok {
 		return nil, fmt.Errorf("didn't find valid `id` attribute in message")
 	}
+	_, info.Offline = node.Attrs["offline"]
 	ts, ok := node.Attrs["t"].(string)
 	if !ok {
 		return nil, fmt.Errorf("didn't find valid `t` (timestamp) attribute in message")
@@ -97,8 +116,14 @@ func (cli *Client) parseMessageInfo(node *waBinary.Node) (*types.MessageInfo, er
 	}
 	info.Timestamp = time.Unix(tsInt, 0)
 
-	info.PushName, _ = node.Attrs["notify"].(string)
-	info.Category, _ = node.Attrs["category"].(string)
+	ag := node.AttrGetter()
+	info.Type = ag.OptionalString("type")
+	info.PushName = ag.OptionalString("notify")
+	info.Category = ag.OptionalString("category")
+	info.Multicast = ag.OptionalBool("multicast")
+	info.Edit = types.EditAttribute(ag.OptionalString("edit"))
+	info.IsEdit = info.Edit == types.EditAttributeMessageEdit || info.Edit == types.EditAttributeAdminEdit
+	info.IsRevoke = info.Edit == types.EditAttributeSenderRevoke || info.Edit == types.EditAttributeAdminRevoke
 
 	return &info, nil
 }
@@ -106,6 +131,7 @@ func (cli *Client) parseMessageInfo(node *waBinary.Node) (*types.MessageInfo, er
 func (cli *Client) decryptMessages(info *types.MessageInfo, node *waBinary.Node) {
 	if len(node.GetChildrenByTag("unavailable")) == len(node.GetChildren()) {
 		cli.Log.Warnf("Unavailable message %s from %s", info.ID, info.SourceString())
+		cli.incrCounter(&cli.metrics.decryptionFailures, MetricDecryptionFailures, 1)
 		go cli.sendRetryReceipt(node, true)
 		go cli.dispatchEvent(&events.UndecryptableMessage{Info: *info, IsUnavailable: true})
 		return
@@ -133,9 +159,16 @@ func (cli *Client) decryptMessages(info *types.MessageInfo, node *waBinary.Node)
 		}
 		if err != nil {
 			cli.Log.Warnf("Error decrypting message from %s: %v", info.SourceString(), err)
-			go cli.sendRetryReceipt(node, false)
+			cli.incrCounter(&cli.metrics.decryptionFailures, MetricDecryptionFailures, 1)
+			if encType == "msg" && isBadMACError(err) && cli.recordBadMAC(info.Sender) {
+				cli.recoverSession(info, node)
+			} else {
+				go cli.sendRetryReceipt(node, false)
+			}
 			go cli.dispatchEvent(&events.UndecryptableMessage{Info: *info, IsUnavailable: false})
 			return
+		} else if encType != "skmsg" {
+			cli.resetBadMACs(info.Sender)
 		}
 
 		var msg waProto.Message
@@ -145,20 +178,20 @@ func (cli *Client) decryptMessages(info *types.MessageInfo, node *waBinary.Node)
 			continue
 		}
 
+		cli.incrCounter(&cli.metrics.messagesReceived, MetricMessagesReceived, 1)
 		cli.handleDecryptedMessage(info, &msg)
 		handled = true
 	}
 	if handled {
-		go func() {
-			cli.sendMessageReceipt(info)
-			cli.sendAck(node)
-		}()
+		cli.markMessageHandled(info)
+		cli.queueMessageReceipt(info, node)
 	}
 }
 
 func (cli *Client) decryptDM(child *waBinary.Node, from types.JID, isPreKey bool) ([]byte, error) {
 	content, _ := child.Content.([]byte)
 
+	defer cli.signalLocks.Lock(from.SignalAddress().String())()
 	builder := session.NewBuilderFromSignal(cli.Store, from.SignalAddress(), pbSerializer)
 	cipher := session.NewCipher(builder, from.SignalAddress())
 	var plaintext []byte
@@ -188,6 +221,7 @@ func (cli *Client) decryptGroupMsg(child *waBinary.Node, from types.JID, chat ty
 	content, _ := child.Content.([]byte)
 
 	senderKeyName := protocol.NewSenderKeyName(chat.String(), from.SignalAddress())
+	defer cli.signalLocks.Lock(senderKeyName.GroupID() + "/" + from.SignalAddress().String())()
 	builder := groups.NewGroupSessionBuilder(cli.Store, pbSerializer)
 	cipher := groups.NewGroupCipher(builder, senderKeyName, cli.Store)
 	msg, err := protocol.NewSenderKeyMessageFromBytes(content, pbSerializer.SenderKeyMessage)
@@ -238,7 +272,9 @@ func (cli *Client) handleSenderKeyDistributionMessage(chat, from types.JID, rawS
 		cli.Log.Errorf("Failed to parse sender key distribution message from %s for %s: %v", from, chat, err)
 		return
 	}
+	unlock := cli.signalLocks.Lock(senderKeyName.GroupID() + "/" + from.SignalAddress().String())
 	builder.Process(senderKeyName, sdkMsg)
+	unlock()
 	cli.Log.Debugf("Processed sender key distribution message from %s in %s", senderKeyName.Sender().String(), senderKeyName.GroupID())
 }
 
@@ -253,9 +289,14 @@ func (cli *Client) handleHistorySyncNotification(notif *waProto.HistorySyncNotif
 	} else if err = proto.Unmarshal(rawData, &historySync); err != nil {
 		cli.Log.Errorf("Failed to unmarshal history sync data: %v", err)
 	} else {
-		cli.Log.Debugf("Received history sync")
+		onDemand := notif.GetSyncType() == waProto.HistorySyncNotification_ON_DEMAND
+		cli.Log.Debugf("Received history sync (on demand: %t)", onDemand)
+		if onDemand {
+			cli.handleOnDemandHistorySync(notif.GetOriginalMessageId(), &historySync)
+		}
 		cli.dispatchEvent(&events.HistorySync{
-			Data: &historySync,
+			Data:     &historySync,
+			OnDemand: onDemand,
 		})
 	}
 }
@@ -267,10 +308,15 @@ func (cli *Client) handleAppStateSyncKeyShare(keys *waProto.AppStateSyncKeyShare
 			cli.Log.Errorf("Failed to marshal fingerprint of app state sync key %X", key.GetKeyId().GetKeyId())
 			continue
 		}
+		timestamp := key.GetKeyData().GetTimestamp()
+		if timestamp == 0 {
+			// Keys are selected by timestamp, so keys without one are treated as received now.
+			timestamp = cli.now().UnixMilli()
+		}
 		err = cli.Store.AppStateKeys.PutAppStateSyncKey(key.GetKeyId().GetKeyId(), store.AppStateSyncKey{
 			Data:        key.GetKeyData().GetKeyData(),
 			Fingerprint: marshaledFingerprint,
-			Timestamp:   key.GetKeyData().GetTimestamp(),
+			Timestamp:   timestamp,
 		})
 		if err != nil {
 			cli.Log.Errorf("Failed to store app state sync key %X", key.GetKeyId().GetKeyId())
@@ -279,10 +325,15 @@ func (cli *Client) handleAppStateSyncKeyShare(keys *waProto.AppStateSyncKeyShare
 		cli.Log.Debugf("Received app state sync key %X", key.GetKeyId().GetKeyId())
 	}
 
+	stalled := cli.popStalledAppStates()
 	for _, name := range appstate.AllPatchNames {
-		err := cli.FetchAppState(name, false, true)
+		_, wasStalled := stalled[name]
+		// App states that failed because of missing keys are re-fetched even if they were partially synced.
+		err := cli.FetchAppState(name, false, !wasStalled)
 		if err != nil {
 			cli.Log.Errorf("Failed to do initial fetch of app state %s: %v", name, err)
+		} else if wasStalled {
+			cli.dispatchEvent(&events.AppStateSyncComplete{Name: name})
 		}
 	}
 }
@@ -290,16 +341,52 @@ func (cli *Client) handleAppStateSyncKeyShare(keys *waProto.AppStateSyncKeyShare
 func (cli *Client) handleProtocolMessage(info *types.MessageInfo, msg *waProto.Message) {
 	protoMsg := msg.GetProtocolMessage()
 
-	if protoMsg.GetHistorySyncNotification() != nil && info.IsFromMe {
-		cli.handleHistorySyncNotification(protoMsg.HistorySyncNotification)
-		cli.sendProtocolMessageReceipt(info.ID, "hist_sync")
-	}
-
-	if protoMsg.GetAppStateSyncKeyShare() != nil && info.IsFromMe {
-		cli.handleAppStateSyncKeyShare(protoMsg.AppStateSyncKeyShare)
+	switch protoMsg.GetType() {
+	case waProto.ProtocolMessage_HISTORY_SYNC_NOTIFICATION:
+		if protoMsg.GetHistorySyncNotification() != nil && info.IsFromMe {
+			cli.handleHistorySyncNotification(protoMsg.HistorySyncNotification)
+			cli.sendProtocolMessageReceipt(info.ID, "hist_sync")
+		}
+	case waProto.ProtocolMessage_APP_STATE_SYNC_KEY_SHARE:
+		if protoMsg.GetAppStateSyncKeyShare() != nil && info.IsFromMe {
+			cli.handleAppStateSyncKeyShare(protoMsg.AppStateSyncKeyShare)
+		}
+	case waProto.ProtocolMessage_APP_STATE_SYNC_KEY_REQUEST:
+		if protoMsg.GetAppStateSyncKeyRequest() != nil {
+			cli.handleAppStateSyncKeyRequest(info, protoMsg.AppStateSyncKeyRequest)
+		}
+	case waProto.ProtocolMessage_REVOKE:
+		if protoMsg.GetKey() == nil {
+			cli.Log.Debugf("Got revoke protocol message %s without a message key", info.ID)
+			cli.dispatchEvent(&events.ProtocolMessage{Info: *info, Message: protoMsg})
+			break
+		}
+		cli.dispatchEvent(&events.Revoke{
+			Info:      *info,
+			MessageID: protoMsg.GetKey().GetId(),
+			Key:       protoMsg.GetKey(),
+		})
+	case waProto.ProtocolMessage_EPHEMERAL_SETTING:
+		evt := &events.EphemeralSetting{
+			Info:       *info,
+			Expiration: time.Duration(protoMsg.GetEphemeralExpiration()) * time.Second,
+			Timestamp:  info.Timestamp,
+		}
+		if protoMsg.EphemeralSettingTimestamp != nil {
+			evt.Timestamp = time.Unix(protoMsg.GetEphemeralSettingTimestamp(), 0)
+		}
+		cli.dispatchEvent(evt)
+	default:
+		if _, known := waProto.ProtocolMessage_ProtocolMessageType_name[int32(protoMsg.GetType())]; !known {
+			cli.Log.Debugf("Got protocol message %s with unknown type %d", info.ID, protoMsg.GetType())
+		}
+		cli.dispatchEvent(&events.ProtocolMessage{Info: *info, Message: protoMsg})
 	}
 
 	if info.Category == "peer" {
+		if info.Sender.Device == 0 {
+			cli.setPrimaryDeviceReachable(true)
+		}
 		cli.sendProtocolMessageReceipt(info.ID, "peer_msg")
 	}
 }
@@ -307,17 +394,11 @@ func (cli *Client) handleProtocolMessage(info *types.MessageInfo, msg *waProto.M
 func (cli *Client) handleDecryptedMessage(info *types.MessageInfo, msg *waProto.Message) {
 	fmt.Printf("Raw message: %+v -- info: %+v\n", msg, info)
 
-	evt := &events.Message{Info: *info, RawMessage: msg}
+	evt := parseMessageEvent(info, msg)
 
-	// First unwrap device sent messages
-	if msg.GetDeviceSentMessage().GetMessage() != nil {
-		msg = msg.GetDeviceSentMessage().GetMessage()
-		evt.Info.DeviceSentMeta = &types.DeviceSentMeta{
-			DestinationJID: msg.GetDeviceSentMessage().GetDestinationJid(),
-			Phash:          msg.GetDeviceSentMessage().GetPhash(),
-		}
+	if dsm := msg.GetDeviceSentMessage(); dsm.GetMessage() != nil {
+		msg = dsm.GetMessage()
 	}
-
 	if msg.GetSenderKeyDistributionMessage() != nil {
 		if !info.IsGroup {
 			cli.Log.Warnf("Got sender key distribution message in non-group chat from", info.Sender)
@@ -326,22 +407,63 @@ func (cli *Client) handleDecryptedMessage(info *types.MessageInfo, msg *waProto.
 		}
 	}
 	if msg.GetProtocolMessage() != nil {
-		go cli.handleProtocolMessage(info, msg)
+		protocolInfo := evt.Info
+		go cli.handleProtocolMessage(&protocolInfo, msg)
+	}
+	if reaction := evt.Message.GetReactionMessage(); reaction != nil {
+		cli.storeReaction(info.Chat, info.Sender, info.Timestamp, reaction)
+	}
+	if evt.Message.GetPollCreationMessage() != nil || evt.Message.GetPollUpdateMessage() != nil {
+		cli.storePollMessage(info.Chat, info.Sender, info.ID, info.Timestamp, evt.Message, evt.RawMessage)
+	}
+
+	cli.dispatchEvent(evt)
+}
+
+// parseMessageEvent unwraps the given message and fills the metadata fields of the Message event.
+// It doesn't have any side effects, so it's used for both incoming messages and messages from history syncs.
+func parseMessageEvent(info *types.MessageInfo, msg *waProto.Message) *events.Message {
+	evt := &events.Message{Info: *info, RawMessage: msg}
+
+	// First unwrap device sent messages
+	if dsm := msg.GetDeviceSentMessage(); dsm.GetMessage() != nil {
+		msg = dsm.GetMessage()
+		evt.Info.DeviceSentMeta = &types.DeviceSentMeta{
+			DestinationJID: dsm.GetDestinationJid(),
+			Phash:          dsm.GetPhash(),
+		}
+	}
+	if msg.GetProtocolMessage() != nil && msg.GetProtocolMessage().GetType() == waProto.ProtocolMessage_REVOKE {
+		evt.Info.IsRevoke = true
 	}
 
 	// Unwrap ephemeral and view-once messages
 	// Hopefully sender key distribution messages and protocol messages can't be inside ephemeral messages
 	if msg.GetEphemeralMessage().GetMessage() != nil {
 		msg = msg.GetEphemeralMessage().GetMessage()
-		evt.IsEphemeral = true
+		evt.Info.IsEphemeral = true
 	}
 	if msg.GetViewOnceMessage().GetMessage() != nil {
 		msg = msg.GetViewOnceMessage().GetMessage()
-		evt.IsViewOnce = true
+		evt.Info.IsViewOnce = true
 	}
+	evt.IsEphemeral = evt.Info.IsEphemeral
+	evt.IsViewOnce = evt.Info.IsViewOnce
 	evt.Message = msg
-
-	cli.dispatchEvent(evt)
+	evt.MentionedJIDs = parseMentionedJIDs(getContextInfo(msg))
+	if sticker := msg.GetStickerMessage(); sticker != nil {
+		evt.Sticker = &types.StickerInfo{
+			Mimetype:   sticker.GetMimetype(),
+			Width:      sticker.GetWidth(),
+			Height:     sticker.GetHeight(),
+			IsAnimated: sticker.GetIsAnimated(),
+			FileLength: sticker.GetFileLength(),
+		}
+	}
+	evt.CatalogReference = parseCatalogReference(msg)
+	evt.Interactive = ParseInteractiveMessage(msg)
+	evt.InteractiveResponse = ParseInteractiveResponse(msg)
+	return evt
 }
 
 func (cli *Client) sendProtocolMessageReceipt(id, msgType string) {
@@ -0,0 +1,74 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+func TestMessageInfoFlags(t *testing.T) {
+	ownID := types.NewADJID("1111111111", 0, 2)
+	cli := NewClient(&store.Device{ID: &ownID}, waLog.Noop)
+	evts := make(chan interface{}, 10)
+	cli.AddEventHandler(func(evt interface{}) { evts <- evt })
+	sender := types.NewJID("1234567890", types.DefaultUserServer)
+	info, err := cli.parseMessageInfo(&waBinary.Node{Tag: "message", Attrs: waBinary.Attrs{
+		"from":      sender,
+		"id":        "ABCD",
+		"t":         "1700000000",
+		"type":      "text",
+		"notify":    "Alice",
+		"multicast": "true",
+		"edit":      "1",
+	}})
+	if err != nil {
+		t.Fatalf("Failed to parse message info: %v", err)
+	}
+	if info.Type != "text" || info.PushName != "Alice" || !info.Multicast || !info.IsEdit || info.IsRevoke ||
+		info.Edit != types.EditAttributeMessageEdit || !info.Timestamp.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("Unexpected message info %+v", info)
+	}
+
+	cli.handleDecryptedMessage(info, &waProto.Message{EphemeralMessage: &waProto.FutureProofMessage{
+		Message: &waProto.Message{ViewOnceMessage: &waProto.FutureProofMessage{
+			Message: &waProto.Message{Conversation: proto.String("hi")},
+		}},
+	}})
+	msg := (<-evts).(*events.Message)
+	if !msg.Info.IsEphemeral || !msg.Info.IsViewOnce || !msg.IsEphemeral || !msg.IsViewOnce || !msg.Info.IsEdit ||
+		msg.Message.GetConversation() != "hi" {
+		t.Errorf("Unexpected flags in message event %+v", msg.Info)
+	}
+
+	info.Edit, info.IsEdit = types.EditAttributeEmpty, false
+	cli.handleDecryptedMessage(info, &waProto.Message{ProtocolMessage: &waProto.ProtocolMessage{
+		Type: waProto.ProtocolMessage_REVOKE.Enum(),
+		Key:  &waProto.MessageKey{Id: proto.String("EFGH")},
+	}})
+	for i := 0; i < 2; i++ {
+		switch evt := (<-evts).(type) {
+		case *events.Message:
+			if !evt.Info.IsRevoke || evt.Info.IsEphemeral {
+				t.Errorf("Unexpected flags in revoke message event %+v", evt.Info)
+			}
+		case *events.Revoke:
+			if !evt.Info.IsRevoke || evt.MessageID != "EFGH" {
+				t.Errorf("Unexpected revoke event %+v", evt)
+			}
+		}
+	}
+}
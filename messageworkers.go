@@ -0,0 +1,123 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/types"
+)
+
+const messageWorkerQueueSize = 256
+
+// messageWorkerPool decrypts and handles message nodes in multiple goroutines.
+// Each chat is always assigned to the same worker, so messages in a chat are handled in arrival order.
+type messageWorkerPool struct {
+	queues  []chan *waBinary.Node
+	pending sync.WaitGroup
+}
+
+func (cli *Client) startMessageWorkers(ctx context.Context, count int) *messageWorkerPool {
+	pool := &messageWorkerPool{queues: make([]chan *waBinary.Node, count)}
+	for i := range pool.queues {
+		pool.queues[i] = make(chan *waBinary.Node, messageWorkerQueueSize)
+		go cli.messageWorkerLoop(ctx, pool, pool.queues[i])
+	}
+	return pool
+}
+
+func (cli *Client) messageWorkerLoop(ctx context.Context, pool *messageWorkerPool, queue <-chan *waBinary.Node) {
+	for {
+		select {
+		case node := <-queue:
+			cli.handleQueuedNode(node)
+			pool.pending.Done()
+		case <-ctx.Done():
+			for {
+				select {
+				case <-queue:
+					pool.pending.Done()
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// messageChatKey returns the chat that the given message node belongs to without fully parsing the node.
+func messageChatKey(node *waBinary.Node) string {
+	if recipient, ok := node.Attrs["recipient"].(types.JID); ok {
+		return recipient.ToNonAD().String()
+	}
+	from, _ := node.Attrs["from"].(types.JID)
+	return from.ToNonAD().String()
+}
+
+// submit queues the node for the worker of its chat. It blocks if that worker's queue is full.
+func (pool *messageWorkerPool) submit(ctx context.Context, node *waBinary.Node) {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(messageChatKey(node)))
+	pool.pending.Add(1)
+	select {
+	case pool.queues[hash.Sum32()%uint32(len(pool.queues))] <- node:
+	case <-ctx.Done():
+		pool.pending.Done()
+	}
+}
+
+// wait blocks until all submitted messages have been handled or the context is canceled.
+func (pool *messageWorkerPool) wait(ctx context.Context) {
+	done := make(chan struct{})
+	go func() {
+		pool.pending.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+}
+
+type refCountedMutex struct {
+	sync.Mutex
+	refs int
+}
+
+// keyedMutex is a set of mutexes identified by string keys. Mutexes are removed when nobody is holding them.
+type keyedMutex struct {
+	locks map[string]*refCountedMutex
+	lock  sync.Mutex
+}
+
+func (km *keyedMutex) Lock(key string) (unlock func()) {
+	km.lock.Lock()
+	if km.locks == nil {
+		km.locks = make(map[string]*refCountedMutex)
+	}
+	mutex, ok := km.locks[key]
+	if !ok {
+		mutex = &refCountedMutex{}
+		km.locks[key] = mutex
+	}
+	mutex.refs++
+	km.lock.Unlock()
+
+	mutex.Lock()
+	return func() {
+		mutex.Unlock()
+		km.lock.Lock()
+		mutex.refs--
+		if mutex.refs == 0 {
+			delete(km.locks, key)
+		}
+		km.lock.Unlock()
+	}
+}
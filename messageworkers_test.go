@@ -0,0 +1,51 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/types"
+)
+
+func TestMessageWorkersKeepChatOrder(t *testing.T) {
+	var handled = make(map[string][]string)
+	var lock sync.Mutex
+	cli := &Client{nodeHandlers: map[string]nodeHandler{
+		"message": func(node *waBinary.Node) {
+			lock.Lock()
+			defer lock.Unlock()
+			chat := messageChatKey(node)
+			handled[chat] = append(handled[chat], node.Attrs["id"].(string))
+		},
+	}}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pool := cli.startMessageWorkers(ctx, 4)
+	chats := []types.JID{types.NewJID("1", types.DefaultUserServer), types.NewJID("2", types.DefaultUserServer), types.NewJID("3", types.GroupServer)}
+	for i := 0; i < 100; i++ {
+		for _, chat := range chats {
+			pool.submit(ctx, &waBinary.Node{Tag: "message", Attrs: waBinary.Attrs{"from": chat, "id": strconv.Itoa(i)}})
+		}
+	}
+	pool.wait(ctx)
+	for _, chat := range chats {
+		ids := handled[chat.String()]
+		if len(ids) != 100 {
+			t.Fatalf("Expected 100 messages in %s, got %d", chat, len(ids))
+		}
+		for i, id := range ids {
+			if id != strconv.Itoa(i) {
+				t.Fatalf("Message %d in %s was handled out of order (got %s)", i, chat, id)
+			}
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"sync/atomic"
+)
+
+// Metric names passed to MetricsHook.
+const (
+	MetricMessagesSent         = "messages_sent"
+	MetricMessagesReceived     = "messages_received"
+	MetricDecryptionFailures   = "decryption_failures"
+	MetricRetryReceiptsSent    = "retry_receipts_sent"
+	MetricRetryReceiptsRecv    = "retry_receipts_received"
+	MetricReconnects           = "reconnects"
+	MetricKeepAliveMisses      = "keepalive_misses"
+	MetricMediaBytesUploaded   = "media_bytes_uploaded"
+	MetricMediaBytesDownloaded = "media_bytes_downloaded"
+	MetricDeviceCacheHits      = "device_cache_hits"
+	MetricDeviceCacheMisses    = "device_cache_misses"
+	MetricPreKeyCount          = "prekey_count"
+	MetricDeviceCacheSize      = "device_cache_size"
+	MetricGroupCacheSize       = "group_cache_size"
+)
+
+// MetricsHook can be set as Client.MetricsHook to receive metric updates as they happen,
+// e.g. to feed them into Prometheus without polling Client.Stats.
+//
+// The methods are called synchronously from the code path being measured, so they should not block.
+type MetricsHook interface {
+	// IncrCounter is called when the counter with the given name is incremented.
+	IncrCounter(name string, delta uint64)
+	// ObserveGauge is called when the gauge with the given name gets a new value.
+	ObserveGauge(name string, value int64)
+}
+
+// ClientStats is a snapshot of the counters of a Client returned by Client.Stats.
+//
+// The counters are kept for the whole lifetime of the client (including reconnects) until Client.ResetStats is called.
+type ClientStats struct {
+	MessagesSent         uint64
+	MessagesReceived     uint64
+	DecryptionFailures   uint64
+	RetryReceiptsSent    uint64
+	RetryReceiptsRecv    uint64
+	Reconnects           uint64
+	KeepAliveMisses      uint64
+	MediaBytesUploaded   uint64
+	MediaBytesDownloaded uint64
+	DeviceCacheHits      uint64 // The number of recipients whose device list was found in the cache when sending.
+	DeviceCacheMisses    uint64 // The number of recipients whose device list had to be fetched when sending.
+
+	// PreKeyCount is the number of prekeys on the server as of the last check or upload.
+	PreKeyCount int64
+	// DeviceCacheSize is the number of users whose device list is currently cached.
+	DeviceCacheSize int64
+	// GroupCacheSize is the number of groups whose info is currently cached.
+	GroupCacheSize int64
+	// ContactCacheSize is the number of contacts cached in the device store, if the store reports it.
+	ContactCacheSize int64
+}
+
+// DeviceCacheHitRate returns the fraction of device list lookups that were served from the cache,
+// or zero if there haven't been any lookups.
+func (stats ClientStats) DeviceCacheHitRate() float64 {
+	total := stats.DeviceCacheHits + stats.DeviceCacheMisses
+	if total == 0 {
+		return 0
+	}
+	return float64(stats.DeviceCacheHits) / float64(total)
+}
+
+type clientMetrics struct {
+	messagesSent         atomic.Uint64
+	messagesReceived     atomic.Uint64
+	decryptionFailures   atomic.Uint64
+	retryReceiptsSent    atomic.Uint64
+	retryReceiptsRecv    atomic.Uint64
+	reconnects           atomic.Uint64
+	keepAliveMisses      atomic.Uint64
+	mediaBytesUploaded   atomic.Uint64
+	mediaBytesDownloaded atomic.Uint64
+	deviceCacheHits      atomic.Uint64
+	deviceCacheMisses    atomic.Uint64
+	preKeyCount          atomic.Int64
+	deviceCacheSize      atomic.Int64
+	groupCacheSize       atomic.Int64
+}
+
+// Stats returns a snapshot of the client's counters.
+func (cli *Client) Stats() ClientStats {
+	m := &cli.metrics
+	return ClientStats{
+		MessagesSent:         m.messagesSent.Load(),
+		MessagesReceived:     m.messagesReceived.Load(),
+		DecryptionFailures:   m.decryptionFailures.Load(),
+		RetryReceiptsSent:    m.retryReceiptsSent.Load(),
+		RetryReceiptsRecv:    m.retryReceiptsRecv.Load(),
+		Reconnects:           m.reconnects.Load(),
+		KeepAliveMisses:      m.keepAliveMisses.Load(),
+		MediaBytesUploaded:   m.mediaBytesUploaded.Load(),
+		MediaBytesDownloaded: m.mediaBytesDownloaded.Load(),
+		DeviceCacheHits:      m.deviceCacheHits.Load(),
+		DeviceCacheMisses:    m.deviceCacheMisses.Load(),
+		PreKeyCount:          m.preKeyCount.Load(),
+		DeviceCacheSize:      m.deviceCacheSize.Load(),
+		GroupCacheSize:       m.groupCacheSize.Load(),
+		ContactCacheSize:     int64(cli.contactCacheSize()),
+	}
+}
+
+// ResetStats sets all counters returned by Stats back to zero. The prekey count and cache size gauges are not reset.
+func (cli *Client) ResetStats() {
+	m := &cli.metrics
+	m.messagesSent.Store(0)
+	m.messagesReceived.Store(0)
+	m.decryptionFailures.Store(0)
+	m.retryReceiptsSent.Store(0)
+	m.retryReceiptsRecv.Store(0)
+	m.reconnects.Store(0)
+	m.keepAliveMisses.Store(0)
+	m.mediaBytesUploaded.Store(0)
+	m.mediaBytesDownloaded.Store(0)
+	m.deviceCacheHits.Store(0)
+	m.deviceCacheMisses.Store(0)
+}
+
+func (cli *Client) incrCounter(counter *atomic.Uint64, name string, delta uint64) {
+	counter.Add(delta)
+	if cli.MetricsHook != nil {
+		cli.MetricsHook.IncrCounter(name, delta)
+	}
+}
+
+func (cli *Client) setPreKeyCount(count int) {
+	cli.metrics.preKeyCount.Store(int64(count))
+	if cli.MetricsHook != nil {
+		cli.MetricsHook.ObserveGauge(MetricPreKeyCount, int64(count))
+	}
+}
+
+func (cli *Client) setDeviceCacheSize(size int) {
+	cli.metrics.deviceCacheSize.Store(int64(size))
+	if cli.MetricsHook != nil {
+		cli.MetricsHook.ObserveGauge(MetricDeviceCacheSize, int64(size))
+	}
+}
+
+func (cli *Client) setGroupCacheSize(size int) {
+	cli.metrics.groupCacheSize.Store(int64(size))
+	if cli.MetricsHook != nil {
+		cli.MetricsHook.ObserveGauge(MetricGroupCacheSize, int64(size))
+	}
+}
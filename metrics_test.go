@@ -0,0 +1,50 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+)
+
+type testMetricsHook struct {
+	counters map[string]uint64
+	gauges   map[string]int64
+}
+
+func (h *testMetricsHook) IncrCounter(name string, delta uint64) {
+	h.counters[name] += delta
+}
+
+func (h *testMetricsHook) ObserveGauge(name string, value int64) {
+	h.gauges[name] = value
+}
+
+func TestStats(t *testing.T) {
+	hook := &testMetricsHook{counters: make(map[string]uint64), gauges: make(map[string]int64)}
+	cli := &Client{MetricsHook: hook}
+	cli.incrCounter(&cli.metrics.messagesSent, MetricMessagesSent, 1)
+	cli.incrCounter(&cli.metrics.messagesSent, MetricMessagesSent, 1)
+	cli.incrCounter(&cli.metrics.mediaBytesUploaded, MetricMediaBytesUploaded, 1234)
+	cli.setPreKeyCount(50)
+
+	stats := cli.Stats()
+	if stats.MessagesSent != 2 || stats.MediaBytesUploaded != 1234 || stats.PreKeyCount != 50 {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+	if hook.counters[MetricMessagesSent] != 2 || hook.counters[MetricMediaBytesUploaded] != 1234 || hook.gauges[MetricPreKeyCount] != 50 {
+		t.Errorf("Unexpected hook values %+v %+v", hook.counters, hook.gauges)
+	}
+
+	cli.ResetStats()
+	stats = cli.Stats()
+	if stats.MessagesSent != 0 || stats.MediaBytesUploaded != 0 {
+		t.Errorf("Expected counters to be reset, got %+v", stats)
+	}
+	if stats.PreKeyCount != 50 {
+		t.Errorf("Expected prekey count to survive reset, got %d", stats.PreKeyCount)
+	}
+}
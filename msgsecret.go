@@ -0,0 +1,103 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"fmt"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/util/hkdfutil"
+	"go.mau.fi/whatsmeow/util/random"
+)
+
+// msgSecretType is the use case that a key derived from a message secret is used for.
+type msgSecretType string
+
+const msgSecretPollVote msgSecretType = "Poll Vote"
+
+// storeMessageSecret saves the message secret of the given message if it has one, so that later messages
+// referring to it (e.g. poll votes) can be encrypted and decrypted.
+func (cli *Client) storeMessageSecret(chat, sender types.JID, id types.MessageID, msgs ...*waProto.Message) {
+	if cli.Store.MsgSecrets == nil {
+		return
+	}
+	for _, msg := range msgs {
+		secret := msg.GetMessageContextInfo().GetMessageSecret()
+		if len(secret) == 0 {
+			continue
+		}
+		err := cli.Store.MsgSecrets.PutMessageSecret(chat.ToNonAD(), sender.ToNonAD(), id, secret)
+		if err != nil {
+			cli.Log.Errorf("Failed to store message secret of %s from %s in %s: %v", id, sender, chat, err)
+		}
+		return
+	}
+}
+
+func (cli *Client) getMessageSecret(chat, sender types.JID, id types.MessageID) ([]byte, error) {
+	if cli.Store.MsgSecrets == nil {
+		return nil, ErrNoMsgSecretStore
+	}
+	secret, err := cli.Store.MsgSecrets.GetMessageSecret(chat.ToNonAD(), sender.ToNonAD(), id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message secret of %s: %w", id, err)
+	} else if secret == nil {
+		return nil, ErrOriginalMessageSecretNotFound
+	}
+	return secret, nil
+}
+
+// generateMsgSecretKey derives the key and additional data for encrypting a modification (e.g. a poll vote)
+// of the original message by the given user.
+func generateMsgSecretKey(modificationType msgSecretType, modificationSender types.JID, origMsgID types.MessageID, origMsgSender types.JID, origMsgSecret []byte) ([]byte, []byte) {
+	origMsgSenderStr := origMsgSender.ToNonAD().String()
+	modificationSenderStr := modificationSender.ToNonAD().String()
+
+	useCaseSecret := make([]byte, 0, len(origMsgID)+len(origMsgSenderStr)+len(modificationSenderStr)+len(modificationType))
+	useCaseSecret = append(useCaseSecret, origMsgID...)
+	useCaseSecret = append(useCaseSecret, origMsgSenderStr...)
+	useCaseSecret = append(useCaseSecret, modificationSenderStr...)
+	useCaseSecret = append(useCaseSecret, modificationType...)
+
+	secretKey := hkdfutil.SHA256(origMsgSecret, nil, useCaseSecret, 32)
+	additionalData := []byte(fmt.Sprintf("%s\x00%s", origMsgID, modificationSenderStr))
+	return secretKey, additionalData
+}
+
+func newMsgSecretGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AES cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+func encryptMsgSecret(key, additionalData, plaintext []byte) (ciphertext, iv []byte, err error) {
+	gcm, err := newMsgSecretGCM(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	iv = random.Bytes(gcm.NonceSize())
+	return gcm.Seal(nil, iv, plaintext, additionalData), iv, nil
+}
+
+func decryptMsgSecret(key, additionalData, ciphertext, iv []byte) ([]byte, error) {
+	gcm, err := newMsgSecretGCM(key)
+	if err != nil {
+		return nil, err
+	} else if len(iv) != gcm.NonceSize() {
+		return nil, fmt.Errorf("invalid IV length %d", len(iv))
+	}
+	plaintext, err := gcm.Open(nil, iv, ciphertext, additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt secret message: %w", err)
+	}
+	return plaintext, nil
+}
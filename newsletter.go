@@ -0,0 +1,457 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// NewsletterLinkPrefix is the prefix of all newsletter (channel) invite links.
+const NewsletterLinkPrefix = "https://whatsapp.com/channel/"
+
+// GraphQL query IDs used for the newsletter queries sent through the w:mex namespace.
+const (
+	queryFetchNewsletter       = "6563316087068696"
+	queryFollowNewsletter      = "7871414976211147"
+	queryUnfollowNewsletter    = "7238632346214362"
+	querySubscribedNewsletters = "6388546374527196"
+)
+
+type mexError struct {
+	Message    string `json:"message"`
+	Extensions struct {
+		ErrorCode int `json:"error_code"`
+	} `json:"extensions"`
+}
+
+type mexResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []mexError      `json:"errors"`
+}
+
+// sendMexIQ sends a GraphQL query through the w:mex namespace and returns the data field of the response.
+func (cli *Client) sendMexIQ(queryID string, variables interface{}) (json.RawMessage, error) {
+	payload, err := json.Marshal(map[string]interface{}{"variables": variables})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal query variables: %w", err)
+	}
+	resp, err := cli.sendIQ(infoQuery{
+		Namespace: "w:mex",
+		Type:      "get",
+		To:        types.ServerJID,
+		Content: []waBinary.Node{{
+			Tag:     "query",
+			Attrs:   waBinary.Attrs{"query_id": queryID},
+			Content: payload,
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	result, ok := resp.GetOptionalChildByTag("result")
+	if !ok {
+		return nil, fmt.Errorf("missing <result> element in response to query")
+	}
+	resultBytes, ok := result.Content.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected content type %T in query result", result.Content)
+	}
+	var parsed mexResponse
+	err = json.Unmarshal(resultBytes, &parsed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse query result: %w", err)
+	}
+	if len(parsed.Errors) > 0 {
+		if parsed.Errors[0].Extensions.ErrorCode == 404 {
+			return nil, fmt.Errorf("%w: %s", ErrNewsletterNotFound, parsed.Errors[0].Message)
+		}
+		return nil, fmt.Errorf("query returned error: %s (code %d)", parsed.Errors[0].Message, parsed.Errors[0].Extensions.ErrorCode)
+	}
+	return parsed.Data, nil
+}
+
+type respNewsletterPicture struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	DirectPath string `json:"direct_path"`
+}
+
+func (pic *respNewsletterPicture) convert() *types.NewsletterPicture {
+	if pic == nil || len(pic.ID) == 0 {
+		return nil
+	}
+	return &types.NewsletterPicture{ID: pic.ID, Type: pic.Type, DirectPath: pic.DirectPath}
+}
+
+type respNewsletterMetadata struct {
+	ID    string `json:"id"`
+	State struct {
+		Type string `json:"type"`
+	} `json:"state"`
+	ThreadMeta struct {
+		CreationTime string `json:"creation_time"`
+		Invite       string `json:"invite"`
+		Name         struct {
+			Text string `json:"text"`
+		} `json:"name"`
+		Description struct {
+			Text string `json:"text"`
+		} `json:"description"`
+		SubscribersCount string                 `json:"subscribers_count"`
+		Verification     string                 `json:"verification"`
+		Picture          *respNewsletterPicture `json:"picture"`
+		Preview          *respNewsletterPicture `json:"preview"`
+	} `json:"thread_metadata"`
+	ViewerMeta *struct {
+		Mute string               `json:"mute"`
+		Role types.NewsletterRole `json:"role"`
+	} `json:"viewer_metadata"`
+}
+
+func (meta *respNewsletterMetadata) convert() *types.NewsletterMetadata {
+	creationTime, _ := strconv.ParseInt(meta.ThreadMeta.CreationTime, 10, 64)
+	subscriberCount, _ := strconv.Atoi(meta.ThreadMeta.SubscribersCount)
+	jid, _ := types.ParseJID(meta.ID)
+	info := &types.NewsletterMetadata{
+		ID:              jid,
+		State:           meta.State.Type,
+		Name:            meta.ThreadMeta.Name.Text,
+		Description:     meta.ThreadMeta.Description.Text,
+		InviteCode:      meta.ThreadMeta.Invite,
+		SubscriberCount: subscriberCount,
+		IsVerified:      meta.ThreadMeta.Verification == "verified",
+		CreationTime:    time.Unix(creationTime, 0),
+		Picture:         meta.ThreadMeta.Picture.convert(),
+		Preview:         meta.ThreadMeta.Preview.convert(),
+	}
+	if meta.ViewerMeta != nil {
+		info.ViewerRole = meta.ViewerMeta.Role
+		info.ViewerMutedNotifs = meta.ViewerMeta.Mute == "on"
+	}
+	return info
+}
+
+func (cli *Client) getNewsletterInfo(key, keyType string) (*types.NewsletterMetadata, error) {
+	data, err := cli.sendMexIQ(queryFetchNewsletter, map[string]interface{}{
+		"fetch_creation_time":   true,
+		"fetch_full_image":      true,
+		"fetch_viewer_metadata": true,
+		"input": map[string]string{
+			"key":  key,
+			"type": keyType,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get newsletter info: %w", err)
+	}
+	var resp struct {
+		Newsletter *respNewsletterMetadata `json:"xwa2_newsletter"`
+	}
+	err = json.Unmarshal(data, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse newsletter info: %w", err)
+	} else if resp.Newsletter == nil {
+		return nil, ErrNewsletterNotFound
+	}
+	return resp.Newsletter.convert(), nil
+}
+
+// GetNewsletterInfo gets the info of a newsletter (channel) that you already know the JID of.
+func (cli *Client) GetNewsletterInfo(jid types.JID) (*types.NewsletterMetadata, error) {
+	return cli.getNewsletterInfo(jid.String(), "JID")
+}
+
+// GetNewsletterInfoWithInvite gets the info of a newsletter (channel) using an invite link.
+//
+// The link can be either the full https://whatsapp.com/channel/... link or just the code after the last slash.
+func (cli *Client) GetNewsletterInfoWithInvite(key string) (*types.NewsletterMetadata, error) {
+	return cli.getNewsletterInfo(strings.TrimPrefix(key, NewsletterLinkPrefix), "INVITE")
+}
+
+// GetSubscribedNewsletters gets the info of all newsletters (channels) that the current user is following.
+func (cli *Client) GetSubscribedNewsletters() ([]*types.NewsletterMetadata, error) {
+	data, err := cli.sendMexIQ(querySubscribedNewsletters, map[string]interface{}{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscribed newsletters: %w", err)
+	}
+	var resp struct {
+		Newsletters []*respNewsletterMetadata `json:"xwa2_newsletter_subscribed"`
+	}
+	err = json.Unmarshal(data, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse subscribed newsletters: %w", err)
+	}
+	output := make([]*types.NewsletterMetadata, len(resp.Newsletters))
+	for i, meta := range resp.Newsletters {
+		output[i] = meta.convert()
+	}
+	return output, nil
+}
+
+// FollowNewsletter makes the current user follow (subscribe to) the given newsletter (channel).
+func (cli *Client) FollowNewsletter(jid types.JID) error {
+	_, err := cli.sendMexIQ(queryFollowNewsletter, map[string]string{"newsletter_id": jid.String()})
+	if err != nil {
+		return fmt.Errorf("failed to follow newsletter: %w", err)
+	}
+	return nil
+}
+
+// UnfollowNewsletter makes the current user unfollow (unsubscribe from) the given newsletter (channel).
+func (cli *Client) UnfollowNewsletter(jid types.JID) error {
+	_, err := cli.sendMexIQ(queryUnfollowNewsletter, map[string]string{"newsletter_id": jid.String()})
+	if err != nil {
+		return fmt.Errorf("failed to unfollow newsletter: %w", err)
+	}
+	return nil
+}
+
+// GetNewsletterMessagesParams contains the optional parameters for GetNewsletterMessages.
+type GetNewsletterMessagesParams struct {
+	// The maximum number of messages to return. Defaults to 50.
+	Count int
+	// Only get messages before this server ID. Use the lowest server ID of the previous page to paginate backwards.
+	Before types.MessageServerID
+}
+
+// GetNewsletterMessages gets messages in a newsletter (channel), starting from the newest.
+func (cli *Client) GetNewsletterMessages(jid types.JID, params GetNewsletterMessagesParams) ([]*types.NewsletterMessage, error) {
+	if params.Count <= 0 {
+		params.Count = 50
+	}
+	attrs := waBinary.Attrs{
+		"type":  "jid",
+		"jid":   jid,
+		"count": strconv.Itoa(params.Count),
+	}
+	if params.Before != 0 {
+		attrs["before"] = strconv.Itoa(params.Before)
+	}
+	resp, err := cli.sendIQ(infoQuery{
+		Namespace: "newsletter",
+		Type:      "get",
+		To:        types.ServerJID,
+		Content: []waBinary.Node{{
+			Tag:   "messages",
+			Attrs: attrs,
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get newsletter messages: %w", err)
+	}
+	messagesNode, ok := resp.GetOptionalChildByTag("messages")
+	if !ok {
+		return nil, fmt.Errorf("missing <messages> element in response to newsletter message query")
+	}
+	return cli.parseNewsletterMessages(&messagesNode), nil
+}
+
+func (cli *Client) parseNewsletterMessages(node *waBinary.Node) []*types.NewsletterMessage {
+	children := node.GetChildren()
+	output := make([]*types.NewsletterMessage, 0, len(children))
+	for _, child := range children {
+		if child.Tag != "message" {
+			continue
+		}
+		msg, err := parseNewsletterMessage(&child)
+		if err != nil {
+			cli.Log.Warnf("Failed to parse newsletter message: %v", err)
+			continue
+		}
+		output = append(output, msg)
+	}
+	return output
+}
+
+func parseNewsletterReactions(node waBinary.Node) map[string]int {
+	reactions := make(map[string]int)
+	for _, reaction := range node.GetChildren() {
+		rag := reaction.AttrGetter()
+		reactions[rag.OptionalString("code")] = rag.OptionalInt("count")
+	}
+	return reactions
+}
+
+func parseNewsletterMessage(node *waBinary.Node) (*types.NewsletterMessage, error) {
+	ag := node.AttrGetter()
+	msg := types.NewsletterMessage{
+		ServerID:  ag.Int("server_id"),
+		MessageID: ag.OptionalString("id"),
+		Type:      ag.OptionalString("type"),
+		Timestamp: time.Unix(ag.OptionalInt64("t"), 0),
+	}
+	if !ag.OK() {
+		return nil, ag.Error()
+	}
+	for _, child := range node.GetChildren() {
+		switch child.Tag {
+		case "plaintext":
+			plaintext, ok := child.Content.([]byte)
+			if !ok {
+				continue
+			}
+			var parsed waProto.Message
+			err := proto.Unmarshal(plaintext, &parsed)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal message %d: %w", msg.ServerID, err)
+			}
+			msg.Message = &parsed
+		case "views_count":
+			msg.ViewsCount = child.AttrGetter().OptionalInt("count")
+		case "reactions":
+			msg.ReactionCounts = parseNewsletterReactions(child)
+		}
+	}
+	return &msg, nil
+}
+
+func (cli *Client) handleNewsletterMessage(node *waBinary.Node) {
+	defer cli.sendAck(node)
+	msg, err := parseNewsletterMessage(node)
+	if err != nil {
+		cli.Log.Warnf("Failed to parse newsletter message: %v", err)
+		return
+	}
+	cli.dispatchEvent(&events.NewsletterMessage{
+		NewsletterJID:     node.AttrGetter().JID("from"),
+		NewsletterMessage: *msg,
+	})
+}
+
+func (cli *Client) handleNewsletterNotification(node *waBinary.Node) {
+	ag := node.AttrGetter()
+	liveUpdates, ok := node.GetOptionalChildByTag("live_updates", "messages")
+	if !ok {
+		return
+	}
+	cli.dispatchEvent(&events.NewsletterLiveUpdate{
+		JID:      ag.JID("from"),
+		Time:     time.Unix(ag.Int64("t"), 0),
+		Messages: cli.parseNewsletterMessages(&liveUpdates),
+	})
+}
+
+// sendNewsletter sends a message to a newsletter. Newsletter messages aren't end-to-end encrypted,
+// so the protobuf is sent as plaintext. Only admins of the newsletter can send messages.
+func (cli *Client) sendNewsletter(to types.JID, id string, message *waProto.Message) error {
+	plaintext, err := proto.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+	msgType := "text"
+	if message.GetReactionMessage() != nil {
+		msgType = "reaction"
+	} else if message.GetConversation() == "" && message.GetExtendedTextMessage() == nil {
+		msgType = "media"
+	}
+	return cli.sendNode(waBinary.Node{
+		Tag: "message",
+		Attrs: waBinary.Attrs{
+			"to":   to,
+			"id":   id,
+			"type": msgType,
+		},
+		Content: []waBinary.Node{{Tag: "plaintext", Content: plaintext}},
+	})
+}
+
+func serverIDListNode(tag string, serverIDs []types.MessageServerID) waBinary.Node {
+	items := make([]waBinary.Node, len(serverIDs))
+	for i, serverID := range serverIDs {
+		items[i] = waBinary.Node{
+			Tag:   "item",
+			Attrs: waBinary.Attrs{"server_id": strconv.Itoa(serverID)},
+		}
+	}
+	return waBinary.Node{Tag: tag, Content: items}
+}
+
+// NewsletterSendReaction sends a reaction to a newsletter (channel) message.
+// To remove a reaction, send an empty string as the emoji.
+func (cli *Client) NewsletterSendReaction(jid types.JID, serverID types.MessageServerID, emoji string) error {
+	messageAttrs := waBinary.Attrs{
+		"to":        jid,
+		"id":        GenerateMessageID(),
+		"server_id": strconv.Itoa(serverID),
+		"type":      "reaction",
+	}
+	reactionAttrs := waBinary.Attrs{}
+	if len(emoji) > 0 {
+		reactionAttrs["code"] = emoji
+	} else {
+		// Edit type 7 is a revoke by the sender, which removes the reaction
+		messageAttrs["edit"] = "7"
+	}
+	return cli.sendNode(waBinary.Node{
+		Tag:     "message",
+		Attrs:   messageAttrs,
+		Content: []waBinary.Node{{Tag: "reaction", Attrs: reactionAttrs}},
+	})
+}
+
+// NewsletterMarkViewed marks the given newsletter messages as viewed, which increments their view counts.
+func (cli *Client) NewsletterMarkViewed(jid types.JID, serverIDs []types.MessageServerID) error {
+	return cli.sendNode(waBinary.Node{
+		Tag: "receipt",
+		Attrs: waBinary.Attrs{
+			"to":   jid,
+			"type": "view",
+			"id":   GenerateMessageID(),
+		},
+		Content: []waBinary.Node{serverIDListNode("list", serverIDs)},
+	})
+}
+
+// GetNewsletterMessageViews gets the view and reaction counts of the given newsletter messages.
+//
+// View counts are only visible to admins of the newsletter.
+func (cli *Client) GetNewsletterMessageViews(jid types.JID, serverIDs []types.MessageServerID) ([]types.NewsletterMessageStats, error) {
+	resp, err := cli.sendIQ(infoQuery{
+		Namespace: "newsletter",
+		Type:      "get",
+		To:        jid,
+		Content:   []waBinary.Node{serverIDListNode("message_updates", serverIDs)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to request newsletter message stats: %w", err)
+	}
+	updates, ok := resp.GetOptionalChildByTag("message_updates", "messages")
+	if !ok {
+		return nil, fmt.Errorf("newsletter message stats response didn't contain messages element")
+	}
+	children := updates.GetChildren()
+	stats := make([]types.NewsletterMessageStats, 0, len(children))
+	for _, child := range children {
+		if child.Tag != "message" {
+			continue
+		}
+		ag := child.AttrGetter()
+		stat := types.NewsletterMessageStats{
+			ServerID: ag.Int("server_id"),
+		}
+		if !ag.OK() {
+			return nil, fmt.Errorf("failed to parse newsletter message stats: %w", ag.Error())
+		}
+		if viewsNode, ok := child.GetOptionalChildByTag("views_count"); ok {
+			stat.ViewsCount = viewsNode.AttrGetter().OptionalInt("count")
+		}
+		stat.ReactionCounts = parseNewsletterReactions(child.GetChildByTag("reactions"))
+		stats = append(stats, stat)
+	}
+	return stats, nil
+}
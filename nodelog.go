@@ -0,0 +1,72 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"fmt"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+// redactedNodeTags contains the tags of nodes whose content may contain secrets like
+// encrypted message payloads, key material or pairing data.
+var redactedNodeTags = map[string]struct{}{
+	"enc":             {},
+	"plaintext":       {},
+	"registration":    {},
+	"identity":        {},
+	"value":           {},
+	"signature":       {},
+	"device-identity": {},
+	"ref":             {},
+	"adv_secret":      {},
+}
+
+// redactedNodeAttrs contains the names of attributes that may contain secrets like auth tokens.
+var redactedNodeAttrs = map[string]struct{}{
+	"auth":  {},
+	"token": {},
+}
+
+// redactNode returns a copy of the given node with sensitive contents and attributes replaced by placeholders.
+func redactNode(node waBinary.Node) waBinary.Node {
+	if len(node.Attrs) > 0 {
+		attrs := make(waBinary.Attrs, len(node.Attrs))
+		for key, value := range node.Attrs {
+			if _, sensitive := redactedNodeAttrs[key]; sensitive {
+				value = "<redacted>"
+			}
+			attrs[key] = value
+		}
+		node.Attrs = attrs
+	}
+	switch content := node.Content.(type) {
+	case []waBinary.Node:
+		redactedChildren := make([]waBinary.Node, len(content))
+		for i, child := range content {
+			redactedChildren[i] = redactNode(child)
+		}
+		node.Content = redactedChildren
+	case []byte:
+		if _, sensitive := redactedNodeTags[node.Tag]; sensitive {
+			node.Content = fmt.Sprintf("<!-- redacted %d bytes -->", len(content))
+		}
+	}
+	return node
+}
+
+func (cli *Client) logRawNode(log waLog.Logger, node *waBinary.Node) {
+	if !cli.EnableRawNodeLogging {
+		return
+	}
+	if !cli.DisableRawNodeRedaction {
+		redacted := redactNode(*node)
+		node = &redacted
+	}
+	log.Debugf("%s", node.IndentedXMLString())
+}
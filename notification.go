@@ -11,6 +11,7 @@ import (
 
 	"go.mau.fi/whatsmeow/appstate"
 	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/types"
 	"go.mau.fi/whatsmeow/types/events"
 )
 
@@ -46,6 +47,10 @@ func (cli *Client) handleAppStateNotification(node *waBinary.Node) {
 	}
 }
 
+func (cli *Client) isOwnUser(jid types.JID) bool {
+	return cli.Store.ID != nil && jid.User == cli.Store.ID.User
+}
+
 func (cli *Client) handlePictureNotification(node *waBinary.Node) {
 	ts := time.Unix(node.AttrGetter().Int64("t"), 0)
 	for _, child := range node.GetChildren() {
@@ -54,6 +59,7 @@ func (cli *Client) handlePictureNotification(node *waBinary.Node) {
 		evt.Timestamp = ts
 		evt.JID = ag.JID("jid")
 		evt.Author = ag.JID("author")
+		evt.IsFromMe = cli.isOwnUser(evt.Author)
 		if child.Tag == "remove" {
 			evt.Remove = true
 		} else if child.Tag == "add" {
@@ -65,6 +71,23 @@ func (cli *Client) handlePictureNotification(node *waBinary.Node) {
 	}
 }
 
+func (cli *Client) handleStatusNotification(node *waBinary.Node) {
+	ag := node.AttrGetter()
+	child, ok := node.GetOptionalChildByTag("set")
+	if !ok {
+		cli.Log.Debugf("Status notification did not contain 'set' tag")
+		return
+	}
+	status, _ := child.Content.([]byte)
+	evt := &events.UserAbout{
+		JID:       ag.JID("from"),
+		Status:    string(status),
+		Timestamp: time.Unix(ag.Int64("t"), 0),
+	}
+	evt.IsFromMe = cli.isOwnUser(evt.JID)
+	cli.dispatchEvent(evt)
+}
+
 func (cli *Client) handleNotification(node *waBinary.Node) {
 	ag := node.AttrGetter()
 	notifType := ag.String("type")
@@ -79,15 +102,38 @@ func (cli *Client) handleNotification(node *waBinary.Node) {
 	case "server_sync":
 		go cli.handleAppStateNotification(node)
 	case "account_sync":
-		// If we start storing device lists locally, then this should update that store
+		if _, ok := node.GetOptionalChildByTag("devices"); ok && cli.Store.ID != nil {
+			cli.invalidateDeviceLists(*cli.Store.ID)
+		}
+	case "devices":
+		go cli.handleDeviceNotification(node)
 	case "w:gp2":
 		evt, err := parseGroupChange(node)
 		if err != nil {
 			cli.Log.Errorf("Failed to parse group info change: %v", err)
 		} else {
+			evt.IsFromMe = evt.Sender != nil && cli.isOwnUser(*evt.Sender)
+			cli.invalidateGroupInfoCache(evt.JID)
+			for _, participant := range append(evt.Join, evt.Leave...) {
+				cli.invalidateDeviceLists(participant.JID)
+			}
 			go cli.dispatchEvent(evt)
 		}
 	case "picture":
 		go cli.handlePictureNotification(node)
+	case "status":
+		go cli.handleStatusNotification(node)
+	case "blocklist":
+		go cli.handleBlocklist(node)
+	case "newsletter":
+		go cli.handleNewsletterNotification(node)
+	case "disappearing_mode":
+		go cli.handleDisappearingModeNotification(node)
+	case "privacy":
+		if privacyNode, ok := node.GetOptionalChildByTag("privacy"); ok {
+			go cli.handlePrivacySettingsNotification(&privacyNode)
+		}
+	default:
+		cli.handleUnhandledNode(node)
 	}
 }
@@ -0,0 +1,146 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/proto"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/socket"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// DefaultMaxOutboxAttempts is the default value of Client.MaxOutboxAttempts.
+const DefaultMaxOutboxAttempts = 5
+
+func (cli *Client) outboxEnabled() bool {
+	return cli.EnablePersistentOutbox && cli.Store.Outbox != nil
+}
+
+func (cli *Client) addToOutbox(to types.JID, id types.MessageID, message *waProto.Message) error {
+	data, err := proto.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message for outbox: %w", err)
+	}
+	err = cli.Store.Outbox.PutOutgoingMessage(store.OutgoingMessage{
+		ID:        id,
+		To:        to,
+		Message:   data,
+		Timestamp: cli.now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store message in outbox: %w", err)
+	}
+	return nil
+}
+
+func (cli *Client) removeFromOutbox(id types.MessageID) {
+	err := cli.Store.Outbox.DeleteOutgoingMessage(id)
+	if err != nil {
+		cli.Log.Warnf("Failed to remove message %s from outbox: %v", id, err)
+	}
+}
+
+// isNetworkError checks if sending a message failed because of the connection, in which case resending
+// it after reconnecting may succeed. Other errors (e.g. missing permissions) would just happen again.
+func isNetworkError(err error) bool {
+	var netErr net.Error
+	return errors.Is(err, ErrIQTimedOut) ||
+		errors.Is(err, ErrIQDisconnected) ||
+		errors.Is(err, socket.ErrSocketClosed) ||
+		errors.Is(err, socket.ErrWriteTimeout) ||
+		errors.Is(err, websocket.ErrCloseSent) ||
+		errors.As(err, &netErr)
+}
+
+func (cli *Client) handleAck(node *waBinary.Node) {
+	ag := node.AttrGetter()
+	class := ag.OptionalString("class")
+	id := ag.OptionalString("id")
+	if class != "message" || len(id) == 0 {
+		return
+	}
+	cli.checkGroupSendAck(id, ag.OptionalString("phash"))
+	if !cli.outboxEnabled() {
+		return
+	}
+	if errorCode := ag.OptionalString("error"); len(errorCode) > 0 {
+		// Resending a message the server refused won't help, so it's removed from the outbox anyway.
+		cli.Log.Warnf("Server returned error %s for message %s", errorCode, id)
+	}
+	cli.removeFromOutbox(id)
+}
+
+// replayOutbox resends messages that were stored in the outbox before the given time and haven't been acked yet.
+//
+// The messages are resent with their original IDs, so if the server did receive a message and the ack
+// was just lost, recipients will treat the resend as a duplicate instead of a new message.
+//
+// Messages are dropped from the outbox if resending fails with an error that isn't caused by the connection,
+// or if they've already been resent Client.MaxOutboxAttempts times.
+func (cli *Client) replayOutbox(before time.Time) {
+	msgs, err := cli.Store.Outbox.GetOutgoingMessages()
+	if err != nil {
+		cli.Log.Errorf("Failed to get messages from outbox: %v", err)
+		return
+	}
+	for _, msg := range msgs {
+		if !msg.Timestamp.Before(before) {
+			continue
+		}
+		var message waProto.Message
+		err = proto.Unmarshal(msg.Message, &message)
+		if err != nil {
+			cli.Log.Errorf("Failed to unmarshal message %s in outbox, dropping it: %v", msg.ID, err)
+			_ = cli.Store.Outbox.DeleteOutgoingMessage(msg.ID)
+			continue
+		}
+		if cli.MaxOutboxAttempts > 0 && msg.Attempts >= cli.MaxOutboxAttempts {
+			cli.Log.Warnf("Dropping message %s to %s from outbox after %d attempts", msg.ID, msg.To, msg.Attempts)
+			cli.removeFromOutbox(msg.ID)
+			cli.dispatchEvent(&events.MessageRetried{
+				ID:      msg.ID,
+				To:      msg.To,
+				Attempt: msg.Attempts,
+				Error:   ErrOutboxAttemptsExceeded,
+				Dropped: true,
+			})
+			continue
+		}
+		msg.Attempts++
+		err = cli.Store.Outbox.PutOutgoingMessage(msg)
+		if err != nil {
+			cli.Log.Warnf("Failed to update attempt count of message %s in outbox: %v", msg.ID, err)
+		}
+		cli.Log.Infof("Resending unacknowledged message %s to %s (attempt #%d)", msg.ID, msg.To, msg.Attempts)
+		_, err = cli.sendMessage(msg.To, msg.ID, &message)
+		dropped := false
+		if err != nil {
+			cli.Log.Warnf("Failed to resend message %s from outbox: %v", msg.ID, err)
+			if !isNetworkError(err) {
+				cli.removeFromOutbox(msg.ID)
+				dropped = true
+			}
+		}
+		cli.dispatchEvent(&events.MessageRetried{
+			ID:      msg.ID,
+			To:      msg.To,
+			Attempt: msg.Attempts,
+			Error:   err,
+			Dropped: dropped,
+		})
+	}
+}
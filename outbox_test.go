@@ -0,0 +1,101 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+type fakeOutboxStore map[types.MessageID]store.OutgoingMessage
+
+func (fos fakeOutboxStore) PutOutgoingMessage(msg store.OutgoingMessage) error {
+	fos[msg.ID] = msg
+	return nil
+}
+func (fos fakeOutboxStore) DeleteOutgoingMessage(id types.MessageID) error {
+	delete(fos, id)
+	return nil
+}
+func (fos fakeOutboxStore) GetOutgoingMessages() (msgs []store.OutgoingMessage, err error) {
+	for _, msg := range fos {
+		msgs = append(msgs, msg)
+	}
+	return
+}
+
+func newOutboxTestClient() (*Client, fakeOutboxStore, types.JID) {
+	ownID := types.NewADJID("1111111111", 0, 2)
+	outbox := fakeOutboxStore{}
+	cli := NewClient(&store.Device{ID: &ownID, Outbox: outbox}, waLog.Noop)
+	cli.EnablePersistentOutbox = true
+	cli.GroupInfoCacheTTL = time.Hour
+	group := types.NewJID("123456789-987654321", types.GroupServer)
+	cli.cacheGroupInfo(&types.GroupInfo{
+		JID:           group,
+		GroupAnnounce: types.GroupAnnounce{IsAnnounce: true},
+		Participants:  []types.GroupParticipant{{JID: ownID.ToNonAD()}},
+	})
+	return cli, outbox, group
+}
+
+func TestOutboxRemovesFailedSends(t *testing.T) {
+	cli, outbox, group := newOutboxTestClient()
+	_, err := cli.SendMessage(group, "FAILED", &waProto.Message{Conversation: proto.String("hi")})
+	if !errors.Is(err, ErrGroupAnnounceOnly) {
+		t.Fatalf("Expected ErrGroupAnnounceOnly, got %v", err)
+	}
+	if _, ok := outbox["FAILED"]; ok {
+		t.Error("Expected message that failed to send to be removed from the outbox")
+	}
+}
+
+func TestOutboxReplayDropsMessages(t *testing.T) {
+	cli, outbox, group := newOutboxTestClient()
+	var retries []*events.MessageRetried
+	cli.AddEventHandler(func(evt interface{}) {
+		if retry, ok := evt.(*events.MessageRetried); ok {
+			retries = append(retries, retry)
+		}
+	})
+	data, _ := proto.Marshal(&waProto.Message{Conversation: proto.String("hi")})
+	sentAt := time.Now().Add(-time.Minute)
+	outbox["EXHAUSTED"] = store.OutgoingMessage{ID: "EXHAUSTED", To: types.NewJID("2222222222", types.DefaultUserServer), Message: data, Timestamp: sentAt, Attempts: DefaultMaxOutboxAttempts}
+	outbox["REFUSED"] = store.OutgoingMessage{ID: "REFUSED", To: group, Message: data, Timestamp: sentAt}
+
+	cli.replayOutbox(time.Now())
+	if len(outbox) != 0 {
+		t.Errorf("Expected all messages to be dropped from the outbox, %d left", len(outbox))
+	}
+	if len(retries) != 2 {
+		t.Fatalf("Expected 2 MessageRetried events, got %d", len(retries))
+	}
+	for _, retry := range retries {
+		if !retry.Dropped {
+			t.Errorf("Expected message %s to be marked as dropped", retry.ID)
+		}
+		switch retry.ID {
+		case "EXHAUSTED":
+			if !errors.Is(retry.Error, ErrOutboxAttemptsExceeded) || retry.Attempt != DefaultMaxOutboxAttempts {
+				t.Errorf("Unexpected event for message that reached the attempt limit: %+v", retry)
+			}
+		case "REFUSED":
+			if !errors.Is(retry.Error, ErrGroupAnnounceOnly) || retry.Attempt != 1 {
+				t.Errorf("Unexpected event for message that can't be sent: %+v", retry)
+			}
+		}
+	}
+}
@@ -31,6 +31,7 @@ const qrScanTimeout = 30 * time.Second
 func (cli *Client) handleIQ(node *waBinary.Node) {
 	children := node.GetChildren()
 	if len(children) != 1 || node.Attrs["from"] != types.ServerJID {
+		cli.handleUnhandledNode(node)
 		return
 	}
 	switch children[0].Tag {
@@ -38,6 +39,8 @@ func (cli *Client) handleIQ(node *waBinary.Node) {
 		cli.handlePairDevice(node)
 	case "pair-success":
 		cli.handlePairSuccess(node)
+	default:
+		cli.handleUnhandledNode(node)
 	}
 }
 
@@ -76,7 +79,7 @@ func (cli *Client) handlePairDevice(node *waBinary.Node) {
 }
 
 func (cli *Client) makeQRData(ref string) string {
-	noise := base64.StdEncoding.EncodeToString(cli.Store.NoiseKey.Pub[:])
+	noise := base64.StdEncoding.EncodeToString(cli.Store.GetNoiseKey().PublicKey()[:])
 	identity := base64.StdEncoding.EncodeToString(cli.Store.IdentityKey.Pub[:])
 	adv := base64.StdEncoding.EncodeToString(cli.Store.AdvSecretKey)
 	return strings.Join([]string{ref, noise, identity, adv}, ",")
@@ -127,7 +130,11 @@ func (cli *Client) handlePair(deviceIdentityBytes []byte, reqID, businessName, p
 		return fmt.Errorf("invalid device signature in pair success message")
 	}
 
-	deviceIdentity.DeviceSignature = generateDeviceSignature(&deviceIdentity, cli.Store.IdentityKey)[:]
+	deviceSignature, err := generateDeviceSignature(&deviceIdentity, cli.Store.GetIdentitySigner())
+	if err != nil {
+		return fmt.Errorf("failed to generate device signature: %w", err)
+	}
+	deviceIdentity.DeviceSignature = deviceSignature[:]
 
 	var deviceIdentityDetails waProto.ADVDeviceIdentity
 	err = proto.Unmarshal(deviceIdentity.Details, &deviceIdentityDetails)
@@ -213,10 +220,9 @@ func verifyDeviceIdentityAccountSignature(deviceIdentity *waProto.ADVSignedDevic
 	return ecc.VerifySignature(signatureKey, message, signature)
 }
 
-func generateDeviceSignature(deviceIdentity *waProto.ADVSignedDeviceIdentity, ikp *keys.KeyPair) *[64]byte {
-	message := concatBytes([]byte{6, 1}, deviceIdentity.Details, ikp.Pub[:], deviceIdentity.AccountSignatureKey)
-	sig := ecc.CalculateSignature(ecc.NewDjbECPrivateKey(*ikp.Priv), message)
-	return &sig
+func generateDeviceSignature(deviceIdentity *waProto.ADVSignedDeviceIdentity, signer keys.SigningKey) (*[64]byte, error) {
+	message := concatBytes([]byte{6, 1}, deviceIdentity.Details, signer.PublicKey()[:], deviceIdentity.AccountSignatureKey)
+	return signer.SignMessage(message)
 }
 
 func (cli *Client) sendNotAuthorized(id string) waBinary.Node {
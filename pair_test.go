@@ -0,0 +1,342 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"go.mau.fi/libsignal/ecc"
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/socket"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/util/keys"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+// hsmKey is a software implementation of keys.DHKey and keys.SigningKey that never exposes the private key,
+// like a HSM-backed implementation would.
+type hsmKey struct {
+	kp        *keys.KeyPair
+	ref       string
+	dhCalls   int
+	signCalls int
+}
+
+func (hk *hsmKey) PublicKey() *[32]byte { return hk.kp.Pub }
+func (hk *hsmKey) KeyReference() string { return hk.ref }
+
+func (hk *hsmKey) SharedSecret(pub [32]byte) ([]byte, error) {
+	hk.dhCalls++
+	return hk.kp.SharedSecret(pub)
+}
+
+func (hk *hsmKey) SignMessage(message []byte) (*[64]byte, error) {
+	hk.signCalls++
+	return hk.kp.SignMessage(message)
+}
+
+type fakeDeviceContainer struct {
+	saved int
+}
+
+func (fdc *fakeDeviceContainer) PutDevice(*store.Device) error {
+	fdc.saved++
+	return nil
+}
+
+func (fdc *fakeDeviceContainer) DeleteDevice(*store.Device) error {
+	return errors.New("unexpected delete")
+}
+
+type fakeIdentityStore map[string][32]byte
+
+func (fis fakeIdentityStore) PutIdentity(address string, key [32]byte) error {
+	fis[address] = key
+	return nil
+}
+
+func (fis fakeIdentityStore) IsTrustedIdentity(string, [32]byte) (bool, error) {
+	return true, nil
+}
+
+type handshakeResult struct {
+	clientStatic []byte
+	payload      *waProto.ClientPayload
+	frames       int
+	err          error
+}
+
+// fakeNoiseServer implements the server side of the noise handshake and reports the client's static key
+// and client payload of each connection.
+func fakeNoiseServer(t testing.TB) (string, <-chan *handshakeResult) {
+	results := make(chan *handshakeResult, 2)
+	serverStatic := keys.NewKeyPair()
+	upgrader := websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		res := &handshakeResult{}
+		defer func() { results <- res }()
+		readFrame := func(skip int) (*waProto.HandshakeMessage, error) {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return nil, err
+			}
+			var msg waProto.HandshakeMessage
+			return &msg, proto.Unmarshal(data[skip+socket.FrameLengthSize:], &msg)
+		}
+		hello, err := readFrame(len(socket.WAConnHeader))
+		if res.err = err; err != nil {
+			return
+		}
+		clientEphemeral := *(*[32]byte)(hello.GetClientHello().GetEphemeral())
+		nh := socket.NewNoiseHandshake()
+		nh.Start(socket.NoiseStartPattern, socket.WAConnHeader)
+		nh.Authenticate(clientEphemeral[:])
+		serverEphemeral := keys.NewKeyPair()
+		nh.Authenticate(serverEphemeral.Pub[:])
+		_ = nh.MixSharedSecretIntoKey(*serverEphemeral.Priv, clientEphemeral)
+		static := nh.Encrypt(serverStatic.Pub[:])
+		_ = nh.MixSharedSecretIntoKey(*serverStatic.Priv, clientEphemeral)
+		certDetails, _ := proto.Marshal(&waProto.NoiseCertificateDetails{Key: serverStatic.Pub[:]})
+		cert, _ := proto.Marshal(&waProto.NoiseCertificate{Details: certDetails, Signature: make([]byte, 64)})
+		data, _ := proto.Marshal(&waProto.HandshakeMessage{ServerHello: &waProto.ServerHello{
+			Ephemeral: serverEphemeral.Pub[:],
+			Static:    static,
+			Payload:   nh.Encrypt(cert),
+		}})
+		frame := append([]byte{byte(len(data) >> 16), byte(len(data) >> 8), byte(len(data))}, data...)
+		if res.err = conn.WriteMessage(websocket.BinaryMessage, frame); res.err != nil {
+			return
+		}
+
+		finish, err := readFrame(0)
+		if res.err = err; err != nil {
+			return
+		}
+		res.clientStatic, res.err = nh.Decrypt(finish.GetClientFinish().GetStatic())
+		if res.err != nil {
+			return
+		}
+		_ = nh.MixSharedSecretIntoKey(*serverEphemeral.Priv, *(*[32]byte)(res.clientStatic))
+		payload, err := nh.Decrypt(finish.GetClientFinish().GetPayload())
+		if res.err = err; err != nil {
+			return
+		}
+		res.payload = &waProto.ClientPayload{}
+		if res.err = proto.Unmarshal(payload, res.payload); res.err != nil {
+			return
+		}
+		// Count the encrypted frames sent after the handshake until the client disconnects
+		for {
+			if _, _, err = conn.ReadMessage(); err != nil {
+				return
+			}
+			res.frames++
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return "ws" + strings.TrimPrefix(srv.URL, "http"), results
+}
+
+func connectToFakeServer(t testing.TB, cli *Client, url string) *socket.FrameSocket {
+	fs := socket.NewFrameSocket(waLog.Noop, socket.WAConnHeader)
+	fs.URL = url
+	if err := fs.Connect(); err != nil {
+		t.Fatalf("Failed to connect to fake server: %v", err)
+	}
+	if err := cli.doHandshake(fs, *keys.NewKeyPair()); err != nil {
+		fs.Close(0)
+		t.Fatalf("Handshake failed: %v", err)
+	}
+	return fs
+}
+
+func TestPairAndLoginWithExternalKeys(t *testing.T) {
+	url, results := fakeNoiseServer(t)
+	identityKey := keys.NewKeyPair()
+	noiseKey := &hsmKey{kp: keys.NewKeyPair(), ref: "hsm://noise"}
+	identitySigner := &hsmKey{kp: identityKey, ref: "hsm://identity"}
+	container := &fakeDeviceContainer{}
+	identities := fakeIdentityStore{}
+	device := &store.Device{
+		Log:                 waLog.Noop,
+		IdentityKey:         identityKey,
+		ExternalNoiseKey:    noiseKey,
+		ExternalIdentityKey: identitySigner,
+		RegistrationID:      1234,
+		Identities:          identities,
+		Container:           container,
+	}
+	cli := NewClient(device, waLog.Noop)
+
+	fs := connectToFakeServer(t, cli, url)
+	if device.NoiseKey != nil {
+		t.Error("Expected no in-memory noise key to be generated when an external key is set")
+	}
+	if identitySigner.signCalls != 1 || !ecc.VerifySignature(
+		ecc.NewDjbECPublicKey(*identityKey.Pub),
+		append([]byte{ecc.DjbType}, device.SignedPreKey.Pub[:]...),
+		*device.SignedPreKey.Signature,
+	) {
+		t.Error("Expected signed pre-key to be signed with the external identity key")
+	}
+
+	// The primary device signs the companion's identity key, and the companion signs the result back
+	primaryKey := keys.NewKeyPair()
+	details, _ := proto.Marshal(&waProto.ADVDeviceIdentity{KeyIndex: proto.Uint32(1)})
+	accountSignature, _ := primaryKey.SignMessage(concatBytes([]byte{6, 0}, details, identityKey.Pub[:]))
+	signedIdentity, _ := proto.Marshal(&waProto.ADVSignedDeviceIdentity{
+		Details:             details,
+		AccountSignatureKey: primaryKey.Pub[:],
+		AccountSignature:    accountSignature[:],
+	})
+	h := hmac.New(sha256.New, device.AdvSecretKey)
+	h.Write(signedIdentity)
+	identityContainer, _ := proto.Marshal(&waProto.ADVSignedDeviceIdentityHMAC{Details: signedIdentity, Hmac: h.Sum(nil)})
+	wid := types.NewADJID("1234567890", 0, 5)
+	err := cli.handlePair(identityContainer, "pair-1", "", "android", wid)
+	if err != nil {
+		t.Fatalf("Pairing failed: %v", err)
+	}
+	fs.Close(0)
+	registration := <-results
+	if registration.err != nil {
+		t.Fatalf("Server failed to complete registration handshake: %v", registration.err)
+	} else if string(registration.clientStatic) != string(noiseKey.PublicKey()[:]) {
+		t.Error("Expected client static key to be the external noise key")
+	} else if string(registration.payload.GetRegData().GetEIdent()) != string(identityKey.Pub[:]) {
+		t.Error("Expected registration payload with the identity key")
+	} else if registration.frames != 1 {
+		t.Errorf("Expected pairing confirmation to be sent, got %d frames", registration.frames)
+	}
+
+	deviceSignatureMessage := concatBytes([]byte{6, 1}, details, identityKey.Pub[:], primaryKey.Pub[:])
+	if identitySigner.signCalls != 2 || !ecc.VerifySignature(
+		ecc.NewDjbECPublicKey(*identityKey.Pub),
+		deviceSignatureMessage,
+		*(*[64]byte)(device.Account.DeviceSignature),
+	) {
+		t.Error("Expected device signature to be created with the external identity key")
+	}
+	if container.saved != 1 || device.ID == nil || *device.ID != wid {
+		t.Errorf("Expected paired device to be saved (saved %d times, ID %v)", container.saved, device.ID)
+	}
+	if _, ok := identities[wid.ToNonAD().SignalAddress().String()]; !ok {
+		t.Error("Expected primary device identity to be stored")
+	}
+
+	fs = connectToFakeServer(t, cli, url)
+	fs.Close(0)
+	login := <-results
+	if login.err != nil {
+		t.Fatalf("Server failed to complete login handshake: %v", login.err)
+	} else if string(login.clientStatic) != string(noiseKey.PublicKey()[:]) {
+		t.Error("Expected client static key to be the external noise key on login")
+	} else if login.payload.GetUsername() != wid.UserInt() || login.payload.GetDevice() != 5 {
+		t.Errorf("Unexpected login payload %+v", login.payload)
+	}
+	if noiseKey.dhCalls != 2 {
+		t.Errorf("Expected 2 DH operations with the external noise key, got %d", noiseKey.dhCalls)
+	}
+}
+
+func TestClientPayloadHook(t *testing.T) {
+	url, results := fakeNoiseServer(t)
+	device := &store.Device{
+		Log:            waLog.Noop,
+		IdentityKey:    keys.NewKeyPair(),
+		RegistrationID: 1234,
+		CompanionProps: &waProto.CompanionProps{
+			Os:           proto.String("Test OS"),
+			Version:      &waProto.AppVersion{Primary: proto.Uint32(1)},
+			PlatformType: waProto.CompanionProps_DESKTOP.Enum(),
+		},
+		ClientPayloadHook: func(payload *waProto.ClientPayload) {
+			payload.UserAgent.Platform = waProto.UserAgent_MACOS.Enum()
+			payload.WebInfo = nil
+		},
+	}
+	cli := NewClient(device, waLog.Noop)
+
+	fs := connectToFakeServer(t, cli, url)
+	fs.Close(0)
+	res := <-results
+	if res.err != nil {
+		t.Fatalf("Server failed to complete handshake: %v", res.err)
+	} else if res.payload.GetUserAgent().GetPlatform() != waProto.UserAgent_MACOS || res.payload.WebInfo != nil {
+		t.Errorf("Expected hook to change the user agent, got %+v", res.payload.GetUserAgent())
+	}
+	var companionProps waProto.CompanionProps
+	if err := proto.Unmarshal(res.payload.GetRegData().GetCompanionProps(), &companionProps); err != nil {
+		t.Fatalf("Failed to parse companion props: %v", err)
+	} else if companionProps.GetOs() != "Test OS" || companionProps.GetPlatformType() != waProto.CompanionProps_DESKTOP {
+		t.Errorf("Expected device-specific companion props to be sent, got %+v", &companionProps)
+	}
+
+	device.ClientPayloadHook = func(payload *waProto.ClientPayload) {
+		payload.UserAgent.OsVersion = nil
+	}
+	fs = socket.NewFrameSocket(waLog.Noop, socket.WAConnHeader)
+	fs.URL = url
+	if err := fs.Connect(); err != nil {
+		t.Fatalf("Failed to connect to fake server: %v", err)
+	}
+	defer fs.Close(0)
+	if err := cli.doHandshake(fs, *keys.NewKeyPair()); err == nil || !strings.Contains(err.Error(), "invalid client payload") {
+		t.Errorf("Expected handshake to fail with invalid client payload, got %v", err)
+	}
+}
+
+func TestSetWAVersionWhileConnecting(t *testing.T) {
+	url, results := fakeNoiseServer(t)
+	original := store.GetWAVersion()
+	defer func() { _ = store.SetWAVersion(original) }()
+	newVersion := store.WAVersionContainer{2, original[1] + 1, 0}
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+				if i%2 == 0 {
+					_ = store.SetWAVersion(newVersion)
+				} else {
+					_ = store.SetWAVersion(original)
+				}
+			}
+		}
+	}()
+	cli := NewClient(&store.Device{Log: waLog.Noop, RegistrationID: 1234}, waLog.Noop)
+	connectToFakeServer(t, cli, url).Close(0)
+	close(stop)
+	<-stopped
+	res := <-results
+	if res.err != nil {
+		t.Fatalf("Server failed to complete handshake: %v", res.err)
+	}
+	sent := res.payload.GetUserAgent().GetAppVersion().GetSecondary()
+	if sent != original[1] && sent != newVersion[1] {
+		t.Errorf("Unexpected app version %d in payload", sent)
+	}
+}
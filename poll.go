@@ -0,0 +1,231 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/util/random"
+)
+
+// HashPollOptions returns the SHA-256 hashes of the given poll option names, which is how votes refer to options.
+func HashPollOptions(optionNames []string) [][]byte {
+	hashes := make([][]byte, len(optionNames))
+	for i, option := range optionNames {
+		hash := sha256.Sum256([]byte(option))
+		hashes[i] = hash[:]
+	}
+	return hashes
+}
+
+// BuildPollCreation builds a poll message with the given question and options. selectableOptionCount is the maximum
+// number of options each user can select, 1 for a single choice poll and 0 for no limit.
+//
+// The message contains a new message secret, which is stored when the message is sent with SendMessage.
+// The votes to the poll are encrypted with it, so GetPollResults only works for polls that were sent with this client.
+func (cli *Client) BuildPollCreation(name string, optionNames []string, selectableOptionCount int) *waProto.Message {
+	if selectableOptionCount < 0 || selectableOptionCount > len(optionNames) {
+		selectableOptionCount = 0
+	}
+	options := make([]*waProto.PollOption, len(optionNames))
+	for i, option := range optionNames {
+		options[i] = &waProto.PollOption{OptionName: proto.String(option)}
+	}
+	return &waProto.Message{
+		PollCreationMessage: &waProto.PollCreationMessage{
+			Name:                   proto.String(name),
+			Options:                options,
+			SelectableOptionsCount: proto.Uint32(uint32(selectableOptionCount)),
+		},
+		MessageContextInfo: &waProto.MessageContextInfo{
+			MessageSecret: random.Bytes(32),
+		},
+	}
+}
+
+// BuildPollVote builds a vote in the given poll for the given options. An empty list of options removes the vote.
+//
+// The poll must have been received or sent by this client, as the vote is encrypted with the poll's message secret.
+func (cli *Client) BuildPollVote(pollInfo *types.MessageInfo, optionNames []string) (*waProto.Message, error) {
+	if cli.Store.ID == nil {
+		return nil, ErrNotLoggedIn
+	}
+	pollKey := &waProto.MessageKey{
+		RemoteJid: proto.String(pollInfo.Chat.ToNonAD().String()),
+		FromMe:    proto.Bool(pollInfo.IsFromMe),
+		Id:        proto.String(pollInfo.ID),
+	}
+	if pollInfo.IsGroup && !pollInfo.IsFromMe {
+		pollKey.Participant = proto.String(pollInfo.Sender.ToNonAD().String())
+	}
+	secret, err := cli.getMessageSecret(pollInfo.Chat, pollInfo.Sender, pollInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := proto.Marshal(&waProto.PollVoteMessage{SelectedOptions: HashPollOptions(optionNames)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal poll vote: %w", err)
+	}
+	key, additionalData := generateMsgSecretKey(msgSecretPollVote, *cli.Store.ID, pollInfo.ID, pollInfo.Sender, secret)
+	ciphertext, iv, err := encryptMsgSecret(key, additionalData, plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt poll vote: %w", err)
+	}
+	return &waProto.Message{
+		PollUpdateMessage: &waProto.PollUpdateMessage{
+			PollCreationMessageKey: pollKey,
+			Vote: &waProto.PollEncValue{
+				EncPayload: ciphertext,
+				EncIv:      iv,
+			},
+			SenderTimestampMs: proto.Int64(cli.now().UnixMilli()),
+		},
+	}, nil
+}
+
+// getPollSender finds out who created the poll that the given vote refers to.
+func getPollSender(chat, voter types.JID, pollKey *waProto.MessageKey) (types.JID, error) {
+	if pollKey.GetFromMe() {
+		// The key is from the voter's point of view, so fromMe means the voter created the poll
+		return voter.ToNonAD(), nil
+	} else if chat.Server == types.DefaultUserServer {
+		if len(pollKey.GetRemoteJid()) == 0 {
+			return chat, nil
+		}
+		return types.ParseJID(pollKey.GetRemoteJid())
+	} else if len(pollKey.GetParticipant()) == 0 {
+		return types.EmptyJID, fmt.Errorf("poll key doesn't have a participant")
+	}
+	return types.ParseJID(pollKey.GetParticipant())
+}
+
+// DecryptPollVote decrypts a poll update message that the given user sent in the given chat.
+//
+// The poll must have been received or sent by this client, as the vote is encrypted with the poll's message secret.
+func (cli *Client) DecryptPollVote(chat, voter types.JID, update *waProto.PollUpdateMessage) (*waProto.PollVoteMessage, error) {
+	if update == nil {
+		return nil, ErrNotPollUpdateMessage
+	}
+	pollKey := update.GetPollCreationMessageKey()
+	pollSender, err := getPollSender(chat, voter, pollKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get poll sender: %w", err)
+	}
+	secret, err := cli.getMessageSecret(chat, pollSender, pollKey.GetId())
+	if err != nil {
+		return nil, err
+	}
+	key, additionalData := generateMsgSecretKey(msgSecretPollVote, voter, pollKey.GetId(), pollSender, secret)
+	plaintext, err := decryptMsgSecret(key, additionalData, update.GetVote().GetEncPayload(), update.GetVote().GetEncIv())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt poll vote: %w", err)
+	}
+	var vote waProto.PollVoteMessage
+	if err = proto.Unmarshal(plaintext, &vote); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal poll vote: %w", err)
+	}
+	return &vote, nil
+}
+
+// storePollMessage saves the message secret and contents of polls and decrypts and saves votes to them.
+// It's used for both received and sent messages.
+func (cli *Client) storePollMessage(chat, sender types.JID, id types.MessageID, timestamp time.Time, msgs ...*waProto.Message) {
+	chat = chat.ToNonAD()
+	sender = sender.ToNonAD()
+	msg := msgs[0]
+	if creation := msg.GetPollCreationMessage(); creation != nil {
+		cli.storeMessageSecret(chat, sender, id, msgs...)
+		if cli.Store.Polls == nil {
+			return
+		}
+		poll := types.Poll{
+			ID:                     id,
+			Sender:                 sender,
+			Name:                   creation.GetName(),
+			SelectableOptionsCount: int(creation.GetSelectableOptionsCount()),
+		}
+		for _, option := range creation.GetOptions() {
+			poll.Options = append(poll.Options, option.GetOptionName())
+		}
+		if err := cli.Store.Polls.PutPoll(chat, poll); err != nil {
+			cli.Log.Errorf("Failed to store poll %s in %s: %v", id, chat, err)
+		}
+	} else if update := msg.GetPollUpdateMessage(); update != nil && cli.Store.Polls != nil {
+		vote, err := cli.DecryptPollVote(chat, sender, update)
+		if err != nil {
+			cli.Log.Warnf("Failed to decrypt vote %s from %s in %s: %v", id, sender, chat, err)
+			return
+		}
+		if update.SenderTimestampMs != nil {
+			timestamp = time.UnixMilli(update.GetSenderTimestampMs())
+		}
+		err = cli.Store.Polls.PutPollVote(chat, update.GetPollCreationMessageKey().GetId(), types.PollVote{
+			Voter:           sender,
+			SelectedOptions: vote.GetSelectedOptions(),
+			Timestamp:       timestamp,
+		})
+		if err != nil {
+			cli.Log.Errorf("Failed to store vote from %s in poll %s in %s: %v", sender, update.GetPollCreationMessageKey().GetId(), chat, err)
+		}
+	}
+}
+
+// GetPollResults returns the number of votes for each option of the given poll, including options without votes.
+//
+// Only the latest vote of each user is counted, so users who changed their vote are only counted for their new
+// selection. In polls that allow selecting multiple options, each selected option gets one vote. Votes that select
+// more options than the poll allows are ignored.
+//
+// Only polls and votes received or sent while the client was running (and stored in the device store) are known.
+func (cli *Client) GetPollResults(chat types.JID, pollID types.MessageID) (map[string]int, error) {
+	if cli.Store.Polls == nil {
+		return nil, ErrNoPollStore
+	}
+	chat = chat.ToNonAD()
+	poll, err := cli.Store.Polls.GetPoll(chat, pollID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get poll: %w", err)
+	} else if poll == nil {
+		return nil, ErrUnknownPoll
+	}
+	votes, err := cli.Store.Polls.GetPollVotes(chat, pollID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get poll votes: %w", err)
+	}
+	optionHashes := HashPollOptions(poll.Options)
+	results := make(map[string]int, len(poll.Options))
+	for _, option := range poll.Options {
+		results[option] = 0
+	}
+	for _, vote := range votes {
+		// The same option could be listed more than once, so collect the distinct options first
+		selected := make(map[string]struct{}, len(vote.SelectedOptions))
+		for i, hash := range optionHashes {
+			for _, selectedHash := range vote.SelectedOptions {
+				if bytes.Equal(hash, selectedHash) {
+					selected[poll.Options[i]] = struct{}{}
+					break
+				}
+			}
+		}
+		if poll.SelectableOptionsCount > 0 && len(selected) > poll.SelectableOptionsCount {
+			cli.Log.Debugf("Ignoring vote from %s in poll %s that selects %d options (max %d)", vote.Voter, pollID, len(selected), poll.SelectableOptionsCount)
+			continue
+		}
+		for option := range selected {
+			results[option]++
+		}
+	}
+	return results, nil
+}
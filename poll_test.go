@@ -0,0 +1,194 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+type fakeMsgSecretStore map[string][]byte
+
+func (fmss fakeMsgSecretStore) PutMessageSecret(chat, sender types.JID, id types.MessageID, secret []byte) error {
+	key := chat.String() + "/" + sender.String() + "/" + id
+	if _, ok := fmss[key]; !ok {
+		fmss[key] = secret
+	}
+	return nil
+}
+
+func (fmss fakeMsgSecretStore) GetMessageSecret(chat, sender types.JID, id types.MessageID) ([]byte, error) {
+	return fmss[chat.String()+"/"+sender.String()+"/"+id], nil
+}
+
+type fakePollStore struct {
+	polls map[string]types.Poll
+	votes map[string]map[types.JID]types.PollVote
+}
+
+func (fps *fakePollStore) PutPoll(chat types.JID, poll types.Poll) error {
+	key := chat.String() + "/" + poll.ID
+	if _, ok := fps.polls[key]; !ok {
+		fps.polls[key] = poll
+		fps.votes[key] = make(map[types.JID]types.PollVote)
+	}
+	return nil
+}
+
+func (fps *fakePollStore) GetPoll(chat types.JID, pollID types.MessageID) (*types.Poll, error) {
+	poll, ok := fps.polls[chat.String()+"/"+pollID]
+	if !ok {
+		return nil, nil
+	}
+	return &poll, nil
+}
+
+func (fps *fakePollStore) PutPollVote(chat types.JID, pollID types.MessageID, vote types.PollVote) error {
+	votes := fps.votes[chat.String()+"/"+pollID]
+	if existing, ok := votes[vote.Voter]; ok && existing.Timestamp.After(vote.Timestamp) {
+		return nil
+	}
+	votes[vote.Voter] = vote
+	return nil
+}
+
+func (fps *fakePollStore) GetPollVotes(chat types.JID, pollID types.MessageID) (votes []types.PollVote, err error) {
+	for _, vote := range fps.votes[chat.String()+"/"+pollID] {
+		votes = append(votes, vote)
+	}
+	return
+}
+
+type pollTestUser struct {
+	*Client
+	ID  types.JID
+	now time.Time
+}
+
+func newPollTestUser(user string) *pollTestUser {
+	ptu := &pollTestUser{ID: types.NewADJID(user, 0, 1), now: time.UnixMilli(1000)}
+	ptu.Client = &Client{
+		Store: &store.Device{
+			ID:         &ptu.ID,
+			MsgSecrets: fakeMsgSecretStore{},
+			Polls:      &fakePollStore{polls: make(map[string]types.Poll), votes: make(map[string]map[types.JID]types.PollVote)},
+		},
+		Log:     waLog.Noop,
+		nowFunc: func() time.Time { return ptu.now },
+	}
+	return ptu
+}
+
+// deliverPollMessage stores the message as sent by the sender and passes it through protobuf encoding to the receivers.
+func deliverPollMessage(t *testing.T, info *types.MessageInfo, msg *waProto.Message, sender *pollTestUser, receivers ...*pollTestUser) {
+	sender.storePollMessage(info.Chat, info.Sender, info.ID, info.Timestamp, msg)
+	plaintext, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatalf("Failed to marshal message: %v", err)
+	}
+	for _, receiver := range receivers {
+		var received waProto.Message
+		if err = proto.Unmarshal(plaintext, &received); err != nil {
+			t.Fatalf("Failed to unmarshal message: %v", err)
+		}
+		receiverInfo := *info
+		receiverInfo.IsFromMe = false
+		receiver.handleDecryptedMessage(&receiverInfo, &received)
+	}
+}
+
+func TestGetPollResults(t *testing.T) {
+	alice, bob, carol := newPollTestUser("111"), newPollTestUser("222"), newPollTestUser("333")
+	group := types.NewJID("123-456", types.GroupServer)
+	users := []*pollTestUser{alice, bob, carol}
+	others := func(sender *pollTestUser) (receivers []*pollTestUser) {
+		for _, user := range users {
+			if user != sender {
+				receivers = append(receivers, user)
+			}
+		}
+		return
+	}
+
+	pollInfo := &types.MessageInfo{
+		MessageSource: types.MessageSource{Chat: group, Sender: alice.ID, IsFromMe: true, IsGroup: true},
+		ID:            "POLL",
+		Timestamp:     time.UnixMilli(1000),
+	}
+	deliverPollMessage(t, pollInfo, alice.BuildPollCreation("Lunch?", []string{"Pizza", "Sushi", "Tacos"}, 2), alice, bob, carol)
+
+	vote := func(voter *pollTestUser, ts int64, options ...string) {
+		info := *pollInfo
+		info.IsFromMe = voter == alice
+		voter.now = time.UnixMilli(ts)
+		msg, err := voter.BuildPollVote(&info, options)
+		if err != nil {
+			t.Fatalf("Failed to build vote: %v", err)
+		}
+		deliverPollMessage(t, &types.MessageInfo{
+			MessageSource: types.MessageSource{Chat: group, Sender: voter.ID, IsFromMe: true, IsGroup: true},
+			ID:            "VOTE",
+			Timestamp:     voter.now,
+		}, msg, voter, others(voter)...)
+	}
+	checkResults := func(expected map[string]int) {
+		t.Helper()
+		for _, user := range users {
+			results, err := user.GetPollResults(group, "POLL")
+			if err != nil {
+				t.Fatalf("Failed to get poll results: %v", err)
+			} else if !reflect.DeepEqual(results, expected) {
+				t.Errorf("Expected %v for user %s, got %v", expected, user.ID.User, results)
+			}
+		}
+	}
+
+	checkResults(map[string]int{"Pizza": 0, "Sushi": 0, "Tacos": 0})
+	vote(alice, 2000, "Pizza")
+	vote(bob, 2001, "Pizza", "Sushi")
+	vote(carol, 2002, "Sushi", "Sushi")
+	checkResults(map[string]int{"Pizza": 2, "Sushi": 2, "Tacos": 0})
+
+	// Changing a vote replaces the previous selection, while a late older vote is ignored
+	vote(bob, 3000, "Tacos")
+	vote(carol, 1500, "Pizza", "Tacos")
+	checkResults(map[string]int{"Pizza": 1, "Sushi": 1, "Tacos": 1})
+
+	// Removed votes and votes for more options than allowed aren't counted
+	vote(alice, 4000)
+	vote(carol, 4001, "Pizza", "Sushi", "Tacos")
+	checkResults(map[string]int{"Pizza": 0, "Sushi": 0, "Tacos": 1})
+
+	if _, err := alice.GetPollResults(group, "UNKNOWN"); !errors.Is(err, ErrUnknownPoll) {
+		t.Errorf("Expected ErrUnknownPoll, got %v", err)
+	}
+}
+
+func TestPollVoteRequiresMessageSecret(t *testing.T) {
+	alice, bob := newPollTestUser("111"), newPollTestUser("222")
+	chat := bob.ID.ToNonAD()
+	msg := alice.BuildPollCreation("Single choice", []string{"Yes", "No"}, 1)
+	msg.MessageContextInfo = nil
+	info := &types.MessageInfo{
+		MessageSource: types.MessageSource{Chat: chat, Sender: alice.ID, IsFromMe: true},
+		ID:            "POLL",
+		Timestamp:     time.UnixMilli(1000),
+	}
+	deliverPollMessage(t, info, msg, alice)
+	if _, err := alice.BuildPollVote(info, []string{"Yes"}); !errors.Is(err, ErrOriginalMessageSecretNotFound) {
+		t.Errorf("Expected ErrOriginalMessageSecretNotFound, got %v", err)
+	}
+}
@@ -49,6 +49,7 @@ func (cli *Client) uploadPreKeys(currentCount int) {
 		return
 	}
 	cli.Log.Debugf("Got response to uploading prekeys")
+	cli.setPreKeyCount(currentCount + len(preKeys))
 	err = cli.Store.PreKeys.MarkPreKeysAsUploaded(preKeys[len(preKeys)-1].KeyID)
 	if err != nil {
 		cli.Log.Warnf("Failed to mark prekeys as uploaded: %v", err)
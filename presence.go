@@ -0,0 +1,124 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"time"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// DefaultMaxPresenceSubscriptions is the default value of Client.MaxPresenceSubscriptions.
+const DefaultMaxPresenceSubscriptions = 1000
+
+func (cli *Client) handlePresence(node *waBinary.Node) {
+	var evt events.Presence
+	ag := node.AttrGetter()
+	evt.From = ag.JID("from")
+	presenceType := ag.OptionalString("type")
+	if presenceType == "unavailable" {
+		evt.Unavailable = true
+	} else if presenceType != "" {
+		cli.Log.Debugf("Unrecognized presence type '%s' in presence event from %s", presenceType, evt.From)
+	}
+	lastSeen := ag.OptionalString("last")
+	if lastSeen != "" && lastSeen != "deny" {
+		evt.LastSeen = time.Unix(ag.Int64("last"), 0)
+	}
+	if !ag.OK() {
+		cli.Log.Warnf("Error parsing presence event: %+v", ag.Errors)
+		return
+	}
+	cli.presenceSubsLock.Lock()
+	_, evt.FromSubscription = cli.presenceSubs[evt.From.ToNonAD()]
+	cli.presenceSubsLock.Unlock()
+	cli.dispatchEvent(&evt)
+}
+
+func (cli *Client) sendPresenceSubscription(jid types.JID, subscribe bool) error {
+	presenceType := "unsubscribe"
+	if subscribe {
+		presenceType = "subscribe"
+	}
+	return cli.sendNode(waBinary.Node{
+		Tag: "presence",
+		Attrs: waBinary.Attrs{
+			"type": presenceType,
+			"to":   jid,
+		},
+	})
+}
+
+// SubscribePresence asks the WhatsApp servers to send presence updates of the given user to this client.
+//
+// The subscription is remembered and automatically renewed after reconnecting, until UnsubscribePresence
+// is called. If there are more than Client.MaxPresenceSubscriptions subscriptions, the oldest one is dropped.
+//
+// Note that presence updates are only sent while this client is marked as available (see SendPresence).
+func (cli *Client) SubscribePresence(jid types.JID) error {
+	jid = jid.ToNonAD()
+	err := cli.sendPresenceSubscription(jid, true)
+	if err != nil {
+		return err
+	}
+	var evicted []types.JID
+	cli.presenceSubsLock.Lock()
+	if elem, ok := cli.presenceSubs[jid]; ok {
+		cli.presenceLRU.MoveToBack(elem)
+	} else {
+		cli.presenceSubs[jid] = cli.presenceLRU.PushBack(jid)
+	}
+	maxSubs := cli.MaxPresenceSubscriptions
+	for maxSubs > 0 && cli.presenceLRU.Len() > maxSubs {
+		oldest := cli.presenceLRU.Remove(cli.presenceLRU.Front()).(types.JID)
+		delete(cli.presenceSubs, oldest)
+		evicted = append(evicted, oldest)
+	}
+	cli.presenceSubsLock.Unlock()
+	for _, oldest := range evicted {
+		cli.Log.Debugf("Dropping presence subscription of %s as the subscription limit was reached", oldest)
+		err = cli.sendPresenceSubscription(oldest, false)
+		if err != nil {
+			cli.Log.Warnf("Failed to unsubscribe from presence of %s: %v", oldest, err)
+		}
+	}
+	return nil
+}
+
+// UnsubscribePresence stops receiving presence updates of the given user
+// and removes it from the list of subscriptions to renew after reconnecting.
+func (cli *Client) UnsubscribePresence(jid types.JID) error {
+	jid = jid.ToNonAD()
+	cli.presenceSubsLock.Lock()
+	if elem, ok := cli.presenceSubs[jid]; ok {
+		cli.presenceLRU.Remove(elem)
+		delete(cli.presenceSubs, jid)
+	}
+	cli.presenceSubsLock.Unlock()
+	return cli.sendPresenceSubscription(jid, false)
+}
+
+func (cli *Client) resubscribePresences() {
+	cli.presenceSubsLock.Lock()
+	jids := make([]types.JID, 0, cli.presenceLRU.Len())
+	for elem := cli.presenceLRU.Front(); elem != nil; elem = elem.Next() {
+		jids = append(jids, elem.Value.(types.JID))
+	}
+	cli.presenceSubsLock.Unlock()
+	if len(jids) == 0 {
+		return
+	}
+	cli.Log.Debugf("Renewing %d presence subscriptions", len(jids))
+	for _, jid := range jids {
+		err := cli.sendPresenceSubscription(jid, true)
+		if err != nil {
+			cli.Log.Warnf("Failed to renew presence subscription of %s: %v", jid, err)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// PeerMessageResponseTimeout is how long to wait for the primary device to send a receipt for a peer message
+// before considering it offline.
+var PeerMessageResponseTimeout = 1 * time.Minute
+
+const (
+	primaryDeviceUnknown uint32 = iota
+	primaryDeviceOnline
+	primaryDeviceOffline
+)
+
+// IsPrimaryDeviceReachable returns false if the primary device (i.e. the user's phone) didn't respond to
+// the most recent peer message within PeerMessageResponseTimeout and hasn't been heard from since.
+//
+// It returns true if the status is not known yet, e.g. when no peer messages have been sent.
+func (cli *Client) IsPrimaryDeviceReachable() bool {
+	return atomic.LoadUint32(&cli.primaryDeviceStatus) != primaryDeviceOffline
+}
+
+// SendPeerMessageAndWait sends a peer message to the primary device like SendPeerMessage, but also waits for
+// the primary device to acknowledge it. If it doesn't respond within PeerMessageResponseTimeout,
+// ErrPrimaryDeviceOffline is returned.
+//
+// Note that the message will still be delivered later if the primary device comes online after the timeout.
+func (cli *Client) SendPeerMessageAndWait(ctx context.Context, message *waProto.Message) error {
+	if cli.Store.ID == nil {
+		return ErrNotLoggedIn
+	}
+	id := GenerateMessageID()
+	done := cli.trackPeerMessage(id)
+	err := cli.sendPeerMessage(cli.Store.ID.ToNonAD(), id, message)
+	if err != nil {
+		cli.untrackPeerMessage(id)
+		return err
+	}
+	select {
+	case <-done:
+		return nil
+	case <-time.After(PeerMessageResponseTimeout):
+		return ErrPrimaryDeviceOffline
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (cli *Client) trackPeerMessage(id types.MessageID) <-chan struct{} {
+	done := make(chan struct{})
+	cli.pendingPeerMessagesLock.Lock()
+	cli.pendingPeerMessages[id] = done
+	cli.pendingPeerMessagesLock.Unlock()
+	time.AfterFunc(PeerMessageResponseTimeout, func() {
+		if cli.untrackPeerMessage(id) {
+			cli.Log.Warnf("Primary device didn't respond to peer message %s within %v", id, PeerMessageResponseTimeout)
+			cli.setPrimaryDeviceReachable(false)
+		}
+	})
+	return done
+}
+
+func (cli *Client) untrackPeerMessage(id types.MessageID) bool {
+	cli.pendingPeerMessagesLock.Lock()
+	_, pending := cli.pendingPeerMessages[id]
+	delete(cli.pendingPeerMessages, id)
+	cli.pendingPeerMessagesLock.Unlock()
+	return pending
+}
+
+func (cli *Client) handlePeerMessageReceipt(receipt *events.Receipt) {
+	cli.pendingPeerMessagesLock.Lock()
+	for _, id := range append([]types.MessageID{receipt.MessageID}, receipt.PreviousIDs...) {
+		if done, ok := cli.pendingPeerMessages[id]; ok {
+			close(done)
+			delete(cli.pendingPeerMessages, id)
+		}
+	}
+	cli.pendingPeerMessagesLock.Unlock()
+	if receipt.Sender.Device == 0 {
+		cli.setPrimaryDeviceReachable(true)
+	}
+}
+
+func (cli *Client) setPrimaryDeviceReachable(reachable bool) {
+	newStatus := primaryDeviceOffline
+	if reachable {
+		newStatus = primaryDeviceOnline
+	}
+	oldStatus := atomic.SwapUint32(&cli.primaryDeviceStatus, newStatus)
+	if oldStatus == newStatus {
+		return
+	} else if reachable {
+		if oldStatus == primaryDeviceOffline {
+			cli.Log.Infof("Primary device is reachable again")
+			cli.dispatchEvent(&events.PrimaryDeviceOnline{})
+		}
+	} else {
+		cli.dispatchEvent(&events.PrimaryDeviceOffline{})
+	}
+}
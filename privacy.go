@@ -0,0 +1,163 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"fmt"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// TryFetchPrivacySettings fetches the user's privacy settings, either from the in-memory cache or from the server.
+// If ignoreCache is true, the settings are always fetched from the server.
+func (cli *Client) TryFetchPrivacySettings(ignoreCache bool) (*types.PrivacySettings, error) {
+	if !ignoreCache {
+		cli.privacySettingsLock.Lock()
+		cached := cli.privacySettingsCache
+		cli.privacySettingsLock.Unlock()
+		if cached != nil {
+			settingsCopy := *cached
+			return &settingsCopy, nil
+		}
+	}
+	resp, err := cli.sendIQ(infoQuery{
+		Namespace: "privacy",
+		Type:      "get",
+		To:        types.ServerJID,
+		Content:   []waBinary.Node{{Tag: "privacy"}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to request privacy settings: %w", err)
+	}
+	privacyNode, ok := resp.GetOptionalChildByTag("privacy")
+	if !ok {
+		return nil, fmt.Errorf("privacy settings response didn't contain privacy element")
+	}
+	var settings types.PrivacySettings
+	cli.parsePrivacySettings(&privacyNode, &settings)
+	cli.privacySettingsLock.Lock()
+	cli.privacySettingsCache = &settings
+	cli.privacySettingsLock.Unlock()
+	return &settings, nil
+}
+
+// GetPrivacySettings returns the user's privacy settings.
+// The settings are cached after the first fetch and kept up to date by privacy setting notifications.
+func (cli *Client) GetPrivacySettings() (types.PrivacySettings, error) {
+	settings, err := cli.TryFetchPrivacySettings(false)
+	if err != nil {
+		return types.PrivacySettings{}, err
+	}
+	return *settings, nil
+}
+
+// SetPrivacySetting updates the given privacy setting.
+func (cli *Client) SetPrivacySetting(name types.PrivacySettingType, value types.PrivacySetting) error {
+	_, err := cli.sendIQ(infoQuery{
+		Namespace: "privacy",
+		Type:      "set",
+		To:        types.ServerJID,
+		Content: []waBinary.Node{{
+			Tag: "privacy",
+			Content: []waBinary.Node{{
+				Tag: "category",
+				Attrs: waBinary.Attrs{
+					"name":  string(name),
+					"value": string(value),
+				},
+			}},
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set privacy setting: %w", err)
+	}
+	cli.privacySettingsLock.Lock()
+	if cli.privacySettingsCache != nil {
+		cli.privacySettingsCache.Set(name, value)
+	}
+	cli.privacySettingsLock.Unlock()
+	return nil
+}
+
+// GetStatusPrivacy gets the user's status privacy settings (who to send status broadcasts to).
+//
+// There can be multiple different stored settings, the first one is always the default.
+func (cli *Client) GetStatusPrivacy() ([]types.StatusPrivacy, error) {
+	resp, err := cli.sendIQ(infoQuery{
+		Namespace: "status",
+		Type:      "get",
+		To:        types.ServerJID,
+		Content:   []waBinary.Node{{Tag: "privacy"}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to request status privacy settings: %w", err)
+	}
+	privacyNode, ok := resp.GetOptionalChildByTag("privacy")
+	if !ok {
+		return nil, fmt.Errorf("status privacy response didn't contain privacy element")
+	}
+	var outputs []types.StatusPrivacy
+	for _, list := range privacyNode.GetChildren() {
+		if list.Tag != "list" {
+			continue
+		}
+		ag := list.AttrGetter()
+		out := types.StatusPrivacy{
+			Type:      types.StatusPrivacyType(ag.String("type")),
+			IsDefault: ag.OptionalBool("default"),
+		}
+		if !ag.OK() {
+			return nil, fmt.Errorf("failed to parse status privacy list: %w", ag.Error())
+		}
+		for _, child := range list.GetChildren() {
+			jid, ok := child.Attrs["jid"].(types.JID)
+			if child.Tag == "user" && ok {
+				out.List = append(out.List, jid)
+			}
+		}
+		if out.IsDefault {
+			outputs = append([]types.StatusPrivacy{out}, outputs...)
+		} else {
+			outputs = append(outputs, out)
+		}
+	}
+	return outputs, nil
+}
+
+func (cli *Client) parsePrivacySettings(privacyNode *waBinary.Node, settings *types.PrivacySettings) *events.PrivacySettings {
+	var evt events.PrivacySettings
+	for _, child := range privacyNode.GetChildren() {
+		if child.Tag != "category" {
+			continue
+		}
+		ag := child.AttrGetter()
+		name := types.PrivacySettingType(ag.String("name"))
+		value := types.PrivacySetting(ag.String("value"))
+		if !ag.OK() {
+			cli.Log.Warnf("Failed to parse privacy setting %s: %v", child.XMLString(), ag.Error())
+			continue
+		}
+		settings.Set(name, value)
+		evt.Changed = append(evt.Changed, name)
+	}
+	evt.NewSettings = *settings
+	return &evt
+}
+
+func (cli *Client) handlePrivacySettingsNotification(privacyNode *waBinary.Node) {
+	cli.privacySettingsLock.Lock()
+	var settings types.PrivacySettings
+	if cli.privacySettingsCache != nil {
+		settings = *cli.privacySettingsCache
+	}
+	evt := cli.parsePrivacySettings(privacyNode, &settings)
+	cli.privacySettingsCache = &settings
+	cli.privacySettingsLock.Unlock()
+	cli.dispatchEvent(evt)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"time"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// GetMessageReactions returns the current reactions to the given message, one per user who reacted.
+//
+// Only reactions received or sent while the client was running (and stored in the device store) are known.
+func (cli *Client) GetMessageReactions(chat types.JID, messageID types.MessageID) ([]types.Reaction, error) {
+	if cli.Store.Reactions == nil {
+		return nil, ErrNoReactionStore
+	}
+	return cli.Store.Reactions.GetReactions(chat.ToNonAD(), messageID)
+}
+
+// storeReaction saves a reaction that was sent in the given chat by the given user.
+func (cli *Client) storeReaction(chat, sender types.JID, timestamp time.Time, reaction *waProto.ReactionMessage) {
+	if cli.Store.Reactions == nil || len(reaction.GetKey().GetId()) == 0 {
+		return
+	}
+	if reaction.SenderTimestampMs != nil {
+		timestamp = time.UnixMilli(reaction.GetSenderTimestampMs())
+	}
+	err := cli.Store.Reactions.PutReaction(chat.ToNonAD(), reaction.GetKey().GetId(), types.Reaction{
+		Sender:    sender.ToNonAD(),
+		Emoji:     reaction.GetText(),
+		Timestamp: timestamp,
+	})
+	if err != nil {
+		cli.Log.Errorf("Failed to store reaction from %s to %s in %s: %v", sender, reaction.GetKey().GetId(), chat, err)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+type fakeReactionStore map[string]types.Reaction
+
+func (frs fakeReactionStore) PutReaction(chat types.JID, messageID types.MessageID, reaction types.Reaction) error {
+	key := chat.String() + "/" + messageID + "/" + reaction.Sender.String()
+	if existing, ok := frs[key]; ok && existing.Timestamp.After(reaction.Timestamp) {
+		return nil
+	}
+	frs[key] = reaction
+	return nil
+}
+
+func (frs fakeReactionStore) GetReactions(chat types.JID, messageID types.MessageID) (reactions []types.Reaction, err error) {
+	prefix := chat.String() + "/" + messageID + "/"
+	for key, reaction := range frs {
+		if strings.HasPrefix(key, prefix) && len(reaction.Emoji) > 0 {
+			reactions = append(reactions, reaction)
+		}
+	}
+	sort.Slice(reactions, func(i, j int) bool { return reactions[i].Sender.User < reactions[j].Sender.User })
+	return
+}
+
+func TestReactionAggregation(t *testing.T) {
+	cli := &Client{Store: &store.Device{Reactions: fakeReactionStore{}}, Log: waLog.Noop}
+	group := types.NewJID("123-456", types.GroupServer)
+	react := func(sender types.JID, emoji string, ts int64) {
+		cli.handleDecryptedMessage(&types.MessageInfo{
+			MessageSource: types.MessageSource{Chat: group, Sender: sender, IsGroup: true},
+			ID:            "reaction" + strconv.FormatInt(ts, 10),
+			Timestamp:     time.UnixMilli(ts),
+		}, &waProto.Message{ReactionMessage: &waProto.ReactionMessage{
+			Key:               &waProto.MessageKey{RemoteJid: proto.String(group.String()), Id: proto.String("target")},
+			Text:              proto.String(emoji),
+			SenderTimestampMs: proto.Int64(ts),
+		}})
+	}
+	alice := types.NewADJID("111", 0, 2)
+	bob := types.NewJID("222", types.DefaultUserServer)
+	react(alice, "👍", 1000)
+	react(bob, "❤️", 1001)
+	react(alice, "😂", 1002)
+	// An older update arriving late must not replace the newer reaction
+	react(alice, "😮", 999)
+
+	reactions, err := cli.GetMessageReactions(group, "target")
+	if err != nil {
+		t.Fatalf("Failed to get reactions: %v", err)
+	}
+	if len(reactions) != 2 || reactions[0].Sender != alice.ToNonAD() || reactions[0].Emoji != "😂" || reactions[1].Emoji != "❤️" {
+		t.Errorf("Unexpected reactions %+v", reactions)
+	}
+
+	react(bob, "", 1003)
+	reactions, _ = cli.GetMessageReactions(group, "target")
+	if len(reactions) != 1 || reactions[0].Sender != alice.ToNonAD() {
+		t.Errorf("Expected removed reaction to be deleted, got %+v", reactions)
+	}
+}
@@ -25,6 +25,11 @@ func (cli *Client) handleReceipt(node *waBinary.Node) {
 	if err != nil {
 		cli.Log.Warnf("Failed to parse receipt: %v", err)
 	} else {
+		if receipt.Type == events.ReceiptTypeRetry {
+			cli.incrCounter(&cli.metrics.retryReceiptsRecv, MetricRetryReceiptsRecv, 1)
+		} else if receipt.Type == events.ReceiptTypePeerMsg && receipt.IsFromMe {
+			cli.handlePeerMessageReceipt(receipt)
+		}
 		go cli.dispatchEvent(receipt)
 	}
 	go cli.sendAck(node)
@@ -83,24 +88,76 @@ func (cli *Client) sendAck(node *waBinary.Node) {
 	}
 }
 
-func (cli *Client) sendMessageReceipt(info *types.MessageInfo) {
+// MarkRead sends a read receipt for the given message IDs.
+//
+// The sender is only needed for group chats. If the user has disabled read receipts in the privacy settings,
+// a read-self receipt is sent instead, which only marks the messages as read on the user's own devices.
+func (cli *Client) MarkRead(ids []types.MessageID, timestamp time.Time, chat, sender types.JID) error {
+	if len(ids) == 0 {
+		return fmt.Errorf("no message IDs specified")
+	}
+	receiptType := "read"
+	settings, err := cli.TryFetchPrivacySettings(false)
+	if err != nil {
+		cli.Log.Warnf("Failed to get privacy settings to decide read receipt type: %v", err)
+	} else if settings.ReadReceipts == types.PrivacySettingNone {
+		receiptType = "read-self"
+	}
+	node := waBinary.Node{
+		Tag: "receipt",
+		Attrs: waBinary.Attrs{
+			"id":   ids[0],
+			"type": receiptType,
+			"to":   chat,
+			"t":    timestamp.Unix(),
+		},
+	}
+	if chat.Server == types.GroupServer && !sender.IsEmpty() {
+		node.Attrs["participant"] = sender.ToNonAD()
+	}
+	node.Content = receiptListContent(ids)
+	return cli.sendNode(node)
+}
+
+// receiptListContent returns the content for a receipt node that covers all the given message IDs.
+// The first ID goes in the id attribute of the receipt node itself, so it's not included in the list.
+func receiptListContent(ids []types.MessageID) interface{} {
+	if len(ids) <= 1 {
+		return nil
+	}
+	children := make([]waBinary.Node, len(ids)-1)
+	for i := 1; i < len(ids); i++ {
+		children[i-1].Tag = "item"
+		children[i-1].Attrs = waBinary.Attrs{"id": ids[i]}
+	}
+	return []waBinary.Node{{
+		Tag:     "list",
+		Content: children,
+	}}
+}
+
+func messageReceiptAttrs(id types.MessageID, chat, sender types.JID, isFromMe, isGroup bool) waBinary.Attrs {
 	attrs := waBinary.Attrs{
-		"id": info.ID,
+		"id": id,
 	}
-	if info.IsFromMe {
+	if isFromMe {
 		attrs["type"] = "sender"
 	} else {
 		attrs["type"] = "inactive"
 	}
-	attrs["to"] = info.Chat
-	if info.IsGroup {
-		attrs["participant"] = info.Sender
-	} else if info.IsFromMe {
-		attrs["recipient"] = info.Sender
+	attrs["to"] = chat
+	if isGroup {
+		attrs["participant"] = sender
+	} else if isFromMe {
+		attrs["recipient"] = sender
 	}
+	return attrs
+}
+
+func (cli *Client) sendMessageReceipt(info *types.MessageInfo) {
 	err := cli.sendNode(waBinary.Node{
 		Tag:   "receipt",
-		Attrs: attrs,
+		Attrs: messageReceiptAttrs(info.ID, info.Chat, info.Sender, info.IsFromMe, info.IsGroup),
 	})
 	if err != nil {
 		cli.Log.Warnf("Failed to send receipt for %s: %v", info.ID, err)
@@ -163,5 +220,7 @@ func (cli *Client) sendRetryReceipt(node *waBinary.Node, forceIncludeIdentity bo
 	err := cli.sendNode(payload)
 	if err != nil {
 		cli.Log.Errorf("Failed to send retry receipt for %s: %v", id, err)
+	} else {
+		cli.incrCounter(&cli.metrics.retryReceiptsSent, MetricRetryReceiptsSent, 1)
 	}
 }
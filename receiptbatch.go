@@ -0,0 +1,123 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"time"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// DefaultReceiptBatchMaxSize is the default value of Client.ReceiptBatchMaxSize.
+const DefaultReceiptBatchMaxSize = 50
+
+type receiptBatchKey struct {
+	Chat     types.JID
+	Sender   types.JID
+	IsFromMe bool
+	IsGroup  bool
+}
+
+type receiptBatch struct {
+	ids   []types.MessageID
+	acks  []*waBinary.Node
+	timer *time.Timer
+}
+
+// queueMessageReceipt sends the delivery receipt and ack for a message that has been handled.
+//
+// If ReceiptBatchWindow is set, the receipt is batched with other receipts to the same sender in the same chat,
+// and the ack is delayed until the batch is flushed, so that the server redelivers the message if the receipt
+// is never sent. Acks can't contain multiple IDs, so they're still sent as separate nodes.
+func (cli *Client) queueMessageReceipt(info *types.MessageInfo, node *waBinary.Node) {
+	if cli.ReceiptBatchWindow <= 0 {
+		go func() {
+			cli.sendMessageReceipt(info)
+			cli.sendAck(node)
+		}()
+		return
+	}
+	key := receiptBatchKey{Chat: info.Chat, Sender: info.Sender, IsFromMe: info.IsFromMe, IsGroup: info.IsGroup}
+	cli.pendingReceiptsLock.Lock()
+	batch, ok := cli.pendingReceipts[key]
+	if !ok {
+		batch = &receiptBatch{}
+		batch.timer = time.AfterFunc(cli.ReceiptBatchWindow, func() {
+			cli.flushReceiptBatch(key, batch)
+		})
+		cli.pendingReceipts[key] = batch
+	}
+	batch.ids = append(batch.ids, info.ID)
+	batch.acks = append(batch.acks, node)
+	full := cli.ReceiptBatchMaxSize > 0 && len(batch.ids) >= cli.ReceiptBatchMaxSize
+	cli.pendingReceiptsLock.Unlock()
+	if full {
+		go cli.flushReceiptBatch(key, batch)
+	}
+}
+
+// flushReceiptBatch sends the given batch, unless it has already been sent or dropped.
+func (cli *Client) flushReceiptBatch(key receiptBatchKey, batch *receiptBatch) {
+	cli.pendingReceiptsLock.Lock()
+	if cli.pendingReceipts[key] != batch {
+		cli.pendingReceiptsLock.Unlock()
+		return
+	}
+	delete(cli.pendingReceipts, key)
+	batch.timer.Stop()
+	cli.pendingReceiptsLock.Unlock()
+	cli.sendReceiptBatch(key, batch)
+}
+
+// flushPendingReceipts immediately sends all batched receipts and acks.
+func (cli *Client) flushPendingReceipts() {
+	cli.pendingReceiptsLock.Lock()
+	pending := cli.pendingReceipts
+	cli.pendingReceipts = make(map[receiptBatchKey]*receiptBatch)
+	cli.pendingReceiptsLock.Unlock()
+	for key, batch := range pending {
+		batch.timer.Stop()
+		cli.sendReceiptBatch(key, batch)
+	}
+}
+
+// dropPendingReceipts forgets all batched receipts and acks after the connection has been lost.
+// The server will redeliver the messages, as they were never acked.
+func (cli *Client) dropPendingReceipts() {
+	cli.pendingReceiptsLock.Lock()
+	pending := cli.pendingReceipts
+	cli.pendingReceipts = make(map[receiptBatchKey]*receiptBatch)
+	cli.pendingReceiptsLock.Unlock()
+	dropped := 0
+	for _, batch := range pending {
+		batch.timer.Stop()
+		dropped += len(batch.ids)
+	}
+	if dropped > 0 {
+		cli.Log.Debugf("Dropped %d pending receipts after disconnecting", dropped)
+	}
+}
+
+func (cli *Client) sendReceiptBatch(key receiptBatchKey, batch *receiptBatch) {
+	if !cli.IsConnected() {
+		cli.Log.Debugf("Not sending %d batched receipts to %s in %s: not connected", len(batch.ids), key.Sender, key.Chat)
+		return
+	}
+	node := waBinary.Node{
+		Tag:     "receipt",
+		Attrs:   messageReceiptAttrs(batch.ids[0], key.Chat, key.Sender, key.IsFromMe, key.IsGroup),
+		Content: receiptListContent(batch.ids),
+	}
+	err := cli.sendNode(node)
+	if err != nil {
+		cli.Log.Warnf("Failed to send batched receipt for %d messages from %s in %s: %v", len(batch.ids), key.Sender, key.Chat, err)
+	}
+	for _, ack := range batch.acks {
+		cli.sendAck(ack)
+	}
+}
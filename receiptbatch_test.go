@@ -0,0 +1,77 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+	"time"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+func TestReceiptBatching(t *testing.T) {
+	cli := NewClient(&store.Device{}, waLog.Noop)
+	cli.ReceiptBatchWindow = time.Hour
+	cli.ReceiptBatchMaxSize = 3
+	group, _ := types.ParseJID("123456789-987654321@g.us")
+	alice, _ := types.ParseJID("111@s.whatsapp.net")
+	bob, _ := types.ParseJID("222@s.whatsapp.net")
+	queue := func(chat, sender types.JID, id types.MessageID) {
+		info := &types.MessageInfo{
+			MessageSource: types.MessageSource{Chat: chat, Sender: sender, IsGroup: chat.Server == types.GroupServer},
+			ID:            id,
+		}
+		cli.queueMessageReceipt(info, &waBinary.Node{Tag: "message", Attrs: waBinary.Attrs{"id": id}})
+	}
+	queue(group, alice, "1")
+	queue(group, bob, "2")
+	queue(group, alice, "3")
+	queue(alice, alice, "4")
+
+	cli.pendingReceiptsLock.Lock()
+	if len(cli.pendingReceipts) != 3 {
+		t.Errorf("Expected 3 receipt batches, got %d", len(cli.pendingReceipts))
+	}
+	aliceBatch := cli.pendingReceipts[receiptBatchKey{Chat: group, Sender: alice, IsGroup: true}]
+	if aliceBatch == nil || len(aliceBatch.ids) != 2 || aliceBatch.ids[0] != "1" || aliceBatch.ids[1] != "3" || len(aliceBatch.acks) != 2 {
+		t.Errorf("Unexpected batch for alice in group: %+v", aliceBatch)
+	}
+	cli.pendingReceiptsLock.Unlock()
+
+	node := waBinary.Node{
+		Tag:     "receipt",
+		Attrs:   messageReceiptAttrs("1", group, alice, false, true),
+		Content: receiptListContent(aliceBatch.ids),
+	}
+	list := node.GetChildByTag("list")
+	if node.AttrGetter().JID("participant") != alice || len(list.GetChildren()) != 1 || list.GetChildren()[0].Attrs["id"] != "3" {
+		t.Errorf("Unexpected batched receipt node %s", node.XMLString())
+	}
+
+	// Reaching the max size sends the batch without waiting for the window
+	queue(group, alice, "5")
+	deadline := time.Now().Add(time.Second)
+	for {
+		cli.pendingReceiptsLock.Lock()
+		_, stillPending := cli.pendingReceipts[receiptBatchKey{Chat: group, Sender: alice, IsGroup: true}]
+		cli.pendingReceiptsLock.Unlock()
+		if !stillPending {
+			break
+		} else if time.Now().After(deadline) {
+			t.Fatal("Expected full batch to be flushed")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	cli.dropPendingReceipts()
+	if len(cli.pendingReceipts) != 0 {
+		t.Errorf("Expected pending receipts to be dropped, %d batches left", len(cli.pendingReceipts))
+	}
+}
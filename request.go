@@ -101,17 +101,58 @@ func (cli *Client) sendIQAsync(query infoQuery) (<-chan *waBinary.Node, error) {
 	return waiter, nil
 }
 
-func (cli *Client) sendIQ(query infoQuery) (*waBinary.Node, error) {
-	resChan, err := cli.sendIQAsync(query)
-	if err != nil {
-		return nil, err
+// acquireIQSlot waits until the number of outstanding IQs is below Client.MaxConcurrentIQs.
+//
+// The returned channel must be passed to releaseIQSlot after the IQ is done. If MaxConcurrentIQs
+// isn't set, the returned channel is nil and there's no limit.
+func (cli *Client) acquireIQSlot(ctx context.Context, timeout <-chan time.Time) (chan struct{}, error) {
+	cli.iqSemaphoreLock.Lock()
+	if cli.MaxConcurrentIQs <= 0 {
+		cli.iqSemaphore = nil
+	} else if cli.iqSemaphore == nil || cap(cli.iqSemaphore) != cli.MaxConcurrentIQs {
+		// IQs that acquired a slot from the previous semaphore will release it back there.
+		cli.iqSemaphore = make(chan struct{}, cli.MaxConcurrentIQs)
+	}
+	semaphore := cli.iqSemaphore
+	cli.iqSemaphoreLock.Unlock()
+	if semaphore == nil {
+		return nil, nil
+	}
+	select {
+	case semaphore <- struct{}{}:
+		return semaphore, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-timeout:
+		return nil, ErrIQTimedOut
+	}
+}
+
+func releaseIQSlot(semaphore chan struct{}) {
+	if semaphore != nil {
+		<-semaphore
 	}
+}
+
+func (cli *Client) sendIQ(query infoQuery) (*waBinary.Node, error) {
 	if query.Timeout == 0 {
 		query.Timeout = 1 * time.Minute
 	}
 	if query.Context == nil {
 		query.Context = context.Background()
 	}
+	// The timeout also covers the time spent waiting for a slot if the concurrency limit is reached.
+	timeout := time.NewTimer(query.Timeout)
+	defer timeout.Stop()
+	semaphore, err := cli.acquireIQSlot(query.Context, timeout.C)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseIQSlot(semaphore)
+	resChan, err := cli.sendIQAsync(query)
+	if err != nil {
+		return nil, err
+	}
 	select {
 	case res := <-resChan:
 		if res == closedNode {
@@ -121,12 +162,12 @@ func (cli *Client) sendIQ(query infoQuery) (*waBinary.Node, error) {
 		if res.Tag != "iq" || (resType != "result" && resType != "error") {
 			return res, fmt.Errorf("%w tag=%s type=%s", ErrIQUnexpectedResponse, res.Tag, resType)
 		} else if resType == "error" {
-			return res, fmt.Errorf("%w: %s", ErrIQError, res.XMLString())
+			return res, parseIQError(res)
 		}
 		return res, nil
 	case <-query.Context.Done():
 		return nil, query.Context.Err()
-	case <-time.After(query.Timeout):
+	case <-timeout.C:
 		return nil, ErrIQTimedOut
 	}
 }
@@ -0,0 +1,159 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+func newIQTestClient(t testing.TB) *Client {
+	url, _ := fakeNoiseServer(t)
+	cli := NewClient(&store.Device{Log: waLog.Noop, RegistrationID: 1234}, waLog.Noop)
+	fs := connectToFakeServer(t, cli, url)
+	t.Cleanup(func() { fs.Close(0) })
+	return cli
+}
+
+// pendingIQs returns the IDs of the info queries that have been sent and are waiting for a response.
+func pendingIQs(cli *Client) []string {
+	cli.responseWaitersLock.Lock()
+	defer cli.responseWaitersLock.Unlock()
+	ids := make([]string, 0, len(cli.responseWaiters))
+	for id := range cli.responseWaiters {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func answerIQ(cli *Client, id string) {
+	cli.receiveResponse(&waBinary.Node{Tag: "iq", Attrs: waBinary.Attrs{"id": id, "type": "result", "from": types.ServerJID}})
+}
+
+// waitForPendingIQs waits until exactly count info queries are waiting for a response.
+func waitForPendingIQs(t *testing.T, cli *Client, count int) []string {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		ids := pendingIQs(cli)
+		if len(ids) == count {
+			return ids
+		} else if time.Now().After(deadline) {
+			t.Fatalf("Expected %d pending info queries, got %d", count, len(ids))
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestMaxConcurrentIQs(t *testing.T) {
+	cli := newIQTestClient(t)
+	cli.MaxConcurrentIQs = 2
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := cli.sendIQ(infoQuery{Namespace: "test", Type: "get", To: types.ServerJID}); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		}()
+	}
+	answered := 0
+	for answered < 5 {
+		ids := waitForPendingIQs(t, cli, min(2, 5-answered))
+		// The remaining queries must keep waiting for a slot until one of the in-flight ones is answered
+		time.Sleep(10 * time.Millisecond)
+		if pending := len(pendingIQs(cli)); pending != len(ids) {
+			t.Fatalf("Expected at most %d info queries in flight, got %d", cli.MaxConcurrentIQs, pending)
+		}
+		answerIQ(cli, ids[0])
+		answered++
+	}
+	wg.Wait()
+}
+
+func TestIQSlotTimeout(t *testing.T) {
+	cli := newIQTestClient(t)
+	cli.MaxConcurrentIQs = 1
+	done := make(chan error, 1)
+	go func() {
+		_, err := cli.sendIQ(infoQuery{Namespace: "test", Type: "get", To: types.ServerJID})
+		done <- err
+	}()
+	ids := waitForPendingIQs(t, cli, 1)
+
+	_, err := cli.sendIQ(infoQuery{Namespace: "test", Type: "get", To: types.ServerJID, Timeout: 20 * time.Millisecond})
+	if !errors.Is(err, ErrIQTimedOut) {
+		t.Fatalf("Expected query waiting for a slot to time out, got %v", err)
+	}
+	// The timed out query never got a slot, so it must not have released the one held by the first query
+	if held := len(cli.iqSemaphore); held != 1 {
+		t.Errorf("Expected the in-flight query to still hold its slot, got %d held slots", held)
+	}
+	answerIQ(cli, ids[0])
+	if err = <-done; err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if held := len(cli.iqSemaphore); held != 0 {
+		t.Errorf("Expected all slots to be released, got %d held slots", held)
+	}
+}
+
+// BenchmarkIQBurst sends bursts of 10k info queries, which are answered as soon as they're sent.
+// The max-in-flight metric shows how many queries were outstanding on the socket at once.
+func BenchmarkIQBurst(b *testing.B) {
+	for _, limit := range []int{0, 100} {
+		name := "Unlimited"
+		if limit > 0 {
+			name = "MaxConcurrentIQs=" + strconv.Itoa(limit)
+		}
+		b.Run(name, func(b *testing.B) {
+			cli := newIQTestClient(b)
+			cli.MaxConcurrentIQs = limit
+			stop := make(chan struct{})
+			maxInFlight := make(chan int)
+			go func() {
+				highest := 0
+				for {
+					select {
+					case <-stop:
+						maxInFlight <- highest
+						return
+					default:
+					}
+					ids := pendingIQs(cli)
+					highest = max(highest, len(ids))
+					for _, id := range ids {
+						answerIQ(cli, id)
+					}
+				}
+			}()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				var wg sync.WaitGroup
+				for j := 0; j < 10000; j++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						_, _ = cli.sendIQ(infoQuery{Namespace: "test", Type: "get", To: types.ServerJID})
+					}()
+				}
+				wg.Wait()
+			}
+			b.StopTimer()
+			close(stop)
+			b.ReportMetric(float64(<-maxInFlight), "max-in-flight")
+		})
+	}
+}
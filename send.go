@@ -7,16 +7,18 @@
 package whatsmeow
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
-	"errors"
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"go.mau.fi/libsignal/groups"
 	"go.mau.fi/libsignal/keys/prekey"
@@ -25,6 +27,7 @@ import (
 
 	waBinary "go.mau.fi/whatsmeow/binary"
 	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/socket"
 	"go.mau.fi/whatsmeow/types"
 )
 
@@ -39,28 +42,184 @@ func GenerateMessageID() string {
 	return hex.EncodeToString(id)
 }
 
+// SendResponse contains information about a sent message.
+type SendResponse struct {
+	// The message ID that was used for the message.
+	ID string
+	// The time when the message was sent.
+	Timestamp time.Time
+	// Devices that the message wasn't sent to, because they didn't have a Signal session yet and no prekeys were
+	// available to establish one, along with the reason why fetching prekeys failed for each one.
+	MissingDevices map[types.JID]error
+}
+
+// SendRequestExtra contains optional parameters for SendMessage.
+type SendRequestExtra struct {
+	// Unordered skips the per-chat send queue, so the message may be sent before messages to the same chat
+	// that were passed to SendMessage earlier. This is useful for fire-and-forget messages like reactions.
+	Unordered bool
+}
+
+type preSendMessageIDKey struct{}
+
+// PreSendMessageID returns the ID of the message being sent from the context passed to Client.PreSendHook.
+func PreSendMessageID(ctx context.Context) types.MessageID {
+	id, _ := ctx.Value(preSendMessageIDKey{}).(types.MessageID)
+	return id
+}
+
 // SendMessage sends the given message.
 //
 // If the message ID is not provided, a random message ID will be generated.
-func (cli *Client) SendMessage(to types.JID, id string, message *waProto.Message) error {
+//
+// Messages can also be sent to newsletters (channels) that the current user is an admin of.
+// Newsletter messages are not end-to-end encrypted.
+//
+// If Client.EnablePersistentOutbox is set, messages to users and groups are stored in the outbox until
+// the server acknowledges them, and unacknowledged messages are resent after reconnecting. If sending fails
+// for a reason other than the connection, the message is removed from the outbox and the error is returned.
+//
+// If Client.PreSendHook is set, it's called with the message after the ID is assigned. Messages resent from
+// the outbox already went through the hook, so it isn't called again for them.
+//
+// Messages to the same chat are sent in the order SendMessage was called, even when called from different
+// goroutines, and the returned timestamp is the time when the message was actually sent. If the sending of
+// one message fails, the next ones are still sent. Set SendRequestExtra.Unordered to skip the queue.
+//
+// Signal sessions are automatically established with recipient devices that don't have one yet. If no prekeys are
+// available for some devices, the message is sent to the other devices and the skipped ones are listed in
+// SendResponse.MissingDevices. If none of the devices could be encrypted for, a *MissingPreKeysError is returned.
+func (cli *Client) SendMessage(to types.JID, id string, message *waProto.Message, extra ...SendRequestExtra) (resp SendResponse, err error) {
 	if to.AD {
-		return ErrRecipientADJID
+		err = ErrRecipientADJID
+		return
+	}
+	var req SendRequestExtra
+	if len(extra) > 1 {
+		err = ErrTooManySendExtras
+		return
+	} else if len(extra) == 1 {
+		req = extra[0]
 	}
 
 	if len(id) == 0 {
 		id = GenerateMessageID()
 	}
+	resp.ID = id
+	if !req.Unordered {
+		wait, done := cli.sendQueue.enqueue(to)
+		defer done()
+		wait()
+	}
+	resp.Timestamp = cli.now()
 
+	if cli.PreSendHook != nil {
+		var hookedMessage *waProto.Message
+		hookedMessage, err = cli.PreSendHook(context.WithValue(context.Background(), preSendMessageIDKey{}, id), to, message)
+		if err != nil {
+			err = fmt.Errorf("pre-send hook aborted sending: %w", err)
+			return
+		} else if hookedMessage != nil {
+			message = hookedMessage
+		}
+	}
+
+	useOutbox := cli.outboxEnabled() && (to.Server == types.GroupServer || to.Server == types.DefaultUserServer)
+	if useOutbox {
+		err = cli.addToOutbox(to, id, message)
+		if err != nil {
+			return
+		}
+	}
+	resp.MissingDevices, err = cli.sendMessage(to, id, message)
+	if err != nil && useOutbox && !isNetworkError(err) {
+		// The caller gets the error, so the message must not be resent from the outbox later
+		cli.removeFromOutbox(id)
+	}
+	if err == nil && message.GetReactionMessage() != nil && cli.Store.ID != nil {
+		cli.storeReaction(to, *cli.Store.ID, resp.Timestamp, message.GetReactionMessage())
+	}
+	if err == nil && (message.GetPollCreationMessage() != nil || message.GetPollUpdateMessage() != nil) && cli.Store.ID != nil {
+		cli.storePollMessage(to, *cli.Store.ID, id, resp.Timestamp, message)
+	}
+	return
+}
+
+// sendMessage sends the given message and returns the devices that were skipped because of missing prekeys.
+func (cli *Client) sendMessage(to types.JID, id string, message *waProto.Message) (missingDevices map[types.JID]error, err error) {
 	switch to.Server {
 	case types.GroupServer:
-		return cli.sendGroup(to, id, message)
+		missingDevices, err = cli.sendGroup(to, id, message)
 	case types.DefaultUserServer:
-		return cli.sendDM(to, id, message)
+		missingDevices, err = cli.sendDM(to, id, message)
+	case types.NewsletterServer:
+		err = cli.sendNewsletter(to, id, message)
 	case types.BroadcastServer:
-		return ErrBroadcastListUnsupported
+		err = ErrBroadcastListUnsupported
 	default:
-		return fmt.Errorf("%w %s", ErrUnknownServer, to.Server)
+		err = fmt.Errorf("%w %s", ErrUnknownServer, to.Server)
+	}
+	if err == nil {
+		cli.incrCounter(&cli.metrics.messagesSent, MetricMessagesSent, 1)
+	}
+	return
+}
+
+// SendPeerMessage sends a protocol message to the primary device of the current user.
+//
+// Peer messages are used for things like requesting app state keys or history syncs from the phone.
+// They use the "peer" category and are encrypted only for the primary device, so the message must be
+// something the phone knows how to handle (usually a ProtocolMessage).
+//
+// If the phone doesn't acknowledge the message within PeerMessageResponseTimeout, events.PrimaryDeviceOffline
+// is emitted. Use SendPeerMessageAndWait to get ErrPrimaryDeviceOffline returned instead.
+func (cli *Client) SendPeerMessage(message *waProto.Message) error {
+	if cli.Store.ID == nil {
+		return ErrNotLoggedIn
+	}
+	id := GenerateMessageID()
+	cli.trackPeerMessage(id)
+	err := cli.sendPeerMessage(cli.Store.ID.ToNonAD(), id, message)
+	if err != nil {
+		cli.untrackPeerMessage(id)
+	}
+	return err
+}
+
+// sendPeerMessage sends a peer message to one of the user's own devices.
+func (cli *Client) sendPeerMessage(to types.JID, id string, message *waProto.Message) error {
+	plaintext, err := proto.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+	participantNodes, includeIdentity, missingPreKeys := cli.encryptMessageForDevices([]types.JID{to}, id, plaintext, nil)
+	if len(missingPreKeys) > 0 {
+		return &MissingPreKeysError{Devices: missingPreKeys}
+	} else if len(participantNodes) == 0 {
+		return fmt.Errorf("failed to encrypt peer message %s for %s", id, to)
+	}
+	node := waBinary.Node{
+		Tag: "message",
+		Attrs: waBinary.Attrs{
+			"id":       id,
+			"type":     "text",
+			"to":       to,
+			"category": "peer",
+		},
+		// Peer messages only have one recipient, so the enc node goes directly in the message instead of a participants list.
+		Content: participantNodes[0].GetChildren(),
+	}
+	if includeIdentity {
+		err = cli.appendDeviceIdentityNode(&node)
+		if err != nil {
+			return err
+		}
+	}
+	err = cli.sendNode(node)
+	if err != nil {
+		return fmt.Errorf("failed to send message node: %w", err)
 	}
+	return nil
 }
 
 func participantListHashV2(participantJIDs []string) string {
@@ -69,22 +228,37 @@ func participantListHashV2(participantJIDs []string) string {
 	return fmt.Sprintf("2:%s", base64.RawStdEncoding.EncodeToString(hash[:6]))
 }
 
-func (cli *Client) sendGroup(to types.JID, id string, message *waProto.Message) error {
+func (cli *Client) sendGroup(to types.JID, id string, message *waProto.Message) (map[types.JID]error, error) {
+	return cli.sendGroupMessage(to, id, message, false)
+}
+
+func (cli *Client) sendGroupMessage(to types.JID, id string, message *waProto.Message, isRetry bool) (map[types.JID]error, error) {
 	groupInfo, err := cli.GetGroupInfo(to)
 	if err != nil {
-		return fmt.Errorf("failed to get group info: %w", err)
+		return nil, fmt.Errorf("failed to get group info: %w", err)
+	}
+	if groupInfo.IsAnnounceOnly() && !groupInfo.CanSendMessages(*cli.Store.ID) {
+		return nil, ErrGroupAnnounceOnly
 	}
 
 	plaintext, _, err := marshalMessage(to, message)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if shrunk, ok := fitMessageInFrame(message, len(plaintext), 1); ok {
+		cli.Log.Warnf("Message %s to %s is too large for a single frame, shrinking thumbnails", id, to)
+		message = shrunk
+		plaintext, _, err = marshalMessage(to, message)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	builder := groups.NewGroupSessionBuilder(cli.Store, pbSerializer)
 	senderKeyName := protocol.NewSenderKeyName(to.String(), cli.Store.ID.SignalAddress())
 	signalSKDMessage, err := builder.Create(senderKeyName)
 	if err != nil {
-		return fmt.Errorf("failed to create sender key distribution message to send %s to %s: %w", id, to, err)
+		return nil, fmt.Errorf("failed to create sender key distribution message to send %s to %s: %w", id, to, err)
 	}
 	skdMessage := &waProto.Message{
 		SenderKeyDistributionMessage: &waProto.SenderKeyDistributionMessage{
@@ -94,13 +268,13 @@ func (cli *Client) sendGroup(to types.JID, id string, message *waProto.Message)
 	}
 	skdPlaintext, err := proto.Marshal(skdMessage)
 	if err != nil {
-		return fmt.Errorf("failed to marshal sender key distribution message to send %s to %s: %w", id, to, err)
+		return nil, fmt.Errorf("failed to marshal sender key distribution message to send %s to %s: %w", id, to, err)
 	}
 
 	cipher := groups.NewGroupCipher(builder, senderKeyName, cli.Store)
 	encrypted, err := cipher.Encrypt(padMessage(plaintext))
 	if err != nil {
-		return fmt.Errorf("failed to encrypt group message to send %s to %s: %w", id, to, err)
+		return nil, fmt.Errorf("failed to encrypt group message to send %s to %s: %w", id, to, err)
 	}
 	ciphertext := encrypted.SignedSerialize()
 
@@ -111,19 +285,23 @@ func (cli *Client) sendGroup(to types.JID, id string, message *waProto.Message)
 		participantsStrings[i] = part.JID.String()
 	}
 
-	allDevices, err := cli.GetUserDevices(participants)
+	allDevices, err := cli.getMessageDevices(participants)
 	if err != nil {
-		return fmt.Errorf("failed to get device list: %w", err)
+		return nil, fmt.Errorf("failed to get device list: %w", err)
+	}
+	participantNodes, includeIdentity, missingPreKeys := cli.encryptMessageForDevices(allDevices, id, skdPlaintext, nil)
+	if len(participantNodes) == 0 && len(missingPreKeys) > 0 {
+		return nil, &MissingPreKeysError{Devices: missingPreKeys}
 	}
-	participantNodes, includeIdentity := cli.encryptMessageForDevices(allDevices, id, skdPlaintext, nil)
 
+	phash := participantListHashV2(participantsStrings)
 	node := waBinary.Node{
 		Tag: "message",
 		Attrs: waBinary.Attrs{
 			"id":    id,
 			"type":  "text",
 			"to":    to,
-			"phash": participantListHashV2(participantsStrings),
+			"phash": phash,
 		},
 		Content: []waBinary.Node{
 			{Tag: "participants", Content: participantNodes},
@@ -133,27 +311,39 @@ func (cli *Client) sendGroup(to types.JID, id string, message *waProto.Message)
 	if includeIdentity {
 		err = cli.appendDeviceIdentityNode(&node)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
+	cli.trackGroupSend(id, &pendingGroupSend{to: to, message: message, phash: phash, sentAt: cli.now(), retried: isRetry})
 	err = cli.sendNode(node)
 	if err != nil {
-		return fmt.Errorf("failed to send message node: %w", err)
+		return nil, fmt.Errorf("failed to send message node: %w", err)
 	}
-	return nil
+	return missingPreKeys, nil
 }
 
-func (cli *Client) sendDM(to types.JID, id string, message *waProto.Message) error {
+func (cli *Client) sendDM(to types.JID, id string, message *waProto.Message) (map[types.JID]error, error) {
 	messagePlaintext, deviceSentMessagePlaintext, err := marshalMessage(to, message)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	allDevices, err := cli.GetUserDevices([]types.JID{to, *cli.Store.ID})
+	allDevices, err := cli.getMessageDevices([]types.JID{to, *cli.Store.ID})
 	if err != nil {
-		return fmt.Errorf("failed to get device list: %w", err)
+		return nil, fmt.Errorf("failed to get device list: %w", err)
+	}
+	// Each device gets its own encrypted copy, so the size limit depends on the number of devices.
+	if shrunk, ok := fitMessageInFrame(message, len(deviceSentMessagePlaintext), len(allDevices)); ok {
+		cli.Log.Warnf("Message %s to %s is too large for %d devices, shrinking thumbnails", id, to, len(allDevices))
+		messagePlaintext, deviceSentMessagePlaintext, err = marshalMessage(to, shrunk)
+		if err != nil {
+			return nil, err
+		}
+	}
+	participantNodes, includeIdentity, missingPreKeys := cli.encryptMessageForDevices(allDevices, id, messagePlaintext, deviceSentMessagePlaintext)
+	if len(participantNodes) == 0 && len(missingPreKeys) > 0 {
+		return nil, &MissingPreKeysError{Devices: missingPreKeys}
 	}
-	participantNodes, includeIdentity := cli.encryptMessageForDevices(allDevices, id, messagePlaintext, deviceSentMessagePlaintext)
 
 	node := waBinary.Node{
 		Tag: "message",
@@ -170,14 +360,77 @@ func (cli *Client) sendDM(to types.JID, id string, message *waProto.Message) err
 	if includeIdentity {
 		err = cli.appendDeviceIdentityNode(&node)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 	err = cli.sendNode(node)
 	if err != nil {
-		return fmt.Errorf("failed to send message node: %w", err)
+		return nil, fmt.Errorf("failed to send message node: %w", err)
 	}
-	return nil
+	return missingPreKeys, nil
+}
+
+// messageNodeOverhead is the amount of space reserved in a frame for the parts of a message node
+// other than the encrypted message copies (e.g. attributes, sender key distribution and device identity).
+const messageNodeOverhead = 64 * 1024
+
+// fitMessageInFrame checks if the given number of encrypted copies of a message with the given plaintext size would
+// fit in a single frame. If not, it returns a copy of the message where thumbnails have been shrunk or dropped to make
+// it fit. The original message is never modified. If there are no thumbnails to shrink, sending will fail with
+// socket.ErrFrameTooLarge.
+func fitMessageInFrame(message *waProto.Message, plaintextSize, copies int) (*waProto.Message, bool) {
+	if copies < 1 {
+		copies = 1
+	}
+	maxCopySize := (socket.FrameMaxSize - messageNodeOverhead) / copies
+	if plaintextSize <= maxCopySize {
+		return message, false
+	}
+	shrunk := proto.Clone(message).(*waProto.Message)
+	thumbnails := collectThumbnails(shrunk.ProtoReflect(), nil)
+	thumbnailsSize := 0
+	for _, field := range thumbnails {
+		thumbnailsSize += len(field.parent.Get(field.field).Bytes())
+	}
+	otherSize := plaintextSize - thumbnailsSize
+	if len(thumbnails) == 0 || otherSize >= maxCopySize {
+		return message, false
+	}
+	maxThumbnailSize := (maxCopySize - otherSize) / len(thumbnails)
+	for _, field := range thumbnails {
+		thumbnail := shrinkThumbnail(field.parent.Get(field.field).Bytes(), maxThumbnailSize)
+		if thumbnail == nil {
+			field.parent.Clear(field.field)
+		} else {
+			field.parent.Set(field.field, protoreflect.ValueOfBytes(thumbnail))
+		}
+	}
+	return shrunk, true
+}
+
+type thumbnailField struct {
+	parent protoreflect.Message
+	field  protoreflect.FieldDescriptor
+}
+
+// collectThumbnails finds all JPEG thumbnails in the given message, including ones in nested messages like quotes.
+func collectThumbnails(msg protoreflect.Message, into []thumbnailField) []thumbnailField {
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.Kind() == protoreflect.BytesKind && field.Name() == "jpegThumbnail" && !field.IsList():
+			into = append(into, thumbnailField{parent: msg, field: field})
+		case field.Kind() != protoreflect.MessageKind || field.IsMap():
+		case field.IsList():
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				into = collectThumbnails(list.Get(i).Message(), into)
+			}
+		default:
+			into = collectThumbnails(value.Message(), into)
+		}
+		return true
+	})
+	return into
 }
 
 func marshalMessage(to types.JID, message *waProto.Message) (plaintext, dsmPlaintext []byte, err error) {
@@ -215,20 +468,52 @@ func (cli *Client) appendDeviceIdentityNode(node *waBinary.Node) error {
 	return nil
 }
 
-func (cli *Client) encryptMessageForDevices(allDevices []types.JID, id string, msgPlaintext, dsmPlaintext []byte) ([]waBinary.Node, bool) {
+// encryptMessageForDevices encrypts the given plaintext for each of the given devices.
+//
+// Sessions are established for all devices that don't have one yet using a single prekey request before anything
+// is encrypted. Devices for which no prekeys could be fetched are skipped and returned in the map along with the
+// reason, while other encryption errors are only logged.
+func (cli *Client) encryptMessageForDevices(allDevices []types.JID, id string, msgPlaintext, dsmPlaintext []byte) ([]waBinary.Node, bool, map[types.JID]error) {
+	var newDevices []types.JID
+	for _, jid := range allDevices {
+		if !cli.Store.ContainsSession(jid.SignalAddress()) {
+			newDevices = append(newDevices, jid)
+		}
+	}
+	var bundles map[types.JID]preKeyResp
+	var missingPreKeys map[types.JID]error
+	if len(newDevices) > 0 {
+		cli.Log.Debugf("Fetching prekeys for %d devices without a session to send %s", len(newDevices), id)
+		var err error
+		bundles, err = cli.fetchPreKeys(newDevices)
+		if err != nil {
+			cli.Log.Warnf("Failed to fetch prekeys for %d devices to send %s: %v", len(newDevices), id, err)
+		}
+		missingPreKeys = make(map[types.JID]error)
+		for _, jid := range newDevices {
+			if err != nil {
+				missingPreKeys[jid] = err
+			} else if resp, ok := bundles[jid]; !ok {
+				missingPreKeys[jid] = fmt.Errorf("prekey response didn't contain device")
+			} else if resp.err != nil {
+				missingPreKeys[jid] = resp.err
+			}
+		}
+	}
+
 	includeIdentity := false
 	participantNodes := make([]waBinary.Node, 0, len(allDevices))
-	var retryDevices []types.JID
 	for _, jid := range allDevices {
+		if reason, ok := missingPreKeys[jid]; ok {
+			cli.Log.Warnf("Not sending %s to %s: failed to fetch prekeys: %v", id, jid, reason)
+			continue
+		}
 		plaintext := msgPlaintext
 		if jid.User == cli.Store.ID.User && dsmPlaintext != nil {
 			plaintext = dsmPlaintext
 		}
-		encrypted, isPreKey, err := cli.encryptMessageForDevice(plaintext, jid, nil)
-		if errors.Is(err, ErrNoSession) {
-			retryDevices = append(retryDevices, jid)
-			continue
-		} else if err != nil {
+		encrypted, isPreKey, err := cli.encryptMessageForDevice(plaintext, jid, bundles[jid].bundle)
+		if err != nil {
 			cli.Log.Warnf("Failed to encrypt %s for %s: %v", id, jid, err)
 			continue
 		}
@@ -237,37 +522,14 @@ func (cli *Client) encryptMessageForDevices(allDevices []types.JID, id string, m
 			includeIdentity = true
 		}
 	}
-	if len(retryDevices) > 0 {
-		bundles, err := cli.fetchPreKeys(retryDevices)
-		if err != nil {
-			cli.Log.Warnf("Failed to fetch prekeys for %d to retry encryption: %v", retryDevices, err)
-		} else {
-			for _, jid := range retryDevices {
-				resp := bundles[jid]
-				if resp.err != nil {
-					cli.Log.Warnf("Failed to fetch prekey for %s: %v", jid, resp.err)
-					continue
-				}
-				plaintext := msgPlaintext
-				if jid.User == cli.Store.ID.User && dsmPlaintext != nil {
-					plaintext = dsmPlaintext
-				}
-				encrypted, isPreKey, err := cli.encryptMessageForDevice(plaintext, jid, resp.bundle)
-				if err != nil {
-					cli.Log.Warnf("Failed to encrypt %s for %s (retry): %v", id, jid, err)
-					continue
-				}
-				participantNodes = append(participantNodes, *encrypted)
-				if isPreKey {
-					includeIdentity = true
-				}
-			}
-		}
+	if len(missingPreKeys) == 0 {
+		missingPreKeys = nil
 	}
-	return participantNodes, includeIdentity
+	return participantNodes, includeIdentity, missingPreKeys
 }
 
 func (cli *Client) encryptMessageForDevice(plaintext []byte, to types.JID, bundle *prekey.Bundle) (*waBinary.Node, bool, error) {
+	defer cli.signalLocks.Lock(to.SignalAddress().String())()
 	builder := session.NewBuilderFromSignal(cli.Store, to.SignalAddress(), pbSerializer)
 	if !cli.Store.ContainsSession(to.SignalAddress()) {
 		if bundle != nil {
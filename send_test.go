@@ -0,0 +1,196 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/util/keys"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+func TestPreSendHookAbort(t *testing.T) {
+	hookErr := errors.New("blocked")
+	var seenID types.MessageID
+	cli := &Client{Log: waLog.Noop, PreSendHook: func(ctx context.Context, to types.JID, msg *waProto.Message) (*waProto.Message, error) {
+		seenID = PreSendMessageID(ctx)
+		return nil, hookErr
+	}}
+	_, err := cli.SendMessage(types.NewJID("1", types.DefaultUserServer), "TESTID", &waProto.Message{Conversation: proto.String("hi")})
+	if !errors.Is(err, hookErr) {
+		t.Errorf("Expected hook error, got %v", err)
+	}
+	if seenID != "TESTID" {
+		t.Errorf("Expected hook to see message ID TESTID, got %q", seenID)
+	}
+}
+
+func TestFitMessageInFrame(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 512, 512))
+	for y := 0; y < 512; y++ {
+		for x := 0; x < 512; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x * y), G: uint8(x ^ y), B: uint8(x + y*7), A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 100}); err != nil {
+		t.Fatalf("Failed to encode test image: %v", err)
+	}
+	thumbnail := buf.Bytes()
+	msg := &waProto.Message{ImageMessage: &waProto.ImageMessage{
+		Caption:       proto.String("hello"),
+		JpegThumbnail: thumbnail,
+		ContextInfo: &waProto.ContextInfo{QuotedMessage: &waProto.Message{
+			DocumentMessage: &waProto.DocumentMessage{JpegThumbnail: thumbnail},
+		}},
+	}}
+	size := proto.Size(msg)
+
+	if _, shrunk := fitMessageInFrame(msg, size, 1); shrunk {
+		t.Errorf("Message of %d bytes was shrunk even though it fits in a frame", size)
+	}
+	copies := 16 * 1024 * 1024 / size
+	fitted, shrunk := fitMessageInFrame(msg, size, copies)
+	if !shrunk {
+		t.Fatalf("Message of %d bytes wasn't shrunk for %d copies", size, copies)
+	}
+	if newSize := proto.Size(fitted); newSize*copies > 16*1024*1024-messageNodeOverhead {
+		t.Errorf("Shrunk message is still too large (%d bytes)", newSize)
+	}
+	if len(fitted.GetImageMessage().GetJpegThumbnail()) == 0 || len(fitted.GetImageMessage().GetContextInfo().GetQuotedMessage().GetDocumentMessage().GetJpegThumbnail()) == 0 {
+		t.Errorf("Expected thumbnails to be shrunk rather than dropped")
+	}
+	if !bytes.Equal(msg.GetImageMessage().GetJpegThumbnail(), thumbnail) {
+		t.Errorf("Original message was modified")
+	}
+}
+
+type fakeSessionStore map[string][]byte
+
+func (fss fakeSessionStore) GetSession(address string) ([]byte, error) { return fss[address], nil }
+func (fss fakeSessionStore) HasSession(address string) (bool, error) {
+	_, ok := fss[address]
+	return ok, nil
+}
+func (fss fakeSessionStore) PutSession(address string, session []byte) error {
+	fss[address] = session
+	return nil
+}
+func (fss fakeSessionStore) DeleteSession(address string) error {
+	delete(fss, address)
+	return nil
+}
+
+// respondToIQ waits for the client to send an info query and responds to it with the given content.
+func respondToIQ(t *testing.T, cli *Client, content []waBinary.Node) {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		cli.responseWaitersLock.Lock()
+		var id string
+		for waiterID := range cli.responseWaiters {
+			id = waiterID
+		}
+		cli.responseWaitersLock.Unlock()
+		if len(id) > 0 {
+			cli.receiveResponse(&waBinary.Node{
+				Tag:     "iq",
+				Attrs:   waBinary.Attrs{"id": id, "type": "result", "from": types.ServerJID},
+				Content: content,
+			})
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Error("Timed out waiting for info query")
+}
+
+func TestSendEstablishesSessions(t *testing.T) {
+	url, results := fakeNoiseServer(t)
+	ownDevice := types.NewADJID("1111111111", 0, 2)
+	sessions := fakeSessionStore{}
+	cli := NewClient(&store.Device{
+		ID:             &ownDevice,
+		NoiseKey:       keys.NewKeyPair(),
+		IdentityKey:    keys.NewKeyPair(),
+		RegistrationID: 1234,
+		Account:        &waProto.ADVSignedDeviceIdentity{},
+		Sessions:       sessions,
+		Identities:     fakeIdentityStore{},
+	}, waLog.Noop)
+	recipient := types.NewJID("2222222222", types.DefaultUserServer)
+	withKeys, withoutKeys := types.NewADJID(recipient.User, 0, 0), types.NewADJID(recipient.User, 0, 1)
+	cli.deviceCache.Put(recipient, &deviceCacheEntry{devices: []types.JID{withKeys, withoutKeys}, fetchedAt: time.Now()})
+	cli.deviceCache.Put(ownDevice.ToNonAD(), &deviceCacheEntry{devices: []types.JID{ownDevice}, fetchedAt: time.Now()})
+
+	var registrationID [4]byte
+	binary.BigEndian.PutUint32(registrationID[:], 5678)
+	recipientIdentity := keys.NewKeyPair()
+	fs := connectToFakeServer(t, cli, url)
+	go respondToIQ(t, cli, []waBinary.Node{{Tag: "list", Content: []waBinary.Node{{
+		Tag:   "user",
+		Attrs: waBinary.Attrs{"jid": withKeys},
+		Content: []waBinary.Node{
+			{Tag: "registration", Content: registrationID[:]},
+			{Tag: "identity", Content: recipientIdentity.Pub[:]},
+			preKeyToNode(keys.NewPreKey(1)),
+			preKeyToNode(recipientIdentity.CreateSignedPreKey(1)),
+		},
+	}, {
+		Tag:     "user",
+		Attrs:   waBinary.Attrs{"jid": withoutKeys},
+		Content: []waBinary.Node{{Tag: "error", Attrs: waBinary.Attrs{"code": "406", "text": "not-acceptable"}}},
+	}}}})
+	resp, err := cli.SendMessage(recipient, "", &waProto.Message{Conversation: proto.String("hi")})
+	if err != nil {
+		t.Fatalf("Expected message to be sent to the device with prekeys, got %v", err)
+	} else if _, ok := resp.MissingDevices[withoutKeys]; !ok || len(resp.MissingDevices) != 1 {
+		t.Errorf("Unexpected missing devices %v", resp.MissingDevices)
+	}
+	if _, ok := sessions[withKeys.SignalAddress().String()]; !ok {
+		t.Error("Expected session to be established with the device that had prekeys")
+	}
+
+	// Now that the session exists, sending to the first device must not fetch prekeys again.
+	cli.deviceCache.Put(recipient, &deviceCacheEntry{devices: []types.JID{withKeys}, fetchedAt: time.Now()})
+	resp, err = cli.SendMessage(recipient, "", &waProto.Message{Conversation: proto.String("hi again")})
+	if err != nil || resp.MissingDevices != nil {
+		t.Errorf("Unexpected error sending with existing session: %v (missing devices %v)", err, resp.MissingDevices)
+	}
+
+	// If none of the devices have prekeys, nothing is sent.
+	cli.deviceCache.Put(recipient, &deviceCacheEntry{devices: []types.JID{withoutKeys}, fetchedAt: time.Now()})
+	go respondToIQ(t, cli, []waBinary.Node{{Tag: "list", Content: []waBinary.Node{{
+		Tag:     "user",
+		Attrs:   waBinary.Attrs{"jid": withoutKeys},
+		Content: []waBinary.Node{{Tag: "error", Attrs: waBinary.Attrs{"code": "406", "text": "not-acceptable"}}},
+	}}}})
+	_, err = cli.SendMessage(recipient, "", &waProto.Message{Conversation: proto.String("hi")})
+	var mpe *MissingPreKeysError
+	if !errors.As(err, &mpe) {
+		t.Errorf("Expected MissingPreKeysError when no device has prekeys, got %v", err)
+	} else if _, ok := mpe.Devices[withoutKeys]; !ok {
+		t.Errorf("Unexpected missing prekeys error %+v", mpe)
+	}
+	fs.Close(0)
+	if res := <-results; res.frames != 4 {
+		t.Errorf("Expected two prekey requests and two messages to be sent, got %d frames", res.frames)
+	}
+}
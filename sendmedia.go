@@ -0,0 +1,507 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// DocumentOption is an optional parameter for SendDocument.
+type DocumentOption func(msg *waProto.DocumentMessage)
+
+// WithDocumentPageCount sets the number of pages in the document.
+func WithDocumentPageCount(pageCount uint32) DocumentOption {
+	return func(msg *waProto.DocumentMessage) {
+		msg.PageCount = proto.Uint32(pageCount)
+	}
+}
+
+// WithDocumentThumbnail sets the JPEG thumbnail of the document, e.g. the first page of a PDF.
+func WithDocumentThumbnail(jpeg []byte) DocumentOption {
+	return func(msg *waProto.DocumentMessage) {
+		msg.JpegThumbnail = jpeg
+	}
+}
+
+// WithDocumentTitle sets the title of the document. By default, the title is the file name.
+func WithDocumentTitle(title string) DocumentOption {
+	return func(msg *waProto.DocumentMessage) {
+		msg.Title = proto.String(title)
+	}
+}
+
+// detectMimetype guesses the mime type of a file based on the file name, falling back to sniffing the content.
+func detectMimetype(data []byte, filename string) string {
+	if ext := filepath.Ext(filename); len(ext) > 0 {
+		if mimetype := mime.TypeByExtension(ext); len(mimetype) > 0 {
+			return mimetype
+		}
+	}
+	return http.DetectContentType(data)
+}
+
+// BuildDocumentMessage uploads the given file and builds a DocumentMessage that can be sent with SendMessage.
+//
+// If mimetype is empty, it will be detected based on the file name and content.
+func (cli *Client) BuildDocumentMessage(data []byte, filename, mimetype string, opts ...DocumentOption) (*waProto.Message, error) {
+	if len(mimetype) == 0 {
+		mimetype = detectMimetype(data, filename)
+	}
+	uploaded, err := cli.Upload(context.Background(), data, MediaDocument)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload document: %w", err)
+	}
+	doc := &waProto.DocumentMessage{
+		Url:               proto.String(uploaded.URL),
+		DirectPath:        proto.String(uploaded.DirectPath),
+		MediaKey:          uploaded.MediaKey,
+		MediaKeyTimestamp: proto.Int64(cli.now().Unix()),
+		FileEncSha256:     uploaded.FileEncSHA256,
+		FileSha256:        uploaded.FileSHA256,
+		FileLength:        proto.Uint64(uint64(len(data))),
+		Mimetype:          proto.String(mimetype),
+		FileName:          proto.String(filename),
+		Title:             proto.String(filename),
+	}
+	for _, opt := range opts {
+		opt(doc)
+	}
+	return &waProto.Message{DocumentMessage: doc}, nil
+}
+
+// SendDocument uploads the given file and sends it to the given chat as a document message.
+//
+// If mimetype is empty, it will be detected based on the file name and content.
+func (cli *Client) SendDocument(chat types.JID, data []byte, filename, mimetype string, opts ...DocumentOption) (SendResponse, error) {
+	msg, err := cli.BuildDocumentMessage(data, filename, mimetype, opts...)
+	if err != nil {
+		return SendResponse{}, err
+	}
+	return cli.SendMessage(chat, "", msg)
+}
+
+// AudioOption is an optional parameter for SendAudio.
+type AudioOption func(msg *waProto.AudioMessage)
+
+// WithAudioDuration sets the duration of the audio. This is required for the duration to show up correctly
+// in the official clients, unless the audio is Ogg Opus, in which case the duration is detected automatically.
+func WithAudioDuration(duration time.Duration) AudioOption {
+	return func(msg *waProto.AudioMessage) {
+		msg.Seconds = proto.Uint32(uint32(duration.Round(time.Second) / time.Second))
+	}
+}
+
+// WithAudioWaveform sets the waveform that is displayed for voice notes. See GenerateWaveform for creating one.
+func WithAudioWaveform(waveform []byte) AudioOption {
+	return func(msg *waProto.AudioMessage) {
+		msg.Waveform = waveform
+	}
+}
+
+// WithAudioMimetype overrides the mime type of the audio. By default, it's detected from the data.
+func WithAudioMimetype(mimetype string) AudioOption {
+	return func(msg *waProto.AudioMessage) {
+		msg.Mimetype = proto.String(mimetype)
+	}
+}
+
+// waveformLength is the number of values in a voice note waveform.
+const waveformLength = 64
+
+// GenerateWaveform computes a voice note waveform from the given PCM samples.
+//
+// The result contains 64 values between 0 and 100, which is the format that the official clients use.
+func GenerateWaveform(samples []int16) []byte {
+	waveform := make([]byte, waveformLength)
+	if len(samples) == 0 {
+		return waveform
+	}
+	averages := make([]float64, waveformLength)
+	var maxAverage float64
+	for i := range averages {
+		start := i * len(samples) / waveformLength
+		end := (i + 1) * len(samples) / waveformLength
+		if end <= start {
+			continue
+		}
+		var sum float64
+		for _, sample := range samples[start:end] {
+			if sample < 0 {
+				sum -= float64(sample)
+			} else {
+				sum += float64(sample)
+			}
+		}
+		averages[i] = sum / float64(end-start)
+		if averages[i] > maxAverage {
+			maxAverage = averages[i]
+		}
+	}
+	if maxAverage == 0 {
+		return waveform
+	}
+	for i, avg := range averages {
+		waveform[i] = byte(avg / maxAverage * 100)
+	}
+	return waveform
+}
+
+const opusSampleRate = 48000
+
+// getOggOpusDuration finds the duration of an Ogg Opus file based on the granule position of the last page.
+func getOggOpusDuration(data []byte) (time.Duration, bool) {
+	if !bytes.HasPrefix(data, []byte("OggS")) {
+		return 0, false
+	}
+	headIndex := bytes.Index(data, []byte("OpusHead"))
+	lastPage := bytes.LastIndex(data, []byte("OggS"))
+	if headIndex < 0 || lastPage < 0 || len(data) < headIndex+12 || len(data) < lastPage+14 {
+		return 0, false
+	}
+	preSkip := int64(binary.LittleEndian.Uint16(data[headIndex+10 : headIndex+12]))
+	granulePos := int64(binary.LittleEndian.Uint64(data[lastPage+6 : lastPage+14]))
+	samples := granulePos - preSkip
+	if samples <= 0 {
+		return 0, false
+	}
+	return time.Duration(samples) * time.Second / opusSampleRate, true
+}
+
+// BuildAudioMessage uploads the given audio and builds an AudioMessage that can be sent with SendMessage.
+//
+// If ptt is true, the audio will be sent as a voice note. Voice notes should be Ogg Opus files.
+func (cli *Client) BuildAudioMessage(data []byte, ptt bool, opts ...AudioOption) (*waProto.Message, error) {
+	uploaded, err := cli.Upload(context.Background(), data, MediaAudio)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload audio: %w", err)
+	}
+	audio := &waProto.AudioMessage{
+		Url:               proto.String(uploaded.URL),
+		DirectPath:        proto.String(uploaded.DirectPath),
+		MediaKey:          uploaded.MediaKey,
+		MediaKeyTimestamp: proto.Int64(cli.now().Unix()),
+		FileEncSha256:     uploaded.FileEncSHA256,
+		FileSha256:        uploaded.FileSHA256,
+		FileLength:        proto.Uint64(uint64(len(data))),
+		Ptt:               proto.Bool(ptt),
+	}
+	if duration, ok := getOggOpusDuration(data); ok {
+		audio.Mimetype = proto.String("audio/ogg; codecs=opus")
+		audio.Seconds = proto.Uint32(uint32(duration.Round(time.Second) / time.Second))
+	} else {
+		audio.Mimetype = proto.String(http.DetectContentType(data))
+	}
+	for _, opt := range opts {
+		opt(audio)
+	}
+	return &waProto.Message{AudioMessage: audio}, nil
+}
+
+// SendAudio uploads the given audio and sends it to the given chat.
+//
+// If ptt is true, the audio will be sent as a voice note. Voice notes should be Ogg Opus files
+// and should include a waveform (see WithAudioWaveform and GenerateWaveform).
+func (cli *Client) SendAudio(chat types.JID, data []byte, ptt bool, opts ...AudioOption) (SendResponse, error) {
+	msg, err := cli.BuildAudioMessage(data, ptt, opts...)
+	if err != nil {
+		return SendResponse{}, err
+	}
+	return cli.SendMessage(chat, "", msg)
+}
+
+// Size limits for stickers that the official clients enforce.
+const (
+	MaxStickerSize         = 100 * 1024
+	MaxAnimatedStickerSize = 500 * 1024
+)
+
+type webpInfo struct {
+	width, height uint32
+	animated      bool
+}
+
+// parseWebPHeader reads the dimensions and animation flag from a WebP file.
+func parseWebPHeader(data []byte) (info webpInfo, err error) {
+	if len(data) < 12 || !bytes.Equal(data[0:4], []byte("RIFF")) || !bytes.Equal(data[8:12], []byte("WEBP")) {
+		return info, ErrStickerNotWebP
+	}
+	// The first chunk defines the format: VP8X for extended (possibly animated), VP8 for lossy and VP8L for lossless.
+	if len(data) < 30 {
+		return info, ErrStickerBadHeader
+	}
+	chunk := data[12:16]
+	payload := data[20:]
+	switch string(chunk) {
+	case "VP8X":
+		info.animated = payload[0]&0x02 != 0
+		info.width = 1 + (uint32(payload[4]) | uint32(payload[5])<<8 | uint32(payload[6])<<16)
+		info.height = 1 + (uint32(payload[7]) | uint32(payload[8])<<8 | uint32(payload[9])<<16)
+	case "VP8 ":
+		if payload[3] != 0x9d || payload[4] != 0x01 || payload[5] != 0x2a {
+			return info, ErrStickerBadHeader
+		}
+		info.width = uint32(binary.LittleEndian.Uint16(payload[6:8]) & 0x3fff)
+		info.height = uint32(binary.LittleEndian.Uint16(payload[8:10]) & 0x3fff)
+	case "VP8L":
+		if payload[0] != 0x2f {
+			return info, ErrStickerBadHeader
+		}
+		bits := binary.LittleEndian.Uint32(payload[1:5])
+		info.width = 1 + bits&0x3fff
+		info.height = 1 + (bits>>14)&0x3fff
+	default:
+		return info, ErrStickerBadHeader
+	}
+	return info, nil
+}
+
+// BuildStickerMessage uploads the given WebP sticker and builds a StickerMessage that can be sent with SendMessage.
+//
+// The dimensions and animation flag are read from the WebP header.
+func (cli *Client) BuildStickerMessage(webpData []byte) (*waProto.Message, error) {
+	info, err := parseWebPHeader(webpData)
+	if err != nil {
+		return nil, err
+	}
+	maxSize := MaxStickerSize
+	if info.animated {
+		maxSize = MaxAnimatedStickerSize
+	}
+	if len(webpData) > maxSize {
+		return nil, fmt.Errorf("%w (%d bytes, max %d bytes)", ErrStickerTooLarge, len(webpData), maxSize)
+	}
+	uploaded, err := cli.Upload(context.Background(), webpData, MediaImage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload sticker: %w", err)
+	}
+	return &waProto.Message{StickerMessage: &waProto.StickerMessage{
+		Url:               proto.String(uploaded.URL),
+		DirectPath:        proto.String(uploaded.DirectPath),
+		MediaKey:          uploaded.MediaKey,
+		MediaKeyTimestamp: proto.Int64(cli.now().Unix()),
+		FileEncSha256:     uploaded.FileEncSHA256,
+		FileSha256:        uploaded.FileSHA256,
+		FileLength:        proto.Uint64(uint64(len(webpData))),
+		Mimetype:          proto.String("image/webp"),
+		Width:             proto.Uint32(info.width),
+		Height:            proto.Uint32(info.height),
+		IsAnimated:        proto.Bool(info.animated),
+	}}, nil
+}
+
+// SendSticker uploads the given WebP sticker and sends it to the given chat.
+//
+// Static stickers can be at most 100 KiB and animated stickers 500 KiB.
+func (cli *Client) SendSticker(chat types.JID, webpData []byte) (SendResponse, error) {
+	msg, err := cli.BuildStickerMessage(webpData)
+	if err != nil {
+		return SendResponse{}, err
+	}
+	return cli.SendMessage(chat, "", msg)
+}
+
+// VideoInfo contains metadata about a video, as returned by Client.VideoThumbnailer.
+type VideoInfo struct {
+	Width     uint32
+	Height    uint32
+	Duration  time.Duration
+	Thumbnail []byte // A JPEG thumbnail of a frame in the video
+}
+
+// VideoThumbnailer is a function that extracts metadata and a thumbnail from a video.
+// This is not implemented in whatsmeow itself to avoid depending on ffmpeg or similar tools.
+type VideoThumbnailer func(data []byte) (VideoInfo, error)
+
+const thumbnailMaxSize = 72
+
+// generateImageThumbnail decodes the given image and creates a small JPEG thumbnail out of it.
+func generateImageThumbnail(data []byte) (thumbnail []byte, width, height uint32, err error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to decode image: %w", err)
+	}
+	bounds := img.Bounds()
+	width, height = uint32(bounds.Dx()), uint32(bounds.Dy())
+	thumbnail, err = encodeThumbnail(img, 75)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return thumbnail, width, height, nil
+}
+
+// encodeThumbnail scales the given image down to thumbnailMaxSize and encodes it as JPEG with the given quality.
+func encodeThumbnail(img image.Image, quality int) ([]byte, error) {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	thumbWidth, thumbHeight := thumbnailMaxSize, thumbnailMaxSize
+	if width > height {
+		thumbHeight = height * thumbnailMaxSize / width
+	} else {
+		thumbWidth = width * thumbnailMaxSize / height
+	}
+	if thumbWidth < 1 {
+		thumbWidth = 1
+	}
+	if thumbHeight < 1 {
+		thumbHeight = 1
+	}
+	thumb := image.NewRGBA(image.Rect(0, 0, thumbWidth, thumbHeight))
+	for y := 0; y < thumbHeight; y++ {
+		for x := 0; x < thumbWidth; x++ {
+			srcX := bounds.Min.X + x*bounds.Dx()/thumbWidth
+			srcY := bounds.Min.Y + y*bounds.Dy()/thumbHeight
+			thumb.Set(x, y, img.At(srcX, srcY))
+		}
+	}
+	var buf bytes.Buffer
+	err := jpeg.Encode(&buf, thumb, &jpeg.Options{Quality: quality})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// shrinkThumbnail re-encodes the given thumbnail with decreasing quality until it's at most maxSize bytes.
+// If the thumbnail can't be decoded or doesn't get small enough, nil is returned, i.e. the thumbnail is dropped.
+func shrinkThumbnail(thumbnail []byte, maxSize int) []byte {
+	if len(thumbnail) <= maxSize {
+		return thumbnail
+	}
+	img, _, err := image.Decode(bytes.NewReader(thumbnail))
+	if err != nil {
+		return nil
+	}
+	for _, quality := range []int{75, 50, 25} {
+		shrunk, err := encodeThumbnail(img, quality)
+		if err == nil && len(shrunk) <= maxSize {
+			return shrunk
+		}
+	}
+	return nil
+}
+
+// BuildImageMessage uploads the given image and builds an ImageMessage that can be sent with SendMessage.
+//
+// The dimensions and a thumbnail are generated automatically. Only JPEG, PNG and GIF images are supported.
+func (cli *Client) BuildImageMessage(data []byte, caption string) (*waProto.Message, error) {
+	thumbnail, width, height, err := generateImageThumbnail(data)
+	if err != nil {
+		return nil, err
+	}
+	uploaded, err := cli.Upload(context.Background(), data, MediaImage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload image: %w", err)
+	}
+	img := &waProto.ImageMessage{
+		Url:               proto.String(uploaded.URL),
+		DirectPath:        proto.String(uploaded.DirectPath),
+		MediaKey:          uploaded.MediaKey,
+		MediaKeyTimestamp: proto.Int64(cli.now().Unix()),
+		FileEncSha256:     uploaded.FileEncSHA256,
+		FileSha256:        uploaded.FileSHA256,
+		FileLength:        proto.Uint64(uint64(len(data))),
+		Mimetype:          proto.String(http.DetectContentType(data)),
+		Width:             proto.Uint32(width),
+		Height:            proto.Uint32(height),
+		JpegThumbnail:     thumbnail,
+	}
+	if len(caption) > 0 {
+		img.Caption = proto.String(caption)
+	}
+	return &waProto.Message{ImageMessage: img}, nil
+}
+
+// BuildVideoMessage uploads the given video and builds a VideoMessage that can be sent with SendMessage.
+//
+// If Client.VideoThumbnailer is set, it will be used to fill the dimensions, duration and thumbnail.
+func (cli *Client) BuildVideoMessage(data []byte, caption string) (*waProto.Message, error) {
+	var info VideoInfo
+	if cli.VideoThumbnailer != nil {
+		var err error
+		info, err = cli.VideoThumbnailer(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate video thumbnail: %w", err)
+		}
+	}
+	uploaded, err := cli.Upload(context.Background(), data, MediaVideo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload video: %w", err)
+	}
+	video := &waProto.VideoMessage{
+		Url:               proto.String(uploaded.URL),
+		DirectPath:        proto.String(uploaded.DirectPath),
+		MediaKey:          uploaded.MediaKey,
+		MediaKeyTimestamp: proto.Int64(cli.now().Unix()),
+		FileEncSha256:     uploaded.FileEncSHA256,
+		FileSha256:        uploaded.FileSHA256,
+		FileLength:        proto.Uint64(uint64(len(data))),
+		Mimetype:          proto.String(http.DetectContentType(data)),
+		JpegThumbnail:     info.Thumbnail,
+	}
+	if info.Width > 0 && info.Height > 0 {
+		video.Width = proto.Uint32(info.Width)
+		video.Height = proto.Uint32(info.Height)
+	}
+	if info.Duration > 0 {
+		video.Seconds = proto.Uint32(uint32(info.Duration.Round(time.Second) / time.Second))
+	}
+	if len(caption) > 0 {
+		video.Caption = proto.String(caption)
+	}
+	return &waProto.Message{VideoMessage: video}, nil
+}
+
+// SendMedia detects the type of the given file and sends it as an image, video, audio or document message.
+//
+// Captions are not supported for audio messages, so the caption is ignored for them.
+// Documents are sent with a generic file name, use SendDocument to specify the name.
+func (cli *Client) SendMedia(chat types.JID, data []byte, caption string) (SendResponse, error) {
+	mimetype := http.DetectContentType(data)
+	baseType := strings.SplitN(mimetype, ";", 2)[0]
+	var msg *waProto.Message
+	var err error
+	switch {
+	case baseType == "image/jpeg", baseType == "image/png":
+		msg, err = cli.BuildImageMessage(data, caption)
+	case baseType == "video/mp4":
+		msg, err = cli.BuildVideoMessage(data, caption)
+	case strings.HasPrefix(baseType, "audio/"), baseType == "application/ogg":
+		msg, err = cli.BuildAudioMessage(data, false)
+	case strings.HasPrefix(baseType, "application/"), strings.HasPrefix(baseType, "text/"):
+		filename := "file"
+		if exts, _ := mime.ExtensionsByType(baseType); len(exts) > 0 {
+			filename += exts[0]
+		}
+		msg, err = cli.BuildDocumentMessage(data, filename, baseType)
+		if err == nil && len(caption) > 0 {
+			msg.DocumentMessage.Title = proto.String(caption)
+		}
+	default:
+		return SendResponse{}, fmt.Errorf("%w %s", ErrUnsupportedMediaType, baseType)
+	}
+	if err != nil {
+		return SendResponse{}, err
+	}
+	return cli.SendMessage(chat, "", msg)
+}
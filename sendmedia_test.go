@@ -0,0 +1,67 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+func TestDocumentRoundTrip(t *testing.T) {
+	host := newFakeMediaServer(t)
+	ownID := types.NewADJID("1111111111", 0, 2)
+	cli := NewClient(&store.Device{ID: &ownID}, waLog.Noop)
+	setTestMediaHosts(cli, host)
+	evts := make(chan *events.Message, 1)
+	cli.AddEventHandler(func(evt interface{}) {
+		if msg, ok := evt.(*events.Message); ok {
+			evts <- msg
+		}
+	})
+
+	data := append([]byte("%PDF-1.4\n"), bytes.Repeat([]byte("page content\n"), 200)...)
+	msg, err := cli.BuildDocumentMessage(data, "Quarterly report (final).pdf", "", WithDocumentPageCount(3))
+	if err != nil {
+		t.Fatalf("Failed to build document message: %v", err)
+	}
+	// Go through the same protobuf encoding as sending and receiving the message would
+	plaintext, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatalf("Failed to marshal message: %v", err)
+	}
+	var received waProto.Message
+	if err = proto.Unmarshal(plaintext, &received); err != nil {
+		t.Fatalf("Failed to unmarshal message: %v", err)
+	}
+	sender := types.NewJID("2222222222", types.DefaultUserServer)
+	cli.handleDecryptedMessage(&types.MessageInfo{
+		MessageSource: types.MessageSource{Chat: sender, Sender: sender},
+		ID:            "DOCUMENT",
+		Timestamp:     time.Now(),
+	}, &received)
+	evt := <-evts
+
+	doc := evt.Message.GetDocumentMessage()
+	if doc.GetFileName() != "Quarterly report (final).pdf" || doc.GetMimetype() != "application/pdf" || doc.GetPageCount() != 3 {
+		t.Errorf("Unexpected document metadata after round trip: %q %q %d", doc.GetFileName(), doc.GetMimetype(), doc.GetPageCount())
+	}
+	downloaded, err := cli.DownloadAny(evt.Message)
+	if err != nil {
+		t.Fatalf("Failed to download document: %v", err)
+	} else if !bytes.Equal(downloaded, data) {
+		t.Error("Downloaded document doesn't match the uploaded file")
+	}
+}
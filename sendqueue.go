@@ -0,0 +1,50 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"sync"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+// sendSequencer makes sends to the same chat happen in the order they were started.
+//
+// Unlike keyedMutex, waiters are guaranteed to get their turn in FIFO order: each send waits for the one that was
+// enqueued right before it to finish. Queues are removed when the last send in them finishes.
+type sendSequencer struct {
+	tails map[types.JID]chan struct{}
+	lock  sync.Mutex
+}
+
+// enqueue reserves the next turn in the queue of the given chat. The returned wait function blocks until all sends
+// that were enqueued earlier have finished, and done must always be called afterwards to let the next send through.
+func (ss *sendSequencer) enqueue(chat types.JID) (wait, done func()) {
+	ss.lock.Lock()
+	if ss.tails == nil {
+		ss.tails = make(map[types.JID]chan struct{})
+	}
+	prev := ss.tails[chat]
+	own := make(chan struct{})
+	ss.tails[chat] = own
+	ss.lock.Unlock()
+
+	wait = func() {
+		if prev != nil {
+			<-prev
+		}
+	}
+	done = func() {
+		ss.lock.Lock()
+		if ss.tails[chat] == own {
+			delete(ss.tails, chat)
+		}
+		ss.lock.Unlock()
+		close(own)
+	}
+	return
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+func TestSendMessageChatOrder(t *testing.T) {
+	chat := types.NewJID("1", types.DefaultUserServer)
+	release := make(chan struct{})
+	var sent []string
+	var sentLock sync.Mutex
+	cli := &Client{Log: waLog.Noop, PreSendHook: func(ctx context.Context, to types.JID, msg *waProto.Message) (*waProto.Message, error) {
+		if msg.GetConversation() == "0" {
+			<-release
+		}
+		sentLock.Lock()
+		sent = append(sent, msg.GetConversation())
+		sentLock.Unlock()
+		// Every send fails, which must not block the rest of the queue
+		return nil, errors.New("not connected")
+	}}
+	currentTail := func() chan struct{} {
+		cli.sendQueue.lock.Lock()
+		defer cli.sendQueue.lock.Unlock()
+		return cli.sendQueue.tails[chat]
+	}
+	var wg sync.WaitGroup
+	send := func(text string, extra ...SendRequestExtra) {
+		defer wg.Done()
+		_, _ = cli.SendMessage(chat, "", &waProto.Message{Conversation: proto.String(text)}, extra...)
+	}
+	for i := 0; i < 10; i++ {
+		prevTail := currentTail()
+		wg.Add(1)
+		go send(strconv.Itoa(i))
+		// Wait until the send has been enqueued, so the expected order is deterministic
+		for currentTail() == prevTail {
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	wg.Add(1)
+	send("unordered", SendRequestExtra{Unordered: true})
+	sentLock.Lock()
+	if len(sent) != 1 || sent[0] != "unordered" {
+		t.Errorf("Expected unordered message to skip the queue, got %v", sent)
+	}
+	sentLock.Unlock()
+
+	close(release)
+	wg.Wait()
+	expected := []string{"unordered", "0", "1", "2", "3", "4", "5", "6", "7", "8", "9"}
+	if strings.Join(sent, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected messages to be sent in order %v, got %v", expected, sent)
+	}
+	if currentTail() != nil {
+		t.Error("Expected the chat queue to be removed after all sends finished")
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"strings"
+	"time"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+const (
+	// DefaultBadMACRecoveryThreshold is the default value of Client.BadMACRecoveryThreshold.
+	DefaultBadMACRecoveryThreshold = 3
+	// DefaultMaxSessionRecoveries is the default value of Client.MaxSessionRecoveries.
+	DefaultMaxSessionRecoveries = 2
+	// DefaultSessionRecoveryWindow is the default value of Client.SessionRecoveryWindow.
+	DefaultSessionRecoveryWindow = 1 * time.Hour
+)
+
+type badMACState struct {
+	failures   int
+	recoveries []time.Time
+}
+
+// isBadMACError checks if the given error from decrypting a Signal message means that the session is corrupted.
+//
+// libsignal doesn't have sentinel errors, so this has to check the error message. "No valid sessions" is returned
+// instead of the bad MAC error when the session record had previous states and none of them could decrypt either.
+func isBadMACError(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "Bad Mac!") || strings.Contains(msg, "No valid sessions.")
+}
+
+// recordBadMAC counts a bad MAC error from the given device and returns true if the session should be reset.
+func (cli *Client) recordBadMAC(jid types.JID) bool {
+	if cli.BadMACRecoveryThreshold <= 0 {
+		return false
+	}
+	cli.badMACsLock.Lock()
+	defer cli.badMACsLock.Unlock()
+	state, ok := cli.badMACs[jid]
+	if !ok {
+		state = &badMACState{}
+		cli.badMACs[jid] = state
+	}
+	state.failures++
+	if state.failures < cli.BadMACRecoveryThreshold {
+		return false
+	}
+	now := cli.now()
+	recentRecoveries := state.recoveries[:0]
+	for _, ts := range state.recoveries {
+		if now.Sub(ts) < cli.SessionRecoveryWindow {
+			recentRecoveries = append(recentRecoveries, ts)
+		}
+	}
+	state.recoveries = recentRecoveries
+	if cli.MaxSessionRecoveries > 0 && len(state.recoveries) >= cli.MaxSessionRecoveries {
+		cli.Log.Warnf("Not resetting session with %s after %d bad MAC errors: already reset %d times in the past %v",
+			jid, state.failures, len(state.recoveries), cli.SessionRecoveryWindow)
+		return false
+	}
+	state.failures = 0
+	state.recoveries = append(state.recoveries, now)
+	return true
+}
+
+// resetBadMACs forgets previous bad MAC errors from the given device after a message was decrypted successfully.
+// Recent session recoveries are still remembered to prevent recovery loops.
+func (cli *Client) resetBadMACs(jid types.JID) {
+	cli.badMACsLock.Lock()
+	defer cli.badMACsLock.Unlock()
+	state, ok := cli.badMACs[jid]
+	if !ok {
+		return
+	}
+	state.failures = 0
+	if len(state.recoveries) == 0 || cli.now().Sub(state.recoveries[len(state.recoveries)-1]) >= cli.SessionRecoveryWindow {
+		delete(cli.badMACs, jid)
+	}
+}
+
+// recoverSession deletes the Signal session with the sender of the given message and asks them to resend it,
+// which makes them establish a new session using a fresh pre-key.
+func (cli *Client) recoverSession(info *types.MessageInfo, node *waBinary.Node) {
+	address := info.Sender.SignalAddress().String()
+	cli.Log.Warnf("Resetting Signal session with %s after repeated bad MAC errors", info.Sender)
+	unlock := cli.signalLocks.Lock(address)
+	err := cli.Store.Sessions.DeleteSession(address)
+	unlock()
+	if err != nil {
+		cli.Log.Errorf("Failed to delete corrupted session with %s: %v", info.Sender, err)
+		go cli.sendRetryReceipt(node, false)
+		return
+	}
+	go func() {
+		cli.sendRetryReceipt(node, true)
+		cli.dispatchEvent(&events.SessionRecovered{JID: info.Sender})
+	}()
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+func TestBadMACRecoveryLimit(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	cli := NewClient(&store.Device{}, waLog.Noop)
+	cli.nowFunc = func() time.Time { return now }
+	sender := types.NewADJID("1234567890", 0, 1)
+	badMACs := func(n int) (recoveries int) {
+		for i := 0; i < n; i++ {
+			if cli.recordBadMAC(sender) {
+				recoveries++
+			}
+		}
+		return
+	}
+
+	if !isBadMACError(errors.New("failed to decrypt normal message: Bad Mac!")) || isBadMACError(errors.New("Uninitialized session!")) {
+		t.Error("Unexpected bad MAC error detection")
+	}
+	if recoveries := badMACs(DefaultBadMACRecoveryThreshold - 1); recoveries != 0 {
+		t.Errorf("Expected no recovery below the threshold, got %d", recoveries)
+	}
+	cli.resetBadMACs(sender)
+	if recoveries := badMACs(DefaultBadMACRecoveryThreshold - 1); recoveries != 0 {
+		t.Error("Expected successful decryption to reset the bad MAC counter")
+	}
+	if recoveries := badMACs(1); recoveries != 1 {
+		t.Error("Expected recovery after reaching the threshold")
+	}
+	if recoveries := badMACs(DefaultBadMACRecoveryThreshold * 5); recoveries != DefaultMaxSessionRecoveries-1 {
+		t.Errorf("Expected recoveries to be limited to %d, got %d", DefaultMaxSessionRecoveries, recoveries+1)
+	}
+	cli.resetBadMACs(sender)
+	if _, ok := cli.badMACs[sender]; !ok {
+		t.Error("Expected recent recoveries to be remembered after successful decryption")
+	}
+
+	now = now.Add(DefaultSessionRecoveryWindow)
+	if recoveries := badMACs(DefaultBadMACRecoveryThreshold); recoveries != 1 {
+		t.Error("Expected recovery to be allowed again after the window")
+	}
+	now = now.Add(DefaultSessionRecoveryWindow)
+	cli.resetBadMACs(sender)
+	if _, ok := cli.badMACs[sender]; ok {
+		t.Error("Expected bad MAC state to be forgotten after the window")
+	}
+}
@@ -7,7 +7,10 @@
 // Package socket implements a subset of the Noise protocol framework on top of websockets as used by WhatsApp.
 package socket
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 const (
 	// Origin is the Origin header for all WhatsApp websocket connections
@@ -30,8 +33,12 @@ const (
 	FrameLengthSize = 3
 )
 
+// DefaultWriteTimeout is the default value for FrameSocket.WriteTimeout.
+const DefaultWriteTimeout = 20 * time.Second
+
 var (
 	ErrFrameTooLarge     = errors.New("frame too large")
 	ErrSocketClosed      = errors.New("frame socket is closed")
 	ErrSocketAlreadyOpen = errors.New("frame socket is already open")
+	ErrWriteTimeout      = errors.New("frame socket write timed out")
 )
@@ -8,8 +8,10 @@ package socket
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"sync"
 	"time"
@@ -25,12 +27,18 @@ type FrameSocket struct {
 	cancel func()
 	log    waLog.Logger
 	lock   sync.Mutex
+	// writeLock serializes SendFrame calls, as the websocket library only supports one concurrent writer.
+	writeLock sync.Mutex
 
 	OnFrame      func([]byte)
 	OnDisconnect func()
+	// WriteTimeout is the maximum time a single frame write may take. If a write times out, the socket is
+	// closed and OnDisconnect is called. Defaults to DefaultWriteTimeout; zero or negative disables the deadline.
 	WriteTimeout time.Duration
 
 	Header []byte
+	// URL is the websocket URL to dial. Defaults to the URL constant.
+	URL string
 
 	incomingLength int
 	receivedLength int
@@ -43,6 +51,9 @@ func NewFrameSocket(log waLog.Logger, header []byte) *FrameSocket {
 		conn:   nil,
 		log:    log,
 		Header: header,
+		URL:    URL,
+
+		WriteTimeout: DefaultWriteTimeout,
 	}
 }
 
@@ -55,10 +66,15 @@ func (fs *FrameSocket) Context() context.Context {
 }
 
 func (fs *FrameSocket) Close(code int) {
+	fs.close(nil, code, code > 0)
+}
+
+// close closes the websocket. If onlyConn is set, the socket is only closed if it's still using that connection.
+func (fs *FrameSocket) close(onlyConn *websocket.Conn, code int, notify bool) {
 	fs.lock.Lock()
 	defer fs.lock.Unlock()
 
-	if fs.conn == nil {
+	if fs.conn == nil || (onlyConn != nil && fs.conn != onlyConn) {
 		return
 	}
 
@@ -80,7 +96,7 @@ func (fs *FrameSocket) Close(code int) {
 	fs.conn = nil
 	fs.ctx = nil
 	fs.cancel = nil
-	if code > 0 && fs.OnDisconnect != nil {
+	if notify && fs.OnDisconnect != nil {
 		go fs.OnDisconnect()
 	}
 }
@@ -96,8 +112,8 @@ func (fs *FrameSocket) Connect() error {
 	dialer := websocket.Dialer{}
 
 	headers := http.Header{"Origin": []string{Origin}}
-	fs.log.Debugf("Dialing %s", URL)
-	conn, _, err := dialer.Dial(URL, headers)
+	fs.log.Debugf("Dialing %s", fs.URL)
+	conn, _, err := dialer.Dial(fs.URL, headers)
 	if err != nil {
 		cancel()
 		return fmt.Errorf("couldn't dial whatsapp web websocket: %w", err)
@@ -119,15 +135,20 @@ func (fs *FrameSocket) Connect() error {
 }
 
 func (fs *FrameSocket) SendFrame(data []byte) error {
-	conn := fs.conn
-	if conn == nil {
-		return ErrSocketClosed
-	}
 	dataLength := len(data)
 	if dataLength >= FrameMaxSize {
 		return fmt.Errorf("%w (got %d bytes, max %d bytes)", ErrFrameTooLarge, len(data), FrameMaxSize)
 	}
 
+	fs.writeLock.Lock()
+	defer fs.writeLock.Unlock()
+	fs.lock.Lock()
+	conn := fs.conn
+	fs.lock.Unlock()
+	if conn == nil {
+		return ErrSocketClosed
+	}
+
 	headerLength := len(fs.Header)
 	// Whole frame is header + 3 bytes for length + data
 	wholeFrame := make([]byte, headerLength+FrameLengthSize+dataLength)
@@ -153,7 +174,18 @@ func (fs *FrameSocket) SendFrame(data []byte) error {
 			fs.log.Warnf("Failed to set write deadline: %v", err)
 		}
 	}
-	return conn.WriteMessage(websocket.BinaryMessage, wholeFrame)
+	err := conn.WriteMessage(websocket.BinaryMessage, wholeFrame)
+	if err != nil {
+		// The websocket can't be written to after a failed write, so tear it down and let the
+		// disconnect handler reconnect instead of leaving other senders to fail one by one.
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			err = fmt.Errorf("%w after %s: %v", ErrWriteTimeout, fs.WriteTimeout, err)
+		}
+		fs.log.Warnf("Failed to write frame, closing socket: %v", err)
+		go fs.close(conn, 0, true)
+	}
+	return err
 }
 
 func (fs *FrameSocket) SetOnFrame(onFrame func([]byte)) {
@@ -196,7 +228,7 @@ func (fs *FrameSocket) frameComplete() {
 	}
 }
 
-func (fs *FrameSocket) processData(msg []byte) {
+func (fs *FrameSocket) processData(msg []byte) error {
 	for len(msg) > 0 {
 		// This probably doesn't happen a lot (if at all), so the code is unoptimized
 		if fs.partialHeader != nil {
@@ -206,6 +238,9 @@ func (fs *FrameSocket) processData(msg []byte) {
 		if fs.incoming == nil {
 			if len(msg) >= FrameLengthSize {
 				length := (int(msg[0]) << 16) + (int(msg[1]) << 8) + int(msg[2])
+				if length >= FrameMaxSize {
+					return fmt.Errorf("%w (got length prefix of %d bytes, max %d bytes)", ErrFrameTooLarge, length, FrameMaxSize)
+				}
 				fs.incomingLength = length
 				fs.receivedLength = len(msg)
 				msg = msg[FrameLengthSize:]
@@ -235,17 +270,19 @@ func (fs *FrameSocket) processData(msg []byte) {
 			}
 		}
 	}
+	return nil
 }
 
 func (fs *FrameSocket) readPump(conn *websocket.Conn, ctx context.Context) {
 	var readErr error
 	var msgType int
 	var reader io.Reader
+	var closeCode int
 
 	fs.log.Debugf("Frame websocket read pump starting %p", fs)
 	defer func() {
 		fs.log.Debugf("Frame websocket read pump exiting %p", fs)
-		go fs.Close(0)
+		go fs.close(nil, closeCode, closeCode > 0)
 	}()
 	for {
 		readerFound := make(chan struct{})
@@ -267,7 +304,12 @@ func (fs *FrameSocket) readPump(conn *websocket.Conn, ctx context.Context) {
 				fs.log.Errorf("Error reading message from websocket reader: %v", err)
 				continue
 			}
-			fs.processData(msg)
+			err = fs.processData(msg)
+			if err != nil {
+				fs.log.Errorf("Closing websocket after receiving invalid data: %v", err)
+				closeCode = websocket.CloseMessageTooBig
+				return
+			}
 		case <-ctx.Done():
 			return
 		}
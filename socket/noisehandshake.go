@@ -16,6 +16,8 @@ import (
 
 	"golang.org/x/crypto/curve25519"
 	"golang.org/x/crypto/hkdf"
+
+	"go.mau.fi/whatsmeow/util/keys"
 )
 
 type NoiseHandshake struct {
@@ -107,6 +109,17 @@ func (nh *NoiseHandshake) MixSharedSecretIntoKey(priv, pub [32]byte) error {
 	return nh.MixIntoKey(secret)
 }
 
+// MixSharedSecret calculates the shared secret between the given key and public key and mixes it into the key.
+//
+// Unlike MixSharedSecretIntoKey, this doesn't need the private key to be available in memory.
+func (nh *NoiseHandshake) MixSharedSecret(key keys.DHKey, pub [32]byte) error {
+	secret, err := key.SharedSecret(pub)
+	if err != nil {
+		return fmt.Errorf("failed to calculate shared secret: %w", err)
+	}
+	return nh.MixIntoKey(secret)
+}
+
 func (nh *NoiseHandshake) MixIntoKey(data []byte) error {
 	nh.counter = 0
 	write, read, err := nh.extractAndExpand(nh.salt, data)
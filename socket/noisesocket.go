@@ -10,11 +10,35 @@ import (
 	"context"
 	"crypto/cipher"
 	"encoding/binary"
+	"fmt"
 	"sync"
 	"sync/atomic"
 )
 
+// NetworkStats contains counters for the amount of data sent and received through a socket.
+//
+// Byte counts are the sizes of the encrypted frames, excluding websocket and frame length overhead.
+type NetworkStats struct {
+	BytesRead     uint64
+	BytesWritten  uint64
+	FramesRead    uint64
+	FramesWritten uint64
+}
+
+// Add returns the sum of the two sets of counters.
+func (stats NetworkStats) Add(other NetworkStats) NetworkStats {
+	return NetworkStats{
+		BytesRead:     stats.BytesRead + other.BytesRead,
+		BytesWritten:  stats.BytesWritten + other.BytesWritten,
+		FramesRead:    stats.FramesRead + other.FramesRead,
+		FramesWritten: stats.FramesWritten + other.FramesWritten,
+	}
+}
+
 type NoiseSocket struct {
+	// The counters are 64-bit atomics, so they're at the start of the struct to ensure alignment on 32-bit platforms.
+	stats NetworkStats
+
 	fs           *FrameSocket
 	OnFrame      func([]byte)
 	writeKey     cipher.AEAD
@@ -49,16 +73,26 @@ func (ns *NoiseSocket) Close(code int) {
 }
 
 func (ns *NoiseSocket) SendFrame(plaintext []byte) error {
+	// Check the size before encrypting, as a frame that's rejected after incrementing the counter would desync it.
+	if size := len(plaintext) + ns.writeKey.Overhead(); size >= FrameMaxSize {
+		return fmt.Errorf("%w (got %d bytes, max %d bytes)", ErrFrameTooLarge, size, FrameMaxSize)
+	}
 	ns.writeLock.Lock()
 	ciphertext := ns.writeKey.Seal(nil, generateIV(ns.writeCounter), plaintext, nil)
 	ns.writeCounter++
 	err := ns.fs.SendFrame(ciphertext)
 	ns.writeLock.Unlock()
+	if err == nil {
+		atomic.AddUint64(&ns.stats.BytesWritten, uint64(len(ciphertext)))
+		atomic.AddUint64(&ns.stats.FramesWritten, 1)
+	}
 	return err
 }
 
 func (ns *NoiseSocket) receiveEncryptedFrame(ciphertext []byte) {
 	count := atomic.AddUint32(&ns.readCounter, 1) - 1
+	atomic.AddUint64(&ns.stats.BytesRead, uint64(len(ciphertext)))
+	atomic.AddUint64(&ns.stats.FramesRead, 1)
 	plaintext, err := ns.readKey.Open(nil, generateIV(count), ciphertext, nil)
 	if err != nil {
 		ns.fs.log.Warnf("Failed to decrypt frame: %v", err)
@@ -67,6 +101,16 @@ func (ns *NoiseSocket) receiveEncryptedFrame(ciphertext []byte) {
 	ns.OnFrame(plaintext)
 }
 
+// Stats returns the current values of the network counters of this socket.
+func (ns *NoiseSocket) Stats() NetworkStats {
+	return NetworkStats{
+		BytesRead:     atomic.LoadUint64(&ns.stats.BytesRead),
+		BytesWritten:  atomic.LoadUint64(&ns.stats.BytesWritten),
+		FramesRead:    atomic.LoadUint64(&ns.stats.FramesRead),
+		FramesWritten: atomic.LoadUint64(&ns.stats.FramesWritten),
+	}
+}
+
 func (ns *NoiseSocket) SetOnDisconnect(onDisconnect func(socket *NoiseSocket)) {
 	if onDisconnect == nil {
 		ns.fs.OnDisconnect = nil
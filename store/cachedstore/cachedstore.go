@@ -0,0 +1,195 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package cachedstore contains an in-memory write-through cache for the Signal session and identity stores.
+package cachedstore
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/util/lru"
+)
+
+// DefaultSize is the default number of sessions and identities to cache.
+const DefaultSize = 1000
+
+// Stats contains the hit and miss counters of a CachedStore.
+type Stats struct {
+	SessionHits    uint64
+	SessionMisses  uint64
+	IdentityHits   uint64
+	IdentityMisses uint64
+}
+
+// CachedStore wraps the session and identity stores of a single device with an LRU cache.
+//
+// All writes must go through the CachedStore, otherwise the cache may return stale data.
+// Because the cache is per device, a separate CachedStore must be created for each device.
+type CachedStore struct {
+	sessions   store.SessionStore
+	identities store.IdentityStore
+
+	// Cached sessions. A nil value means the database doesn't have a session for the address.
+	sessionCache *lru.Cache[string, []byte]
+	// Identity keys that the underlying store has confirmed to be trusted for each address.
+	identityCache *lru.Cache[string, [32]byte]
+	cacheLock     sync.Mutex
+
+	sessionHits    atomic.Uint64
+	sessionMisses  atomic.Uint64
+	identityHits   atomic.Uint64
+	identityMisses atomic.Uint64
+}
+
+var _ store.SessionStore = (*CachedStore)(nil)
+var _ store.IdentityStore = (*CachedStore)(nil)
+
+// New creates a cache wrapping the given stores. If size is not positive, DefaultSize is used.
+func New(sessions store.SessionStore, identities store.IdentityStore, size int) *CachedStore {
+	if size <= 0 {
+		size = DefaultSize
+	}
+	return &CachedStore{
+		sessions:      sessions,
+		identities:    identities,
+		sessionCache:  lru.New[string, []byte](size),
+		identityCache: lru.New[string, [32]byte](size),
+	}
+}
+
+// Wrap replaces the session and identity stores of the given device with a cache that wraps them.
+func Wrap(device *store.Device, size int) *CachedStore {
+	cache := New(device.Sessions, device.Identities, size)
+	device.Sessions = cache
+	device.Identities = cache
+	return cache
+}
+
+// Stats returns the current values of the hit and miss counters.
+func (cs *CachedStore) Stats() Stats {
+	return Stats{
+		SessionHits:    cs.sessionHits.Load(),
+		SessionMisses:  cs.sessionMisses.Load(),
+		IdentityHits:   cs.identityHits.Load(),
+		IdentityMisses: cs.identityMisses.Load(),
+	}
+}
+
+func (cs *CachedStore) getCachedSession(address string) ([]byte, bool) {
+	cs.cacheLock.Lock()
+	defer cs.cacheLock.Unlock()
+	return cs.sessionCache.Get(address)
+}
+
+// putCachedSession stores the session in the cache. If onlyIfMissing is true, an existing cached value is not replaced.
+func (cs *CachedStore) putCachedSession(address string, session []byte, onlyIfMissing bool) {
+	cs.cacheLock.Lock()
+	defer cs.cacheLock.Unlock()
+	if _, ok := cs.sessionCache.Get(address); !ok || !onlyIfMissing {
+		cs.sessionCache.Put(address, session)
+	}
+}
+
+// InvalidateSession removes the cached session of the given address.
+// This must be called if the session is deleted or modified without going through the cache.
+func (cs *CachedStore) InvalidateSession(address string) {
+	cs.cacheLock.Lock()
+	cs.sessionCache.Remove(address)
+	cs.cacheLock.Unlock()
+}
+
+func (cs *CachedStore) GetSession(address string) ([]byte, error) {
+	if session, ok := cs.getCachedSession(address); ok {
+		cs.sessionHits.Add(1)
+		return session, nil
+	}
+	cs.sessionMisses.Add(1)
+	session, err := cs.sessions.GetSession(address)
+	if err != nil {
+		return nil, err
+	}
+	// A concurrent PutSession may have cached a newer session while this one was being read.
+	cs.putCachedSession(address, session, true)
+	return session, nil
+}
+
+func (cs *CachedStore) HasSession(address string) (bool, error) {
+	if session, ok := cs.getCachedSession(address); ok {
+		cs.sessionHits.Add(1)
+		return session != nil, nil
+	}
+	cs.sessionMisses.Add(1)
+	return cs.sessions.HasSession(address)
+}
+
+func (cs *CachedStore) PutSession(address string, session []byte) error {
+	err := cs.sessions.PutSession(address, session)
+	if err != nil {
+		// The write may or may not have gone through, so don't trust the cached value anymore.
+		cs.InvalidateSession(address)
+		return err
+	}
+	cs.putCachedSession(address, session, false)
+	return nil
+}
+
+func (cs *CachedStore) DeleteSession(address string) error {
+	err := cs.sessions.DeleteSession(address)
+	if err != nil {
+		cs.InvalidateSession(address)
+		return err
+	}
+	cs.putCachedSession(address, nil, false)
+	return nil
+}
+
+func (cs *CachedStore) getCachedIdentity(address string) ([32]byte, bool) {
+	cs.cacheLock.Lock()
+	defer cs.cacheLock.Unlock()
+	return cs.identityCache.Get(address)
+}
+
+// putCachedIdentity stores the identity in the cache. If onlyIfMissing is true, an existing cached value is not replaced.
+func (cs *CachedStore) putCachedIdentity(address string, key [32]byte, onlyIfMissing bool) {
+	cs.cacheLock.Lock()
+	defer cs.cacheLock.Unlock()
+	if _, ok := cs.identityCache.Get(address); !ok || !onlyIfMissing {
+		cs.identityCache.Put(address, key)
+	}
+}
+
+// InvalidateIdentity removes the cached identity of the given address.
+// This must be called if the identity is deleted or modified without going through the cache.
+func (cs *CachedStore) InvalidateIdentity(address string) {
+	cs.cacheLock.Lock()
+	cs.identityCache.Remove(address)
+	cs.cacheLock.Unlock()
+}
+
+func (cs *CachedStore) PutIdentity(address string, key [32]byte) error {
+	err := cs.identities.PutIdentity(address, key)
+	if err != nil {
+		cs.InvalidateIdentity(address)
+		return err
+	}
+	cs.putCachedIdentity(address, key, false)
+	return nil
+}
+
+func (cs *CachedStore) IsTrustedIdentity(address string, key [32]byte) (bool, error) {
+	if trustedKey, ok := cs.getCachedIdentity(address); ok && trustedKey == key {
+		cs.identityHits.Add(1)
+		return true, nil
+	}
+	cs.identityMisses.Add(1)
+	trusted, err := cs.identities.IsTrustedIdentity(address, key)
+	if err == nil && trusted {
+		cs.putCachedIdentity(address, key, true)
+	}
+	return trusted, err
+}
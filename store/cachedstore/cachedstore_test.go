@@ -0,0 +1,92 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cachedstore
+
+import (
+	"testing"
+)
+
+type memoryStore struct {
+	sessions   map[string][]byte
+	identities map[string][32]byte
+	reads      int
+}
+
+func (ms *memoryStore) GetSession(address string) ([]byte, error) {
+	ms.reads++
+	return ms.sessions[address], nil
+}
+
+func (ms *memoryStore) HasSession(address string) (bool, error) {
+	ms.reads++
+	_, ok := ms.sessions[address]
+	return ok, nil
+}
+
+func (ms *memoryStore) PutSession(address string, session []byte) error {
+	ms.sessions[address] = session
+	return nil
+}
+
+func (ms *memoryStore) DeleteSession(address string) error {
+	delete(ms.sessions, address)
+	return nil
+}
+
+func (ms *memoryStore) PutIdentity(address string, key [32]byte) error {
+	ms.identities[address] = key
+	return nil
+}
+
+func (ms *memoryStore) IsTrustedIdentity(address string, key [32]byte) (bool, error) {
+	ms.reads++
+	existing, ok := ms.identities[address]
+	return !ok || existing == key, nil
+}
+
+func TestCachedStore(t *testing.T) {
+	backing := &memoryStore{sessions: make(map[string][]byte), identities: make(map[string][32]byte)}
+	cache := New(backing, backing, 2)
+
+	if has, _ := cache.HasSession("1:0"); has {
+		t.Error("Expected no session before storing one")
+	}
+	_ = cache.PutSession("1:0", []byte("session"))
+	if session, _ := cache.GetSession("1:0"); string(session) != "session" {
+		t.Errorf("Unexpected session %q", session)
+	}
+	if has, _ := cache.HasSession("1:0"); !has {
+		t.Error("Expected session to exist after storing it")
+	}
+	if backing.reads != 1 {
+		t.Errorf("Expected only the first HasSession to read the backing store, got %d reads", backing.reads)
+	}
+
+	// Fill the cache so that the first session is evicted.
+	_ = cache.PutSession("2:0", []byte("two"))
+	_ = cache.PutSession("3:0", []byte("three"))
+	if session, _ := cache.GetSession("1:0"); string(session) != "session" {
+		t.Errorf("Unexpected session %q after eviction", session)
+	}
+	if backing.reads != 2 {
+		t.Errorf("Expected evicted session to be read from the backing store, got %d reads", backing.reads)
+	}
+
+	key1, key2 := [32]byte{1}, [32]byte{2}
+	_ = cache.PutIdentity("1:0", key1)
+	if trusted, _ := cache.IsTrustedIdentity("1:0", key1); !trusted {
+		t.Error("Expected stored identity to be trusted")
+	}
+	if trusted, _ := cache.IsTrustedIdentity("1:0", key2); trusted {
+		t.Error("Expected different identity to not be trusted")
+	}
+
+	stats := cache.Stats()
+	if stats.SessionHits != 2 || stats.SessionMisses != 2 || stats.IdentityHits != 1 || stats.IdentityMisses != 1 {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+}
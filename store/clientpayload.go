@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 
 	"google.golang.org/protobuf/proto"
 
@@ -20,30 +21,65 @@ import (
 	waProto "go.mau.fi/whatsmeow/binary/proto"
 )
 
-// waVersion is the WhatsApp web client version
-var waVersion = []uint32{2, 2140, 7}
+// WAVersionContainer is a WhatsApp web client version number (primary, secondary, tertiary).
+type WAVersionContainer [3]uint32
 
-// waVersionHash is the md5 hash of a dot-separated waVersion
-var waVersionHash [16]byte
+// String returns the version number as a dot-separated string.
+func (vc WAVersionContainer) String() string {
+	parts := make([]string, len(vc))
+	for i, part := range vc {
+		parts[i] = strconv.Itoa(int(part))
+	}
+	return strings.Join(parts, ".")
+}
+
+// Hash returns the md5 hash of the String representation of this version.
+func (vc WAVersionContainer) Hash() [16]byte {
+	return md5.Sum([]byte(vc.String()))
+}
+
+// ProtoAppVersion returns the version number as an AppVersion protobuf struct.
+func (vc WAVersionContainer) ProtoAppVersion() *waProto.AppVersion {
+	return &waProto.AppVersion{
+		Primary:   &vc[0],
+		Secondary: &vc[1],
+		Tertiary:  &vc[2],
+	}
+}
+
+// waVersion is the WhatsApp web client version that is advertised to the server in the client payload.
+var waVersion = WAVersionContainer{2, 2140, 7}
 
-func init() {
-	waVersionParts := make([]string, len(waVersion))
-	for i, part := range waVersion {
-		waVersionParts[i] = strconv.Itoa(int(part))
+// clientPayloadLock protects waVersion as well as the OS info in BaseClientPayload and CompanionProps.
+var clientPayloadLock sync.RWMutex
+
+// GetWAVersion returns the WhatsApp web client version that is advertised to the server in the client payload.
+func GetWAVersion() WAVersionContainer {
+	clientPayloadLock.RLock()
+	defer clientPayloadLock.RUnlock()
+	return waVersion
+}
+
+// SetWAVersion changes the advertised WhatsApp web client version after checking that it looks valid.
+//
+// WhatsApp may refuse logins from outdated versions, in which case the version can be bumped with this function.
+// The new version is used by all client payloads created after this call, i.e. it applies on the next connection.
+// It's safe to call this while clients are connecting.
+func SetWAVersion(version WAVersionContainer) error {
+	if version[0] != 2 || version[1] == 0 {
+		return fmt.Errorf("implausible WhatsApp web version %s", version)
 	}
-	waVersionString := strings.Join(waVersionParts, ".")
-	waVersionHash = md5.Sum([]byte(waVersionString))
+	clientPayloadLock.Lock()
+	waVersion = version
+	clientPayloadLock.Unlock()
+	return nil
 }
 
 var BaseClientPayload = &waProto.ClientPayload{
 	UserAgent: &waProto.UserAgent{
-		Platform:       waProto.UserAgent_WEB.Enum(),
-		ReleaseChannel: waProto.UserAgent_RELEASE.Enum(),
-		AppVersion: &waProto.AppVersion{
-			Primary:   &waVersion[0],
-			Secondary: &waVersion[1],
-			Tertiary:  &waVersion[2],
-		},
+		Platform:                    waProto.UserAgent_WEB.Enum(),
+		ReleaseChannel:              waProto.UserAgent_RELEASE.Enum(),
+		AppVersion:                  waVersion.ProtoAppVersion(),
 		Mcc:                         proto.String("000"),
 		Mnc:                         proto.String("000"),
 		OsVersion:                   proto.String("0.1.0"),
@@ -71,22 +107,56 @@ var CompanionProps = &waProto.CompanionProps{
 	RequireFullSync: proto.Bool(false),
 }
 
-func SetOSInfo(name string, version [3]uint32) {
+// SetOSInfo changes the OS name and version that are shown in the linked devices list on the phone
+// after checking that they look valid.
+//
+// The version is also sent as the OS version in the user agent of the client payload.
+// Like SetWAVersion, it applies on the next connection and is safe to call while clients are connecting.
+func SetOSInfo(name string, version [3]uint32) error {
+	if len(strings.TrimSpace(name)) == 0 {
+		return fmt.Errorf("empty OS name")
+	} else if version == [3]uint32{} {
+		return fmt.Errorf("implausible OS version %s", WAVersionContainer(version))
+	}
+	clientPayloadLock.Lock()
+	defer clientPayloadLock.Unlock()
 	CompanionProps.Os = &name
 	CompanionProps.Version.Primary = &version[0]
 	CompanionProps.Version.Secondary = &version[1]
 	CompanionProps.Version.Tertiary = &version[2]
-	BaseClientPayload.UserAgent.OsVersion = proto.String(fmt.Sprintf("%d.%d.%d", version[0], version[1], version[2]))
+	BaseClientPayload.UserAgent.OsVersion = proto.String(WAVersionContainer(version).String())
 	BaseClientPayload.UserAgent.OsBuildNumber = BaseClientPayload.UserAgent.OsVersion
+	return nil
 }
 
-func (device *Device) getRegistrationPayload() *waProto.ClientPayload {
+func (device *Device) marshalCompanionProps() []byte {
+	if device.CompanionProps != nil {
+		data, _ := proto.Marshal(device.CompanionProps)
+		return data
+	}
+	clientPayloadLock.RLock()
+	defer clientPayloadLock.RUnlock()
+	data, _ := proto.Marshal(CompanionProps)
+	return data
+}
+
+func getBaseClientPayload(version WAVersionContainer) *waProto.ClientPayload {
+	clientPayloadLock.RLock()
 	payload := proto.Clone(BaseClientPayload).(*waProto.ClientPayload)
+	clientPayloadLock.RUnlock()
+	payload.UserAgent.AppVersion = version.ProtoAppVersion()
+	return payload
+}
+
+func (device *Device) getRegistrationPayload() *waProto.ClientPayload {
+	version := GetWAVersion()
+	payload := getBaseClientPayload(version)
 	regID := make([]byte, 4)
 	binary.BigEndian.PutUint32(regID, device.RegistrationID)
 	preKeyID := make([]byte, 4)
 	binary.BigEndian.PutUint32(preKeyID, device.SignedPreKey.KeyID)
-	companionProps, _ := proto.Marshal(CompanionProps)
+	companionProps := device.marshalCompanionProps()
+	buildHash := version.Hash()
 	payload.RegData = &waProto.CompanionRegData{
 		ERegid:         regID,
 		EKeytype:       []byte{ecc.DjbType},
@@ -94,7 +164,7 @@ func (device *Device) getRegistrationPayload() *waProto.ClientPayload {
 		ESkeyId:        preKeyID[1:],
 		ESkeyVal:       device.SignedPreKey.Pub[:],
 		ESkeySig:       device.SignedPreKey.Signature[:],
-		BuildHash:      waVersionHash[:],
+		BuildHash:      buildHash[:],
 		CompanionProps: companionProps,
 	}
 	payload.Passive = proto.Bool(false)
@@ -102,17 +172,62 @@ func (device *Device) getRegistrationPayload() *waProto.ClientPayload {
 }
 
 func (device *Device) getLoginPayload() *waProto.ClientPayload {
-	payload := proto.Clone(BaseClientPayload).(*waProto.ClientPayload)
+	payload := getBaseClientPayload(GetWAVersion())
 	payload.Username = proto.Uint64(device.ID.UserInt())
 	payload.Device = proto.Uint32(uint32(device.ID.Device))
 	payload.Passive = proto.Bool(true)
 	return payload
 }
 
+// GetClientPayload returns the payload to send at the end of the handshake. If the device has an ID,
+// it's a login payload, otherwise it's a registration payload for pairing as a new companion device.
+//
+// If the device has a ClientPayloadHook, it's called with the payload before it's returned.
 func (device *Device) GetClientPayload() *waProto.ClientPayload {
+	var payload *waProto.ClientPayload
 	if device.ID != nil {
-		return device.getLoginPayload()
+		payload = device.getLoginPayload()
 	} else {
-		return device.getRegistrationPayload()
+		payload = device.getRegistrationPayload()
+	}
+	if device.ClientPayloadHook != nil {
+		device.ClientPayloadHook(payload)
+	}
+	return payload
+}
+
+// ValidateClientPayload checks that the fields the server requires in the client payload aren't empty.
+func ValidateClientPayload(payload *waProto.ClientPayload) error {
+	ua := payload.GetUserAgent()
+	switch {
+	case ua == nil:
+		return fmt.Errorf("missing user agent")
+	case ua.Platform == nil:
+		return fmt.Errorf("missing user agent platform")
+	case ua.GetAppVersion().GetPrimary() == 0:
+		return fmt.Errorf("missing app version")
+	case len(ua.GetOsVersion()) == 0:
+		return fmt.Errorf("missing OS version")
+	case ua.GetPlatform() == waProto.UserAgent_WEB && payload.WebInfo == nil:
+		return fmt.Errorf("missing web info for web platform")
+	case payload.ConnectType == nil || payload.ConnectReason == nil:
+		return fmt.Errorf("missing connect type or reason")
+	}
+	if payload.Username != nil {
+		if payload.Device == nil {
+			return fmt.Errorf("missing device ID in login payload")
+		}
+		return nil
+	}
+	regData := payload.GetRegData()
+	if regData == nil {
+		return fmt.Errorf("missing registration data in registration payload")
+	}
+	var companionProps waProto.CompanionProps
+	if err := proto.Unmarshal(regData.GetCompanionProps(), &companionProps); err != nil {
+		return fmt.Errorf("failed to parse companion props: %w", err)
+	} else if len(companionProps.GetOs()) == 0 || companionProps.Version == nil || companionProps.PlatformType == nil {
+		return fmt.Errorf("missing OS name, version or platform type in companion props")
 	}
+	return nil
 }
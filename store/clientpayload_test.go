@@ -0,0 +1,66 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package store
+
+import (
+	"sync"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/util/keys"
+)
+
+func TestSetOSInfo(t *testing.T) {
+	if err := SetOSInfo("", [3]uint32{1, 0, 0}); err == nil {
+		t.Error("Expected empty OS name to be rejected")
+	}
+	if err := SetOSInfo("Linux", [3]uint32{0, 0, 0}); err == nil {
+		t.Error("Expected zero OS version to be rejected")
+	}
+	if CompanionProps.GetOs() != "whatsmeow" || BaseClientPayload.GetUserAgent().GetOsVersion() != "0.1.0" {
+		t.Fatal("Expected rejected OS info to not change the client payload")
+	}
+
+	origProps := proto.Clone(CompanionProps).(*waProto.CompanionProps)
+	origUserAgent := proto.Clone(BaseClientPayload.UserAgent).(*waProto.UserAgent)
+	t.Cleanup(func() {
+		CompanionProps = origProps
+		BaseClientPayload.UserAgent = origUserAgent
+	})
+	device := &Device{IdentityKey: keys.NewKeyPair(), SignedPreKey: keys.NewKeyPair().CreateSignedPreKey(1)}
+	var wg sync.WaitGroup
+	for i := uint32(1); i <= 10; i++ {
+		wg.Add(2)
+		go func(i uint32) {
+			defer wg.Done()
+			if err := SetOSInfo("Linux", [3]uint32{5, i, 0}); err != nil {
+				t.Errorf("Failed to set OS info: %v", err)
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			if err := ValidateClientPayload(device.GetClientPayload()); err != nil {
+				t.Errorf("Invalid client payload: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := SetOSInfo("Linux", [3]uint32{5, 15, 2}); err != nil {
+		t.Fatalf("Failed to set OS info: %v", err)
+	}
+	payload := device.GetClientPayload()
+	var props waProto.CompanionProps
+	if err := proto.Unmarshal(payload.GetRegData().GetCompanionProps(), &props); err != nil {
+		t.Fatalf("Failed to unmarshal companion props: %v", err)
+	}
+	if props.GetOs() != "Linux" || props.GetVersion().GetSecondary() != 15 || payload.GetUserAgent().GetOsVersion() != "5.15.2" {
+		t.Errorf("OS info wasn't applied to the client payload: %s %v %s", props.GetOs(), props.GetVersion(), payload.GetUserAgent().GetOsVersion())
+	}
+}
@@ -111,7 +111,10 @@ func (device *Device) ContainsSession(remoteAddress *protocol.SignalAddress) boo
 }
 
 func (device *Device) DeleteSession(remoteAddress *protocol.SignalAddress) {
-	panic("not implemented")
+	err := device.Sessions.DeleteSession(remoteAddress.String())
+	if err != nil {
+		device.Log.Errorf("Failed to delete session with %s: %v", remoteAddress.String(), err)
+	}
 }
 
 func (device *Device) DeleteAllSessions() {
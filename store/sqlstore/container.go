@@ -7,26 +7,63 @@
 package sqlstore
 
 import (
-	"crypto/rand"
 	"database/sql"
 	"errors"
 	"fmt"
-	mathRand "math/rand"
+	"sync"
+	"time"
 
 	waProto "go.mau.fi/whatsmeow/binary/proto"
 	"go.mau.fi/whatsmeow/store"
 	"go.mau.fi/whatsmeow/types"
 	"go.mau.fi/whatsmeow/util/keys"
 	waLog "go.mau.fi/whatsmeow/util/log"
+	"go.mau.fi/whatsmeow/util/random"
 )
 
 type Container struct {
 	db      *sql.DB
+	readDB  *sql.DB
 	dialect string
 	log     waLog.Logger
+
+	// ConsistentReads makes stores read Signal protocol data (identities, sessions, pre-keys and sender keys)
+	// and app state keys from the main database even if a separate read database is configured.
+	//
+	// Those are written and read back immediately during normal operation (e.g. a session is updated after every
+	// message), so reading a stale value from a lagging replica would break the encryption ratchet.
+	ConsistentReads bool
+
+	// SoftDelete makes DeleteDevice only mark devices as deleted instead of removing them and all their data.
+	// Soft-deleted devices are not returned by GetAllDevices or GetDevice, and can be removed for real
+	// later using PurgeDeletedDevices.
+	SoftDelete bool
+
+	// ContactCacheSize is the maximum number of contacts each device store keeps cached in memory.
+	// When the limit is reached, the least recently used contact is dropped. Zero means no limit.
+	// Changes only affect device stores that are loaded afterwards.
+	ContactCacheSize int
+
+	// KeyProvider is used to load the keys of devices whose noise or identity key is stored outside the database
+	// (see store.Device.ExternalNoiseKey). For such devices, only a reference to the key is saved in the database.
+	KeyProvider keys.KeyProvider
+
+	preKeyLocks     map[string]*sync.Mutex
+	preKeyLocksLock sync.Mutex
 }
 
+// ErrNoKeyProvider is returned when loading a device with external keys if Container.KeyProvider is not set.
+var ErrNoKeyProvider = errors.New("device has external keys, but no key provider is configured")
+
+// ErrExternalKeyNotReferenceable is returned by PutDevice if the device has an external noise key
+// that can't be saved in the database (i.e. doesn't implement keys.ExternalKey).
+var ErrExternalKeyNotReferenceable = errors.New("external noise key doesn't have a reference that could be saved")
+
+// DefaultContactCacheSize is the default value for Container.ContactCacheSize.
+const DefaultContactCacheSize = 100000
+
 var _ store.DeviceContainer = (*Container)(nil)
+var _ store.DeviceFieldUpdater = (*Container)(nil)
 
 func New(dialect, address string, log waLog.Logger) (*Container, error) {
 	db, err := sql.Open(dialect, address)
@@ -34,9 +71,11 @@ func New(dialect, address string, log waLog.Logger) (*Container, error) {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
 	container := NewWithDB(db, dialect, log)
-	err = container.Upgrade()
+	applied, err := container.UpgradeWithResult()
 	if err != nil {
 		return nil, fmt.Errorf("failed to upgrade database: %w", err)
+	} else if len(applied) > 0 {
+		container.log.Infof("Upgraded database to v%d (applied migrations %v)", applied[len(applied)-1], applied)
 	}
 	return container, nil
 }
@@ -47,20 +86,54 @@ func NewWithDB(db *sql.DB, dialect string, log waLog.Logger) *Container {
 	}
 	return &Container{
 		db:      db,
+		readDB:  db,
 		dialect: dialect,
 		log:     log,
+
+		ContactCacheSize: DefaultContactCacheSize,
+	}
+}
+
+// NewWithReadDB creates a container that sends writes to writeDB and read-only queries to readDB,
+// which is meant to be a read replica of the same database.
+//
+// Replication lag means that freshly written data may not be readable from the replica immediately.
+// Because of that, ConsistentReads is enabled by default, and some data that must always be up-to-date
+// (like the app state version and pre-key IDs) is always read from the main database.
+// Generally only contacts, chat settings and labels are read from the replica.
+func NewWithReadDB(writeDB, readDB *sql.DB, dialect string, log waLog.Logger) *Container {
+	container := NewWithDB(writeDB, dialect, log)
+	container.readDB = readDB
+	container.ConsistentReads = true
+	return container
+}
+
+// getPreKeyLock returns the lock that serializes pre-key ID allocation for the given device. The lock is shared by
+// all stores of the device (including ones created for transactions), so concurrent generation can't reuse IDs.
+func (c *Container) getPreKeyLock(jid string) *sync.Mutex {
+	c.preKeyLocksLock.Lock()
+	defer c.preKeyLocksLock.Unlock()
+	if c.preKeyLocks == nil {
+		c.preKeyLocks = make(map[string]*sync.Mutex)
 	}
+	lock, ok := c.preKeyLocks[jid]
+	if !ok {
+		lock = &sync.Mutex{}
+		c.preKeyLocks[jid] = lock
+	}
+	return lock
 }
 
 const getAllDevicesQuery = `
 SELECT jid, registration_id, noise_key, identity_key,
        signed_pre_key, signed_pre_key_id, signed_pre_key_sig,
        adv_key, adv_details, adv_account_sig, adv_device_sig,
-       platform, business_name, push_name
+       platform, business_name, push_name, noise_key_ref, identity_key_ref
 FROM whatsmeow_device
 `
 
-const getDeviceQuery = getAllDevicesQuery + " WHERE jid=$1"
+const getAllNonDeletedDevicesQuery = getAllDevicesQuery + " WHERE deleted_at IS NULL"
+const getDeviceQuery = getAllNonDeletedDevicesQuery + " AND jid=$1"
 
 type scannable interface {
 	Scan(dest ...interface{}) error
@@ -71,21 +144,37 @@ func (c *Container) scanDevice(row scannable) (*store.Device, error) {
 	device.Log = c.log
 	device.SignedPreKey = &keys.PreKey{}
 	var noisePriv, identityPriv, preKeyPriv, preKeySig []byte
+	var noiseKeyRef, identityKeyRef string
 	var account waProto.ADVSignedDeviceIdentity
 
 	err := row.Scan(
 		&device.ID, &device.RegistrationID, &noisePriv, &identityPriv,
 		&preKeyPriv, &device.SignedPreKey.KeyID, &preKeySig,
 		&device.AdvSecretKey, &account.Details, &account.AccountSignature, &account.DeviceSignature,
-		&device.Platform, &device.BusinessName, &device.PushName)
+		&device.Platform, &device.BusinessName, &device.PushName, &noiseKeyRef, &identityKeyRef)
 	if err != nil {
 		return nil, fmt.Errorf("failed to scan session: %w", err)
 	} else if len(noisePriv) != 32 || len(identityPriv) != 32 || len(preKeyPriv) != 32 || len(preKeySig) != 64 {
 		return nil, ErrInvalidLength
 	}
 
-	device.NoiseKey = keys.NewKeyPairFromPrivateKey(*(*[32]byte)(noisePriv))
+	if len(noiseKeyRef) > 0 {
+		// The noise_key column contains the public key for devices with an external noise key
+		device.NoiseKey = &keys.KeyPair{Pub: (*[32]byte)(noisePriv)}
+		device.ExternalNoiseKey, err = c.loadDHKey(noiseKeyRef, device.NoiseKey.Pub)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load external noise key: %w", err)
+		}
+	} else {
+		device.NoiseKey = keys.NewKeyPairFromPrivateKey(*(*[32]byte)(noisePriv))
+	}
 	device.IdentityKey = keys.NewKeyPairFromPrivateKey(*(*[32]byte)(identityPriv))
+	if len(identityKeyRef) > 0 {
+		device.ExternalIdentityKey, err = c.loadSigningKey(identityKeyRef, device.IdentityKey.Pub)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load external identity key: %w", err)
+		}
+	}
 	device.SignedPreKey.KeyPair = *keys.NewKeyPairFromPrivateKey(*(*[32]byte)(preKeyPriv))
 	device.SignedPreKey.Signature = (*[64]byte)(preKeySig)
 	device.Account = &account
@@ -99,14 +188,55 @@ func (c *Container) scanDevice(row scannable) (*store.Device, error) {
 	device.AppState = innerStore
 	device.Contacts = innerStore
 	device.ChatSettings = innerStore
+	device.Labels = innerStore
+	device.Outbox = innerStore
+	device.Reactions = innerStore
+	device.MsgSecrets = innerStore
+	device.Polls = innerStore
 	device.Container = c
 	device.Initialized = true
 
 	return &device, nil
 }
 
+func (c *Container) loadDHKey(ref string, expectedPub *[32]byte) (keys.DHKey, error) {
+	if c.KeyProvider == nil {
+		return nil, ErrNoKeyProvider
+	}
+	key, err := c.KeyProvider.LoadDHKey(ref)
+	if err != nil {
+		return nil, err
+	} else if *key.PublicKey() != *expectedPub {
+		return nil, fmt.Errorf("public key of %s doesn't match the one in the database", ref)
+	}
+	return key, nil
+}
+
+func (c *Container) loadSigningKey(ref string, expectedPub *[32]byte) (keys.SigningKey, error) {
+	if c.KeyProvider == nil {
+		return nil, ErrNoKeyProvider
+	}
+	key, err := c.KeyProvider.LoadSigningKey(ref)
+	if err != nil {
+		return nil, err
+	} else if *key.PublicKey() != *expectedPub {
+		return nil, fmt.Errorf("public key of %s doesn't match the one in the database", ref)
+	}
+	return key, nil
+}
+
+// GetAllDevices returns all devices in the database, except ones that have been soft-deleted.
 func (c *Container) GetAllDevices() ([]*store.Device, error) {
-	res, err := c.db.Query(getAllDevicesQuery)
+	return c.GetDevices(false)
+}
+
+// GetDevices returns all devices in the database, optionally including soft-deleted ones.
+func (c *Container) GetDevices(includeDeleted bool) ([]*store.Device, error) {
+	query := getAllNonDeletedDevicesQuery
+	if includeDeleted {
+		query = getAllDevicesQuery
+	}
+	res, err := c.db.Query(query)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query sessions: %w", err)
 	}
@@ -134,13 +264,38 @@ const (
 		INSERT INTO whatsmeow_device (jid, registration_id, noise_key, identity_key,
 									  signed_pre_key, signed_pre_key_id, signed_pre_key_sig,
 									  adv_key, adv_details, adv_account_sig, adv_device_sig,
-									  platform, business_name, push_name)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
-		ON CONFLICT (jid) DO UPDATE SET platform=$12, business_name=$13, push_name=$14
+									  platform, business_name, push_name, noise_key_ref, identity_key_ref)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		ON CONFLICT (jid) DO UPDATE SET platform=$12, business_name=$13, push_name=$14, deleted_at=NULL
 	`
-	deleteDeviceQuery = `DELETE FROM whatsmeow_device WHERE jid=$1`
+	updatePushNameQuery     = `UPDATE whatsmeow_device SET push_name=$1 WHERE jid=$2`
+	updateBusinessNameQuery = `UPDATE whatsmeow_device SET business_name=$1 WHERE jid=$2`
+	updatePlatformQuery     = `UPDATE whatsmeow_device SET platform=$1 WHERE jid=$2`
+	deleteDeviceQuery       = `DELETE FROM whatsmeow_device WHERE jid=$1`
+	softDeleteDeviceQuery   = `UPDATE whatsmeow_device SET deleted_at=$1 WHERE jid=$2 AND deleted_at IS NULL`
+	getDeletedDevicesQuery  = `SELECT jid FROM whatsmeow_device WHERE deleted_at IS NOT NULL AND deleted_at<$1`
 )
 
+// deviceDataTables lists the tables that contain data of a device, along with the column that has the device JID.
+// They're listed in an order where there are no foreign key references to earlier tables.
+var deviceDataTables = [...][2]string{
+	{"whatsmeow_app_state_mutation_macs", "jid"},
+	{"whatsmeow_app_state_version", "jid"},
+	{"whatsmeow_app_state_sync_keys", "jid"},
+	{"whatsmeow_identity_keys", "our_jid"},
+	{"whatsmeow_pre_keys", "jid"},
+	{"whatsmeow_sessions", "our_jid"},
+	{"whatsmeow_sender_keys", "our_jid"},
+	{"whatsmeow_contacts", "our_jid"},
+	{"whatsmeow_chat_settings", "our_jid"},
+	{"whatsmeow_labels", "our_jid"},
+	{"whatsmeow_outbox", "our_jid"},
+	{"whatsmeow_reactions", "our_jid"},
+	{"whatsmeow_message_secrets", "our_jid"},
+	{"whatsmeow_polls", "our_jid"},
+	{"whatsmeow_poll_votes", "our_jid"},
+}
+
 func (c *Container) NewDevice() *store.Device {
 	device := &store.Device{
 		Log:       c.log,
@@ -148,12 +303,8 @@ func (c *Container) NewDevice() *store.Device {
 
 		NoiseKey:       keys.NewKeyPair(),
 		IdentityKey:    keys.NewKeyPair(),
-		RegistrationID: mathRand.Uint32(),
-		AdvSecretKey:   make([]byte, 32),
-	}
-	_, err := rand.Read(device.AdvSecretKey)
-	if err != nil {
-		panic(err)
+		RegistrationID: random.RegistrationID(),
+		AdvSecretKey:   random.Bytes(32),
 	}
 	device.SignedPreKey = device.IdentityKey.CreateSignedPreKey(1)
 	return device
@@ -161,15 +312,30 @@ func (c *Container) NewDevice() *store.Device {
 
 var ErrDeviceIDMustBeSet = errors.New("device JID must be known before accessing database")
 
+// PutDevice inserts the device into the database. For devices that already exist, it updates all the mutable
+// columns at once, so changing single fields should be done with PutDeviceField (via the store.Device.Set* methods)
+// to avoid overwriting concurrent changes to other fields.
 func (c *Container) PutDevice(device *store.Device) error {
 	if device.ID == nil {
 		return ErrDeviceIDMustBeSet
 	}
+	noiseKey, noiseKeyRef := device.NoiseKey.Priv, ""
+	if device.ExternalNoiseKey != nil {
+		external, ok := device.ExternalNoiseKey.(keys.ExternalKey)
+		if !ok {
+			return ErrExternalKeyNotReferenceable
+		}
+		noiseKey, noiseKeyRef = device.ExternalNoiseKey.PublicKey(), external.KeyReference()
+	}
+	var identityKeyRef string
+	if external, ok := device.ExternalIdentityKey.(keys.ExternalKey); ok {
+		identityKeyRef = external.KeyReference()
+	}
 	_, err := c.db.Exec(insertDeviceQuery,
-		device.ID.String(), device.RegistrationID, device.NoiseKey.Priv[:], device.IdentityKey.Priv[:],
+		device.ID.String(), device.RegistrationID, noiseKey[:], device.IdentityKey.Priv[:],
 		device.SignedPreKey.Priv[:], device.SignedPreKey.KeyID, device.SignedPreKey.Signature[:],
 		device.AdvSecretKey, device.Account.Details, device.Account.AccountSignature, device.Account.DeviceSignature,
-		device.Platform, device.BusinessName, device.PushName)
+		device.Platform, device.BusinessName, device.PushName, noiseKeyRef, identityKeyRef)
 
 	if !device.Initialized {
 		innerStore := NewSQLStore(c, *device.ID)
@@ -181,15 +347,139 @@ func (c *Container) PutDevice(device *store.Device) error {
 		device.AppState = innerStore
 		device.Contacts = innerStore
 		device.ChatSettings = innerStore
+		device.Labels = innerStore
+		device.Outbox = innerStore
+		device.Reactions = innerStore
+		device.MsgSecrets = innerStore
+		device.Polls = innerStore
 		device.Initialized = true
 	}
 	return err
 }
 
+// PutDeviceField updates a single column of an already saved device.
+func (c *Container) PutDeviceField(device *store.Device, field store.DeviceField, value string) error {
+	if device.ID == nil {
+		return ErrDeviceIDMustBeSet
+	}
+	var query string
+	switch field {
+	case store.DeviceFieldPushName:
+		query = updatePushNameQuery
+	case store.DeviceFieldBusinessName:
+		query = updateBusinessNameQuery
+	case store.DeviceFieldPlatform:
+		query = updatePlatformQuery
+	default:
+		return fmt.Errorf("unknown device field %d", field)
+	}
+	_, err := c.db.Exec(query, value, device.ID.String())
+	return err
+}
+
+// WithTransaction runs the given function inside a single database transaction. All the stores passed to the
+// function write through the transaction, which is committed if the function returns nil and rolled back otherwise.
+//
+// The stores are separate instances from the ones in the store.Device, so contact info written inside the
+// transaction may not be visible through the device's in-memory contact cache.
+func (c *Container) WithTransaction(jid types.JID, fn func(stores store.DeviceStores) error) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	txStore := newTxSQLStore(c, jid, tx)
+	err = fn(store.DeviceStores{
+		Identities:   txStore,
+		Sessions:     txStore,
+		PreKeys:      txStore,
+		SenderKeys:   txStore,
+		AppStateKeys: txStore,
+		AppState:     txStore,
+		Contacts:     txStore,
+		ChatSettings: txStore,
+		Labels:       txStore,
+		Outbox:       txStore,
+		Reactions:    txStore,
+		MsgSecrets:   txStore,
+		Polls:        txStore,
+	})
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			c.log.Warnf("Failed to roll back transaction: %v", rollbackErr)
+		}
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// DeleteDevice deletes the given device and all its data, or only marks it as deleted if SoftDelete is enabled.
 func (c *Container) DeleteDevice(store *store.Device) error {
 	if store.ID == nil {
 		return ErrDeviceIDMustBeSet
 	}
-	_, err := c.db.Exec(deleteDeviceQuery, store.ID.String())
-	return err
+	if c.SoftDelete {
+		_, err := c.db.Exec(softDeleteDeviceQuery, time.Now().Unix(), store.ID.String())
+		return err
+	}
+	return c.hardDeleteDevice(store.ID.String())
+}
+
+// hardDeleteDevice deletes the device and its data from all tables explicitly instead of relying on
+// foreign key cascades, which aren't enabled by default in SQLite.
+func (c *Container) hardDeleteDevice(jid string) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	for _, table := range deviceDataTables {
+		_, err = tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s=$1", table[0], table[1]), jid)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to delete data from %s: %w", table[0], err)
+		}
+	}
+	_, err = tx.Exec(deleteDeviceQuery, jid)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to delete device: %w", err)
+	}
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// PurgeDeletedDevices permanently deletes devices (and all their data) that were soft-deleted before the given time.
+// It returns the number of devices that were purged.
+func (c *Container) PurgeDeletedDevices(olderThan time.Time) (int, error) {
+	rows, err := c.db.Query(getDeletedDevicesQuery, olderThan.Unix())
+	if err != nil {
+		return 0, fmt.Errorf("failed to query deleted devices: %w", err)
+	}
+	var jids []string
+	for rows.Next() {
+		var jid string
+		err = rows.Scan(&jid)
+		if err != nil {
+			_ = rows.Close()
+			return 0, fmt.Errorf("failed to scan deleted device: %w", err)
+		}
+		jids = append(jids, jid)
+	}
+	_ = rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to query deleted devices: %w", err)
+	}
+	for i, jid := range jids {
+		err = c.hardDeleteDevice(jid)
+		if err != nil {
+			return i, err
+		}
+	}
+	return len(jids), nil
 }
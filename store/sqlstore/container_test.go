@@ -0,0 +1,162 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package sqlstore
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/util/keys"
+)
+
+// fakeDeviceRow returns the given values in the order of the columns in getAllDevicesQuery.
+type fakeDeviceRow []interface{}
+
+func (row fakeDeviceRow) Scan(dest ...interface{}) error {
+	if len(dest) != len(row) {
+		return fmt.Errorf("expected %d columns, got %d", len(row), len(dest))
+	}
+	for i, val := range row {
+		reflect.ValueOf(dest[i]).Elem().Set(reflect.ValueOf(val))
+	}
+	return nil
+}
+
+type externalKey struct {
+	*keys.KeyPair
+	ref string
+}
+
+func (ek *externalKey) KeyReference() string { return ek.ref }
+
+type fakeKeyProvider map[string]*externalKey
+
+func (fkp fakeKeyProvider) LoadDHKey(ref string) (keys.DHKey, error) {
+	if key, ok := fkp[ref]; ok {
+		return key, nil
+	}
+	return nil, errors.New("key not found")
+}
+
+func (fkp fakeKeyProvider) LoadSigningKey(ref string) (keys.SigningKey, error) {
+	if key, ok := fkp[ref]; ok {
+		return key, nil
+	}
+	return nil, errors.New("key not found")
+}
+
+func makeDeviceRow(noiseKey []byte, identityKey *keys.KeyPair, noiseKeyRef, identityKeyRef string) fakeDeviceRow {
+	jid := types.NewADJID("1234567890", 0, 5)
+	preKey := identityKey.CreateSignedPreKey(1)
+	return fakeDeviceRow{
+		&jid, uint32(1234), noiseKey, identityKey.Priv[:],
+		preKey.Priv[:], uint32(1), preKey.Signature[:],
+		[]byte("adv key"), []byte("details"), make([]byte, 64), make([]byte, 64),
+		"android", "", "Test", noiseKeyRef, identityKeyRef,
+	}
+}
+
+func TestScanDeviceExternalKeys(t *testing.T) {
+	noiseKey := &externalKey{KeyPair: keys.NewKeyPair(), ref: "hsm://noise"}
+	identityKey := keys.NewKeyPair()
+	identitySigner := &externalKey{KeyPair: identityKey, ref: "hsm://identity"}
+	container := NewWithDB(nil, "sqlite3", nil)
+	row := makeDeviceRow(noiseKey.Pub[:], identityKey, noiseKey.ref, identitySigner.ref)
+
+	_, err := container.scanDevice(row)
+	if !errors.Is(err, ErrNoKeyProvider) {
+		t.Fatalf("Expected ErrNoKeyProvider, got %v", err)
+	}
+
+	container.KeyProvider = fakeKeyProvider{noiseKey.ref: noiseKey, identitySigner.ref: identitySigner}
+	device, err := container.scanDevice(row)
+	if err != nil {
+		t.Fatalf("Failed to scan device: %v", err)
+	}
+	if device.GetNoiseKey() != keys.DHKey(noiseKey) || device.NoiseKey.Priv != nil || *device.NoiseKey.Pub != *noiseKey.Pub {
+		t.Error("Expected noise key to be loaded from the key provider")
+	}
+	if device.GetIdentitySigner() != keys.SigningKey(identitySigner) || *device.IdentityKey.Priv != *identityKey.Priv {
+		t.Error("Expected identity signer to be loaded from the key provider")
+	}
+
+	// The stored public key must match the key returned by the provider
+	otherKey := keys.NewKeyPair()
+	_, err = container.scanDevice(makeDeviceRow(otherKey.Pub[:], identityKey, noiseKey.ref, ""))
+	if err == nil {
+		t.Error("Expected error when the external key doesn't match the stored public key")
+	}
+
+	device, err = container.scanDevice(makeDeviceRow(otherKey.Priv[:], identityKey, "", ""))
+	if err != nil {
+		t.Fatalf("Failed to scan device: %v", err)
+	} else if device.GetNoiseKey() != keys.DHKey(device.NoiseKey) || *device.NoiseKey.Pub != *otherKey.Pub {
+		t.Error("Expected in-memory noise key for device without references")
+	}
+}
+
+func TestConcurrentDeviceFieldUpdates(t *testing.T) {
+	var lock sync.Mutex
+	row := map[string]string{}
+	inserts := 0
+	db := &fakeUpgradeDB{onExec: func(query string, args []driver.NamedValue) error {
+		lock.Lock()
+		defer lock.Unlock()
+		query = strings.TrimSpace(query)
+		if strings.HasPrefix(query, "INSERT INTO whatsmeow_device") {
+			inserts++
+			row["platform"], row["business_name"], row["push_name"] = args[11].Value.(string), args[12].Value.(string), args[13].Value.(string)
+		} else if strings.HasPrefix(query, "UPDATE whatsmeow_device SET ") {
+			column := strings.TrimPrefix(query[:strings.IndexByte(query, '=')], "UPDATE whatsmeow_device SET ")
+			row[column] = args[0].Value.(string)
+		}
+		return nil
+	}}
+	container := newFakeUpgradeContainer(t, db)
+	device := container.NewDevice()
+	jid := types.NewADJID("1234567890", 0, 5)
+	device.ID = &jid
+	device.Account = &waProto.ADVSignedDeviceIdentity{}
+	device.Platform = "android"
+	if err := device.Save(); err != nil {
+		t.Fatalf("Failed to save device: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			if err := device.SetPushName(fmt.Sprintf("Name %d", i)); err != nil {
+				t.Errorf("Failed to set push name: %v", err)
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			if err := device.SetBusinessName(fmt.Sprintf("Business %d", i)); err != nil {
+				t.Errorf("Failed to set business name: %v", err)
+			}
+		}
+	}()
+	wg.Wait()
+
+	if row["push_name"] != "Name 49" || row["business_name"] != "Business 49" || row["platform"] != "android" {
+		t.Errorf("Unexpected device row after concurrent updates: %v", row)
+	}
+	if inserts != 1 {
+		t.Errorf("Expected only the initial save to write the whole device, got %d inserts", inserts)
+	}
+}
@@ -9,6 +9,7 @@ package sqlstore
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -20,6 +21,7 @@ import (
 	"go.mau.fi/whatsmeow/store"
 	"go.mau.fi/whatsmeow/types"
 	"go.mau.fi/whatsmeow/util/keys"
+	"go.mau.fi/whatsmeow/util/lru"
 )
 
 var ErrInvalidLength = errors.New("database returned byte array with illegal length")
@@ -28,9 +30,14 @@ type SQLStore struct {
 	*Container
 	JID string
 
-	preKeyLock sync.Mutex
+	// db is either the container's database or a transaction started with Container.WithTransaction.
+	db     execable
+	readDB execable
+	inTx   bool
 
-	contactCache     map[types.JID]*types.ContactInfo
+	preKeyLock *sync.Mutex
+
+	contactCache     *lru.Cache[types.JID, *types.ContactInfo]
 	contactCacheLock sync.Mutex
 }
 
@@ -38,10 +45,29 @@ func NewSQLStore(c *Container, jid types.JID) *SQLStore {
 	return &SQLStore{
 		Container:    c,
 		JID:          jid.String(),
-		contactCache: make(map[types.JID]*types.ContactInfo),
+		db:           c.db,
+		readDB:       c.readDB,
+		preKeyLock:   c.getPreKeyLock(jid.String()),
+		contactCache: lru.New[types.JID, *types.ContactInfo](c.ContactCacheSize),
 	}
 }
 
+func newTxSQLStore(c *Container, jid types.JID, tx *sql.Tx) *SQLStore {
+	txStore := NewSQLStore(c, jid)
+	txStore.db = tx
+	txStore.readDB = tx
+	txStore.inTx = true
+	return txStore
+}
+
+// consistentReadDB returns the database for reading data that is read back immediately after being written.
+func (s *SQLStore) consistentReadDB() execable {
+	if s.ConsistentReads {
+		return s.db
+	}
+	return s.readDB
+}
+
 var _ store.IdentityStore = (*SQLStore)(nil)
 var _ store.SessionStore = (*SQLStore)(nil)
 var _ store.PreKeyStore = (*SQLStore)(nil)
@@ -49,6 +75,10 @@ var _ store.SenderKeyStore = (*SQLStore)(nil)
 var _ store.AppStateSyncKeyStore = (*SQLStore)(nil)
 var _ store.AppStateStore = (*SQLStore)(nil)
 var _ store.ContactStore = (*SQLStore)(nil)
+var _ store.OutboxStore = (*SQLStore)(nil)
+var _ store.ReactionStore = (*SQLStore)(nil)
+var _ store.MsgSecretStore = (*SQLStore)(nil)
+var _ store.PollStore = (*SQLStore)(nil)
 
 const (
 	putIdentityQuery = `
@@ -65,7 +95,7 @@ func (s *SQLStore) PutIdentity(address string, key [32]byte) error {
 
 func (s *SQLStore) IsTrustedIdentity(address string, key [32]byte) (bool, error) {
 	var existingIdentity []byte
-	err := s.db.QueryRow(getIdentityQuery, s.JID, address).Scan(&existingIdentity)
+	err := s.consistentReadDB().QueryRow(getIdentityQuery, s.JID, address).Scan(&existingIdentity)
 	if errors.Is(err, sql.ErrNoRows) {
 		// Trust if not known, it'll be saved automatically later
 		return true, nil
@@ -84,10 +114,11 @@ const (
 		INSERT INTO whatsmeow_sessions (our_jid, their_id, session) VALUES ($1, $2, $3)
 		ON CONFLICT (our_jid, their_id) DO UPDATE SET session=$3
 	`
+	deleteSessionQuery = `DELETE FROM whatsmeow_sessions WHERE our_jid=$1 AND their_id=$2`
 )
 
 func (s *SQLStore) GetSession(address string) (session []byte, err error) {
-	err = s.db.QueryRow(getSessionQuery, s.JID, address).Scan(&session)
+	err = s.consistentReadDB().QueryRow(getSessionQuery, s.JID, address).Scan(&session)
 	if errors.Is(err, sql.ErrNoRows) {
 		err = nil
 	}
@@ -95,7 +126,7 @@ func (s *SQLStore) GetSession(address string) (session []byte, err error) {
 }
 
 func (s *SQLStore) HasSession(address string) (has bool, err error) {
-	err = s.db.QueryRow(hasSessionQuery, s.JID, address).Scan(&has)
+	err = s.consistentReadDB().QueryRow(hasSessionQuery, s.JID, address).Scan(&has)
 	if errors.Is(err, sql.ErrNoRows) {
 		err = nil
 	}
@@ -107,9 +138,15 @@ func (s *SQLStore) PutSession(address string, session []byte) error {
 	return err
 }
 
+func (s *SQLStore) DeleteSession(address string) error {
+	_, err := s.db.Exec(deleteSessionQuery, s.JID, address)
+	return err
+}
+
 const (
 	getLastPreKeyIDQuery        = `SELECT MAX(key_id) FROM whatsmeow_pre_keys WHERE jid=$1`
 	insertPreKeyQuery           = `INSERT INTO whatsmeow_pre_keys (jid, key_id, key, uploaded) VALUES ($1, $2, $3, $4)`
+	insertPreKeysQueryPrefix    = `INSERT INTO whatsmeow_pre_keys (jid, uploaded, key_id, key) VALUES `
 	getUnuploadedPreKeysQuery   = `SELECT key_id, key FROM whatsmeow_pre_keys WHERE jid=$1 AND uploaded=false ORDER BY key_id LIMIT $2`
 	getPreKeyQuery              = `SELECT key_id, key FROM whatsmeow_pre_keys WHERE jid=$1 AND key_id=$2`
 	deletePreKeyQuery           = `DELETE FROM whatsmeow_pre_keys WHERE jid=$1 AND key_id=$2`
@@ -169,15 +206,78 @@ func (s *SQLStore) GetOrGenPreKeys(count uint32) ([]*keys.PreKey, error) {
 		if err != nil {
 			return nil, err
 		}
-		for i := existingCount; i < count; i++ {
-			newKeys[i], err = s.genOnePreKey(nextKeyID, false)
-			nextKeyID++
+		var generated []*keys.PreKey
+		generated, err = keys.NewPreKeys(nextKeyID, int(count-existingCount))
+		if err != nil {
+			return nil, err
+		}
+		// The keys are inserted in one transaction, so a failure doesn't leave gaps in the key IDs.
+		err = s.insertPreKeys(generated)
+		if err != nil {
+			return nil, err
 		}
+		copy(newKeys[existingCount:], generated)
 	}
 
 	return newKeys, nil
 }
 
+// preKeyInsertBatchSize is the number of prekeys to insert per statement.
+// Each row uses two parameters, so this stays well below the SQLite parameter limit.
+const preKeyInsertBatchSize = 250
+
+func (s *SQLStore) insertPreKeyBatch(db execable, preKeys []*keys.PreKey) error {
+	args := make([]interface{}, 2, 2+len(preKeys)*2)
+	args[0] = s.JID
+	args[1] = false
+	valueParts := make([]string, len(preKeys))
+	for i, key := range preKeys {
+		args = append(args, key.KeyID, key.Priv[:])
+		valueParts[i] = fmt.Sprintf("($1, $2, $%d, $%d)", 3+i*2, 4+i*2)
+	}
+	_, err := db.Exec(insertPreKeysQueryPrefix+strings.Join(valueParts, ","), args...)
+	if err != nil {
+		return fmt.Errorf("failed to insert prekeys: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLStore) insertPreKeys(preKeys []*keys.PreKey) error {
+	if len(preKeys) <= preKeyInsertBatchSize {
+		return s.insertPreKeyBatch(s.db, preKeys)
+	}
+	db := s.db
+	var tx *sql.Tx
+	if !s.inTx {
+		var err error
+		tx, err = s.Container.db.Begin()
+		if err != nil {
+			return fmt.Errorf("failed to start transaction: %w", err)
+		}
+		db = tx
+	}
+	for start := 0; start < len(preKeys); start += preKeyInsertBatchSize {
+		end := start + preKeyInsertBatchSize
+		if end > len(preKeys) {
+			end = len(preKeys)
+		}
+		err := s.insertPreKeyBatch(db, preKeys[start:end])
+		if err != nil {
+			if tx != nil {
+				_ = tx.Rollback()
+			}
+			return err
+		}
+	}
+	if tx != nil {
+		err := tx.Commit()
+		if err != nil {
+			return fmt.Errorf("failed to commit transaction: %w", err)
+		}
+	}
+	return nil
+}
+
 func scanPreKey(row scannable) (*keys.PreKey, error) {
 	var priv []byte
 	var id uint32
@@ -196,7 +296,7 @@ func scanPreKey(row scannable) (*keys.PreKey, error) {
 }
 
 func (s *SQLStore) GetPreKey(id uint32) (*keys.PreKey, error) {
-	return scanPreKey(s.db.QueryRow(getPreKeyQuery, s.JID, id))
+	return scanPreKey(s.consistentReadDB().QueryRow(getPreKeyQuery, s.JID, id))
 }
 
 func (s *SQLStore) RemovePreKey(id uint32) error {
@@ -210,7 +310,7 @@ func (s *SQLStore) MarkPreKeysAsUploaded(upToID uint32) error {
 }
 
 func (s *SQLStore) UploadedPreKeyCount() (count int, err error) {
-	err = s.db.QueryRow(getUploadedPreKeyCountQuery, s.JID).Scan(&count)
+	err = s.consistentReadDB().QueryRow(getUploadedPreKeyCountQuery, s.JID).Scan(&count)
 	return
 }
 
@@ -228,7 +328,7 @@ func (s *SQLStore) PutSenderKey(group, user string, session []byte) error {
 }
 
 func (s *SQLStore) GetSenderKey(group, user string) (key []byte, err error) {
-	err = s.db.QueryRow(getSenderKeyQuery, s.JID, group, user).Scan(&key)
+	err = s.consistentReadDB().QueryRow(getSenderKeyQuery, s.JID, group, user).Scan(&key)
 	if errors.Is(err, sql.ErrNoRows) {
 		err = nil
 	}
@@ -240,7 +340,13 @@ const (
 		INSERT INTO whatsmeow_app_state_sync_keys (jid, key_id, key_data, timestamp, fingerprint) VALUES ($1, $2, $3, $4, $5)
 		ON CONFLICT (jid, key_id) DO UPDATE SET key_data=$3, timestamp=$4, fingerprint=$5
 	`
-	getAppStateSyncKeyQuery = `SELECT key_data, timestamp, fingerprint FROM whatsmeow_app_state_sync_keys WHERE jid=$1 AND key_id=$2`
+	getAppStateSyncKeyQuery         = `SELECT key_data, timestamp, fingerprint FROM whatsmeow_app_state_sync_keys WHERE jid=$1 AND key_id=$2`
+	getLatestAppStateSyncKeyIDQuery = `SELECT key_id FROM whatsmeow_app_state_sync_keys WHERE jid=$1 ORDER BY timestamp DESC LIMIT 1`
+	getAllAppStateSyncKeysQuery     = `SELECT key_id, fingerprint, timestamp FROM whatsmeow_app_state_sync_keys WHERE jid=$1 ORDER BY timestamp DESC`
+	deleteOldAppStateSyncKeysQuery  = `
+		DELETE FROM whatsmeow_app_state_sync_keys WHERE jid=$1 AND timestamp<$2
+			AND timestamp<(SELECT MAX(timestamp) FROM whatsmeow_app_state_sync_keys WHERE jid=$1)
+	`
 )
 
 func (s *SQLStore) PutAppStateSyncKey(id []byte, key store.AppStateSyncKey) error {
@@ -250,13 +356,48 @@ func (s *SQLStore) PutAppStateSyncKey(id []byte, key store.AppStateSyncKey) erro
 
 func (s *SQLStore) GetAppStateSyncKey(id []byte) (*store.AppStateSyncKey, error) {
 	var key store.AppStateSyncKey
-	err := s.db.QueryRow(getAppStateSyncKeyQuery, s.JID, id).Scan(&key.Data, &key.Timestamp, &key.Fingerprint)
+	err := s.consistentReadDB().QueryRow(getAppStateSyncKeyQuery, s.JID, id).Scan(&key.Data, &key.Timestamp, &key.Fingerprint)
 	if errors.Is(err, sql.ErrNoRows) {
-		err = nil
+		return nil, nil
 	}
 	return &key, err
 }
 
+func (s *SQLStore) GetLatestAppStateSyncKeyID() ([]byte, error) {
+	var keyID []byte
+	err := s.consistentReadDB().QueryRow(getLatestAppStateSyncKeyIDQuery, s.JID).Scan(&keyID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return keyID, err
+}
+
+func (s *SQLStore) GetAllAppStateSyncKeys() ([]store.AppStateSyncKeyInfo, error) {
+	rows, err := s.consistentReadDB().Query(getAllAppStateSyncKeysQuery, s.JID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var keys []store.AppStateSyncKeyInfo
+	for rows.Next() {
+		var key store.AppStateSyncKeyInfo
+		err = rows.Scan(&key.ID, &key.Fingerprint, &key.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+func (s *SQLStore) DeleteOldAppStateSyncKeys(before int64) (int64, error) {
+	res, err := s.db.Exec(deleteOldAppStateSyncKeysQuery, s.JID, before)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
 const (
 	putAppStateVersionQuery = `
 		INSERT INTO whatsmeow_app_state_version (jid, name, version, hash) VALUES ($1, $2, $3, $4)
@@ -264,6 +405,7 @@ const (
 	`
 	getAppStateVersionQuery                 = `SELECT version, hash FROM whatsmeow_app_state_version WHERE jid=$1 AND name=$2`
 	deleteAppStateVersionQuery              = `DELETE FROM whatsmeow_app_state_version WHERE jid=$1 AND name=$2`
+	deleteAllAppStateMutationMACsQuery      = `DELETE FROM whatsmeow_app_state_mutation_macs WHERE jid=$1 AND name=$2`
 	putAppStateMutationMACsQuery            = `INSERT INTO whatsmeow_app_state_mutation_macs (jid, name, version, index_mac, value_mac) VALUES `
 	deleteAppStateMutationMACsQueryPostgres = `DELETE FROM whatsmeow_app_state_mutation_macs WHERE jid=$1 AND name=$2 AND index_mac=ANY($3::bytea[])`
 	deleteAppStateMutationMACsQueryGeneric  = `DELETE FROM whatsmeow_app_state_mutation_macs WHERE jid=$1 AND name=$2 AND index_mac IN `
@@ -294,12 +436,19 @@ func (s *SQLStore) GetAppStateVersion(name string) (version uint64, hash [128]by
 }
 
 func (s *SQLStore) DeleteAppStateVersion(name string) error {
-	_, err := s.db.Exec(deleteAppStateVersionQuery, s.JID, name)
+	// The MACs would be removed by the foreign key cascade too, but SQLite doesn't enforce foreign keys by default.
+	_, err := s.db.Exec(deleteAllAppStateMutationMACsQuery, s.JID, name)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Exec(deleteAppStateVersionQuery, s.JID, name)
 	return err
 }
 
 type execable interface {
 	Exec(query string, args ...interface{}) (sql.Result, error)
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
 }
 
 func (s *SQLStore) putAppStateMutationMACs(tx execable, name string, version uint64, mutations []store.AppStateMutationMAC) error {
@@ -321,8 +470,20 @@ func (s *SQLStore) putAppStateMutationMACs(tx execable, name string, version uin
 const mutationBatchSize = 400
 
 func (s *SQLStore) PutAppStateMutationMACs(name string, version uint64, mutations []store.AppStateMutationMAC) error {
-	if len(mutations) > mutationBatchSize {
-		tx, err := s.db.Begin()
+	if len(mutations) > mutationBatchSize && s.inTx {
+		for i := 0; i < len(mutations); i += mutationBatchSize {
+			end := i + mutationBatchSize
+			if end > len(mutations) {
+				end = len(mutations)
+			}
+			err := s.putAppStateMutationMACs(s.db, name, version, mutations[i:end])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	} else if len(mutations) > mutationBatchSize {
+		tx, err := s.Container.db.Begin()
 		if err != nil {
 			return fmt.Errorf("failed to start transaction: %w", err)
 		}
@@ -394,8 +555,24 @@ const (
 	getContactQuery = `
 		SELECT first_name, full_name, push_name, business_name FROM whatsmeow_contacts WHERE our_jid=$1 AND their_jid=$2
 	`
+	// %[1]s is the LIKE operator to use, $2 is the substring pattern and $3 is the prefix pattern.
+	searchContactsQuery = `
+		SELECT their_jid, first_name, full_name, push_name, business_name FROM whatsmeow_contacts
+		WHERE our_jid=$1 AND (
+			full_name %[1]s $2 ESCAPE '\' OR first_name %[1]s $2 ESCAPE '\' OR
+			push_name %[1]s $2 ESCAPE '\' OR business_name %[1]s $2 ESCAPE '\'
+		)
+		ORDER BY
+			CASE WHEN full_name %[1]s $3 ESCAPE '\' OR first_name %[1]s $3 ESCAPE '\' OR
+			          push_name %[1]s $3 ESCAPE '\' OR business_name %[1]s $3 ESCAPE '\'
+			THEN 0 ELSE 1 END,
+			COALESCE(NULLIF(full_name, ''), NULLIF(push_name, ''), NULLIF(business_name, ''), their_jid)
+		LIMIT $4
+	`
 )
 
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
 func (s *SQLStore) PutPushName(user types.JID, pushName string) (bool, string, error) {
 	s.contactCacheLock.Lock()
 	defer s.contactCacheLock.Unlock()
@@ -411,7 +588,8 @@ func (s *SQLStore) PutPushName(user types.JID, pushName string) (bool, string, e
 		}
 		previousName := cached.PushName
 		cached.PushName = pushName
-		s.contactCache[user] = cached
+		cached.Found = true
+		s.contactCache.Put(user, cached)
 		return true, previousName, nil
 	}
 	return false, "", nil
@@ -431,6 +609,7 @@ func (s *SQLStore) PutBusinessName(user types.JID, businessName string) error {
 			return err
 		}
 		cached.BusinessName = businessName
+		cached.Found = true
 	}
 	return nil
 }
@@ -450,32 +629,48 @@ func (s *SQLStore) PutContactName(user types.JID, firstName, fullName string) er
 		}
 		cached.FirstName = firstName
 		cached.FullName = fullName
+		cached.Found = true
 	}
 	return nil
 }
 
 func (s *SQLStore) getContact(user types.JID) (*types.ContactInfo, error) {
-	cached, ok := s.contactCache[user]
+	cached, ok := s.contactCache.Get(user)
 	if ok {
 		return cached, nil
 	}
 
 	var first, full, push, business sql.NullString
-	err := s.db.QueryRow(getContactQuery, s.JID, user).Scan(&first, &full, &push, &business)
+	err := s.readDB.QueryRow(getContactQuery, s.JID, user).Scan(&first, &full, &push, &business)
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return nil, err
 	}
 	info := &types.ContactInfo{
+		JID:          user,
 		Found:        err == nil,
 		FirstName:    first.String,
 		FullName:     full.String,
 		PushName:     push.String,
 		BusinessName: business.String,
 	}
-	s.contactCache[user] = info
+	s.contactCache.Put(user, info)
 	return info, nil
 }
 
+// FlushContactCache removes all contacts from the in-memory cache. They'll be read from the database again when needed.
+func (s *SQLStore) FlushContactCache() {
+	s.contactCacheLock.Lock()
+	s.contactCache.Clear()
+	s.contactCacheLock.Unlock()
+}
+
+// ContactCacheSize returns the number of contacts in the in-memory cache.
+func (s *SQLStore) ContactCacheSize() int {
+	s.contactCacheLock.Lock()
+	defer s.contactCacheLock.Unlock()
+	return s.contactCache.Len()
+}
+
 func (s *SQLStore) GetContact(user types.JID) (types.ContactInfo, error) {
 	s.contactCacheLock.Lock()
 	info, err := s.getContact(user)
@@ -486,6 +681,40 @@ func (s *SQLStore) GetContact(user types.JID) (types.ContactInfo, error) {
 	return *info, nil
 }
 
+func (s *SQLStore) SearchContacts(query string, limit int) ([]types.ContactInfo, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+	likeOperator := "LIKE"
+	if s.dialect == "postgres" {
+		likeOperator = "ILIKE"
+	}
+	escaped := likeEscaper.Replace(query)
+	rows, err := s.readDB.Query(fmt.Sprintf(searchContactsQuery, likeOperator), s.JID, "%"+escaped+"%", escaped+"%", limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var contacts []types.ContactInfo
+	for rows.Next() {
+		var jid types.JID
+		var first, full, push, business sql.NullString
+		err = rows.Scan(&jid, &first, &full, &push, &business)
+		if err != nil {
+			return nil, err
+		}
+		contacts = append(contacts, types.ContactInfo{
+			JID:          jid,
+			Found:        true,
+			FirstName:    first.String,
+			FullName:     full.String,
+			PushName:     push.String,
+			BusinessName: business.String,
+		})
+	}
+	return contacts, rows.Err()
+}
+
 const (
 	putChatSettingQuery = `
 		INSERT INTO whatsmeow_chat_settings (our_jid, chat_jid, %[1]s) VALUES ($1, $2, $3)
@@ -517,7 +746,7 @@ func (s *SQLStore) PutArchived(chat types.JID, archived bool) error {
 
 func (s *SQLStore) GetChatSettings(chat types.JID) (settings types.LocalChatSettings, err error) {
 	var mutedUntil int64
-	err = s.db.QueryRow(getChatSettingsQuery, s.JID, chat).Scan(&mutedUntil, &settings.Pinned, &settings.Archived)
+	err = s.readDB.QueryRow(getChatSettingsQuery, s.JID, chat).Scan(&mutedUntil, &settings.Pinned, &settings.Archived)
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return
 	} else if err == nil {
@@ -528,3 +757,228 @@ func (s *SQLStore) GetChatSettings(chat types.JID) (settings types.LocalChatSett
 	}
 	return
 }
+
+const (
+	putLabelQuery = `
+		INSERT INTO whatsmeow_labels (our_jid, label_id, name, color, predefined_id) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (our_jid, label_id) DO UPDATE SET name=$3, color=$4, predefined_id=$5
+	`
+	deleteLabelQuery  = `DELETE FROM whatsmeow_labels WHERE our_jid=$1 AND label_id=$2`
+	getLabelQuery     = `SELECT label_id, name, color, predefined_id FROM whatsmeow_labels WHERE our_jid=$1 AND label_id=$2`
+	getAllLabelsQuery = `SELECT label_id, name, color, predefined_id FROM whatsmeow_labels WHERE our_jid=$1`
+)
+
+func (s *SQLStore) PutLabel(label types.Label) error {
+	_, err := s.db.Exec(putLabelQuery, s.JID, label.ID, label.Name, label.Color, label.PredefinedID)
+	return err
+}
+
+func (s *SQLStore) DeleteLabel(id string) error {
+	_, err := s.db.Exec(deleteLabelQuery, s.JID, id)
+	return err
+}
+
+func (s *SQLStore) GetLabel(id string) (*types.Label, error) {
+	var label types.Label
+	err := s.readDB.QueryRow(getLabelQuery, s.JID, id).Scan(&label.ID, &label.Name, &label.Color, &label.PredefinedID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &label, nil
+}
+
+func (s *SQLStore) GetAllLabels() ([]types.Label, error) {
+	rows, err := s.readDB.Query(getAllLabelsQuery, s.JID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var labels []types.Label
+	for rows.Next() {
+		var label types.Label
+		err = rows.Scan(&label.ID, &label.Name, &label.Color, &label.PredefinedID)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, label)
+	}
+	return labels, rows.Err()
+}
+
+const (
+	putOutgoingMessageQuery = `
+		INSERT INTO whatsmeow_outbox (our_jid, message_id, to_jid, message, timestamp, attempts) VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (our_jid, message_id) DO UPDATE SET attempts=$6
+	`
+	deleteOutgoingMessageQuery = `DELETE FROM whatsmeow_outbox WHERE our_jid=$1 AND message_id=$2`
+	getOutgoingMessagesQuery   = `SELECT message_id, to_jid, message, timestamp, attempts FROM whatsmeow_outbox WHERE our_jid=$1 ORDER BY timestamp`
+)
+
+func (s *SQLStore) PutOutgoingMessage(msg store.OutgoingMessage) error {
+	_, err := s.db.Exec(putOutgoingMessageQuery, s.JID, msg.ID, msg.To, msg.Message, msg.Timestamp.UnixMilli(), msg.Attempts)
+	return err
+}
+
+func (s *SQLStore) DeleteOutgoingMessage(id types.MessageID) error {
+	_, err := s.db.Exec(deleteOutgoingMessageQuery, s.JID, id)
+	return err
+}
+
+func (s *SQLStore) GetOutgoingMessages() ([]store.OutgoingMessage, error) {
+	// The outbox is read right after reconnecting, so it must not lag behind writes made before the restart.
+	rows, err := s.db.Query(getOutgoingMessagesQuery, s.JID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var msgs []store.OutgoingMessage
+	for rows.Next() {
+		var msg store.OutgoingMessage
+		var timestamp int64
+		err = rows.Scan(&msg.ID, &msg.To, &msg.Message, &timestamp, &msg.Attempts)
+		if err != nil {
+			return nil, err
+		}
+		msg.Timestamp = time.UnixMilli(timestamp)
+		msgs = append(msgs, msg)
+	}
+	return msgs, rows.Err()
+}
+
+const (
+	// Removed reactions are stored with an empty emoji, so that the timestamp of the removal is kept
+	// and older reactions that are received after it don't reappear.
+	putReactionQuery = `
+		INSERT INTO whatsmeow_reactions (our_jid, chat_jid, message_id, sender_jid, emoji, timestamp) VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (our_jid, chat_jid, message_id, sender_jid) DO UPDATE SET emoji=$5, timestamp=$6
+		WHERE whatsmeow_reactions.timestamp<=$6
+	`
+	getReactionsQuery = `SELECT sender_jid, emoji, timestamp FROM whatsmeow_reactions WHERE our_jid=$1 AND chat_jid=$2 AND message_id=$3 AND emoji<>'' ORDER BY timestamp`
+)
+
+func (s *SQLStore) PutReaction(chat types.JID, messageID types.MessageID, reaction types.Reaction) error {
+	_, err := s.db.Exec(putReactionQuery, s.JID, chat, messageID, reaction.Sender, reaction.Emoji, reaction.Timestamp.UnixMilli())
+	return err
+}
+
+func (s *SQLStore) GetReactions(chat types.JID, messageID types.MessageID) ([]types.Reaction, error) {
+	rows, err := s.readDB.Query(getReactionsQuery, s.JID, chat, messageID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var reactions []types.Reaction
+	for rows.Next() {
+		var reaction types.Reaction
+		var timestamp int64
+		err = rows.Scan(&reaction.Sender, &reaction.Emoji, &timestamp)
+		if err != nil {
+			return nil, err
+		}
+		reaction.Timestamp = time.UnixMilli(timestamp)
+		reactions = append(reactions, reaction)
+	}
+	return reactions, rows.Err()
+}
+
+const (
+	putMessageSecretQuery = `
+		INSERT INTO whatsmeow_message_secrets (our_jid, chat_jid, sender_jid, message_id, secret) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (our_jid, chat_jid, sender_jid, message_id) DO NOTHING
+	`
+	getMessageSecretQuery = `SELECT secret FROM whatsmeow_message_secrets WHERE our_jid=$1 AND chat_jid=$2 AND sender_jid=$3 AND message_id=$4`
+)
+
+func (s *SQLStore) PutMessageSecret(chat, sender types.JID, id types.MessageID, secret []byte) error {
+	_, err := s.db.Exec(putMessageSecretQuery, s.JID, chat, sender, id, secret)
+	return err
+}
+
+func (s *SQLStore) GetMessageSecret(chat, sender types.JID, id types.MessageID) (secret []byte, err error) {
+	err = s.readDB.QueryRow(getMessageSecretQuery, s.JID, chat, sender, id).Scan(&secret)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = nil
+	}
+	return
+}
+
+const (
+	putPollQuery = `
+		INSERT INTO whatsmeow_polls (our_jid, chat_jid, poll_id, sender_jid, name, options, selectable_count) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (our_jid, chat_jid, poll_id) DO NOTHING
+	`
+	getPollQuery     = `SELECT sender_jid, name, options, selectable_count FROM whatsmeow_polls WHERE our_jid=$1 AND chat_jid=$2 AND poll_id=$3`
+	putPollVoteQuery = `
+		INSERT INTO whatsmeow_poll_votes (our_jid, chat_jid, poll_id, voter_jid, selected_options, timestamp) VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (our_jid, chat_jid, poll_id, voter_jid) DO UPDATE SET selected_options=$5, timestamp=$6
+		WHERE whatsmeow_poll_votes.timestamp<=$6
+	`
+	getPollVotesQuery = `SELECT voter_jid, selected_options, timestamp FROM whatsmeow_poll_votes WHERE our_jid=$1 AND chat_jid=$2 AND poll_id=$3 ORDER BY timestamp`
+)
+
+// pollOptionHashLength is the length of the SHA-256 hashes that identify poll options in votes.
+// The selected options of each vote are stored concatenated in a single column.
+const pollOptionHashLength = 32
+
+func (s *SQLStore) PutPoll(chat types.JID, poll types.Poll) error {
+	options, err := json.Marshal(poll.Options)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Exec(putPollQuery, s.JID, chat, poll.ID, poll.Sender, poll.Name, string(options), poll.SelectableOptionsCount)
+	return err
+}
+
+func (s *SQLStore) GetPoll(chat types.JID, pollID types.MessageID) (*types.Poll, error) {
+	poll := types.Poll{ID: pollID}
+	var options string
+	err := s.readDB.QueryRow(getPollQuery, s.JID, chat, pollID).Scan(&poll.Sender, &poll.Name, &options, &poll.SelectableOptionsCount)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	} else if err = json.Unmarshal([]byte(options), &poll.Options); err != nil {
+		return nil, fmt.Errorf("failed to parse poll options: %w", err)
+	}
+	return &poll, nil
+}
+
+func (s *SQLStore) PutPollVote(chat types.JID, pollID types.MessageID, vote types.PollVote) error {
+	selectedOptions := make([]byte, 0, len(vote.SelectedOptions)*pollOptionHashLength)
+	for _, option := range vote.SelectedOptions {
+		if len(option) != pollOptionHashLength {
+			return fmt.Errorf("%w (poll option hash is %d bytes)", ErrInvalidLength, len(option))
+		}
+		selectedOptions = append(selectedOptions, option...)
+	}
+	_, err := s.db.Exec(putPollVoteQuery, s.JID, chat, pollID, vote.Voter, selectedOptions, vote.Timestamp.UnixMilli())
+	return err
+}
+
+func (s *SQLStore) GetPollVotes(chat types.JID, pollID types.MessageID) ([]types.PollVote, error) {
+	rows, err := s.readDB.Query(getPollVotesQuery, s.JID, chat, pollID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var votes []types.PollVote
+	for rows.Next() {
+		var vote types.PollVote
+		var selectedOptions []byte
+		var timestamp int64
+		err = rows.Scan(&vote.Voter, &selectedOptions, &timestamp)
+		if err != nil {
+			return nil, err
+		} else if len(selectedOptions)%pollOptionHashLength != 0 {
+			return nil, ErrInvalidLength
+		}
+		for i := 0; i < len(selectedOptions); i += pollOptionHashLength {
+			vote.SelectedOptions = append(vote.SelectedOptions, selectedOptions[i:i+pollOptionHashLength])
+		}
+		vote.Timestamp = time.UnixMilli(timestamp)
+		votes = append(votes, vote)
+	}
+	return votes, rows.Err()
+}
@@ -0,0 +1,304 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package sqlstore
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+// fakePreKeyTable emulates the whatsmeow_pre_keys table of a single device, including the primary key constraint.
+type fakePreKeyTable struct {
+	lock     sync.Mutex
+	uploaded map[int64]bool
+	privs    map[int64][]byte
+}
+
+func (tbl *fakePreKeyTable) exec(query string, args []driver.NamedValue) error {
+	tbl.lock.Lock()
+	defer tbl.lock.Unlock()
+	type row struct {
+		id       int64
+		priv     []byte
+		uploaded bool
+	}
+	var rows []row
+	switch {
+	case strings.HasPrefix(query, "INSERT INTO whatsmeow_pre_keys (jid, key_id, key, uploaded)"):
+		rows = append(rows, row{args[1].Value.(int64), args[2].Value.([]byte), args[3].Value.(bool)})
+	case strings.HasPrefix(query, "INSERT INTO whatsmeow_pre_keys (jid, uploaded, key_id, key)"):
+		for i := 2; i < len(args); i += 2 {
+			rows = append(rows, row{args[i].Value.(int64), args[i+1].Value.([]byte), args[1].Value.(bool)})
+		}
+	}
+	for _, r := range rows {
+		if _, exists := tbl.uploaded[r.id]; exists {
+			return errors.New("UNIQUE constraint failed: whatsmeow_pre_keys.jid, whatsmeow_pre_keys.key_id")
+		}
+	}
+	for _, r := range rows {
+		tbl.uploaded[r.id] = r.uploaded
+		tbl.privs[r.id] = r.priv
+	}
+	return nil
+}
+
+func (tbl *fakePreKeyTable) query(query string, args []driver.NamedValue) (driver.Rows, error) {
+	// Emulate some database latency to give concurrent callers a chance to interleave
+	time.Sleep(time.Millisecond)
+	tbl.lock.Lock()
+	defer tbl.lock.Unlock()
+	switch {
+	case strings.HasPrefix(query, "SELECT MAX(key_id) FROM whatsmeow_pre_keys"):
+		var max driver.Value
+		for id := range tbl.uploaded {
+			if max == nil || id > max.(int64) {
+				max = id
+			}
+		}
+		return &fakeUpgradeRows{values: [][]driver.Value{{max}}}, nil
+	case strings.HasPrefix(query, "SELECT key_id, key FROM whatsmeow_pre_keys WHERE jid=$1 AND uploaded=false"):
+		var ids []int64
+		for id, uploaded := range tbl.uploaded {
+			if !uploaded {
+				ids = append(ids, id)
+			}
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		rows := &fakeUpgradeRows{columns: 2}
+		for i, id := range ids {
+			if int64(i) >= args[1].Value.(int64) {
+				break
+			}
+			rows.values = append(rows.values, []driver.Value{id, tbl.privs[id]})
+		}
+		return rows, nil
+	default:
+		return &fakeUpgradeRows{}, nil
+	}
+}
+
+func TestConcurrentPreKeyGeneration(t *testing.T) {
+	tbl := &fakePreKeyTable{uploaded: make(map[int64]bool), privs: make(map[int64][]byte)}
+	container := newFakeUpgradeContainer(t, &fakeUpgradeDB{onExec: tbl.exec, onQuery: tbl.query})
+	jid := types.NewADJID("1234567890", 0, 5)
+
+	const goroutines = 50
+	const batchSize = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, goroutines)
+	batches := make(chan []uint32, goroutines)
+	wg.Add(goroutines)
+	for i := 0; i < goroutines; i++ {
+		go func(i int) {
+			defer wg.Done()
+			// Use a separate store for each goroutine, like a device loaded twice or a transaction store would
+			sqlStore := NewSQLStore(container, jid)
+			if i%2 == 0 {
+				_, err := sqlStore.GenOnePreKey()
+				errs <- err
+				return
+			}
+			preKeys, err := sqlStore.GetOrGenPreKeys(batchSize)
+			errs <- err
+			ids := make([]uint32, 0, len(preKeys))
+			for _, key := range preKeys {
+				if key != nil {
+					ids = append(ids, key.KeyID)
+				}
+			}
+			batches <- ids
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	close(batches)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("Failed to generate pre-keys: %v", err)
+		}
+	}
+
+	uploaded, unuploaded := 0, 0
+	for _, isUploaded := range tbl.uploaded {
+		if isUploaded {
+			uploaded++
+		} else {
+			unuploaded++
+		}
+	}
+	if uploaded != goroutines/2 || unuploaded != batchSize {
+		t.Errorf("Expected %d single keys and %d batch keys, got %d and %d", goroutines/2, batchSize, uploaded, unuploaded)
+	}
+	var first []uint32
+	for ids := range batches {
+		if len(ids) != batchSize {
+			t.Fatalf("Expected %d keys in each batch, got %d", batchSize, len(ids))
+		} else if first == nil {
+			first = ids
+		}
+		for i := range ids {
+			if ids[i] != first[i] {
+				t.Fatalf("Expected all callers to get the same unuploaded keys, got %v and %v", first, ids)
+			}
+		}
+	}
+}
+
+func TestPutNameMarksContactFound(t *testing.T) {
+	var queries int
+	container := newFakeUpgradeContainer(t, &fakeUpgradeDB{onQuery: func(query string, args []driver.NamedValue) (driver.Rows, error) {
+		queries++
+		// Nothing is stored about any contact yet
+		return &fakeUpgradeRows{}, nil
+	}})
+	sqlStore := NewSQLStore(container, types.NewADJID("1234567890", 0, 5))
+
+	pushNameUser := types.NewJID("1111111111", types.DefaultUserServer)
+	changed, previous, err := sqlStore.PutPushName(pushNameUser, "Alice")
+	if err != nil {
+		t.Fatalf("Failed to store push name: %v", err)
+	} else if !changed || previous != "" {
+		t.Errorf("Expected first push name to be a change from an empty name, got changed=%t previous=%q", changed, previous)
+	}
+	businessUser := types.NewJID("2222222222", types.DefaultUserServer)
+	if err = sqlStore.PutBusinessName(businessUser, "Bob's Bakery"); err != nil {
+		t.Fatalf("Failed to store business name: %v", err)
+	}
+	contactUser := types.NewJID("3333333333", types.DefaultUserServer)
+	if err = sqlStore.PutContactName(contactUser, "Carol", "Carol Smith"); err != nil {
+		t.Fatalf("Failed to store contact name: %v", err)
+	}
+
+	queriesBefore := queries
+	for _, user := range []types.JID{pushNameUser, businessUser, contactUser} {
+		info, err := sqlStore.GetContact(user)
+		if err != nil {
+			t.Fatalf("Failed to get contact %s: %v", user, err)
+		} else if !info.Found {
+			t.Errorf("Expected contact %s to be found after storing a name", user)
+		}
+	}
+	if queries != queriesBefore {
+		t.Errorf("Expected contacts to be read from the cache, but the database was queried %d times", queries-queriesBefore)
+	}
+	if info, err := sqlStore.GetContact(types.NewJID("4444444444", types.DefaultUserServer)); err != nil {
+		t.Fatalf("Failed to get contact: %v", err)
+	} else if info.Found {
+		t.Error("Expected unknown contact to not be found")
+	}
+}
+
+// fakeReactionTable emulates the whatsmeow_reactions table of a single chat message, including the conditional upsert.
+type fakeReactionTable map[string][]driver.Value
+
+func (tbl fakeReactionTable) exec(query string, args []driver.NamedValue) error {
+	if !strings.HasPrefix(query, "\n\t\tINSERT INTO whatsmeow_reactions") {
+		return fmt.Errorf("unexpected query %q", query)
+	} else if !strings.Contains(query, "WHERE whatsmeow_reactions.timestamp<=$6") {
+		return errors.New("upsert doesn't compare timestamps")
+	}
+	sender, emoji, timestamp := args[3].Value.(string), args[4].Value.(string), args[5].Value.(int64)
+	if existing, ok := tbl[sender]; !ok || existing[2].(int64) <= timestamp {
+		tbl[sender] = []driver.Value{sender, emoji, timestamp}
+	}
+	return nil
+}
+
+func (tbl fakeReactionTable) query(query string, args []driver.NamedValue) (driver.Rows, error) {
+	rows := &fakeUpgradeRows{columns: 3}
+	for _, row := range tbl {
+		if row[1].(string) == "" && strings.Contains(query, "emoji<>''") {
+			continue
+		}
+		rows.values = append(rows.values, row)
+	}
+	sort.Slice(rows.values, func(i, j int) bool { return rows.values[i][2].(int64) < rows.values[j][2].(int64) })
+	return rows, nil
+}
+
+func TestRemovedReactionTombstone(t *testing.T) {
+	tbl := fakeReactionTable{}
+	container := newFakeUpgradeContainer(t, &fakeUpgradeDB{onExec: tbl.exec, onQuery: tbl.query})
+	sqlStore := NewSQLStore(container, types.NewADJID("1234567890", 0, 5))
+	chat := types.NewJID("123456789-987654321", types.GroupServer)
+	alice := types.NewJID("1111111111", types.DefaultUserServer)
+	bob := types.NewJID("2222222222", types.DefaultUserServer)
+	put := func(sender types.JID, emoji string, ts int64) {
+		err := sqlStore.PutReaction(chat, "target", types.Reaction{Sender: sender, Emoji: emoji, Timestamp: time.UnixMilli(ts)})
+		if err != nil {
+			t.Fatalf("Failed to store reaction: %v", err)
+		}
+	}
+
+	put(alice, "👍", 1000)
+	put(bob, "❤️", 1001)
+	put(alice, "", 1002)
+	// The reaction that was removed arrives again late, which must not bring it back
+	put(alice, "👍", 1000)
+	reactions, err := sqlStore.GetReactions(chat, "target")
+	if err != nil {
+		t.Fatalf("Failed to get reactions: %v", err)
+	}
+	if len(reactions) != 1 || reactions[0].Sender != bob {
+		t.Errorf("Expected only Bob's reaction after Alice removed hers, got %+v", reactions)
+	}
+
+	// Newer reactions replace the tombstone
+	put(alice, "😂", 1003)
+	reactions, _ = sqlStore.GetReactions(chat, "target")
+	if len(reactions) != 2 || reactions[1].Sender != alice || reactions[1].Emoji != "😂" {
+		t.Errorf("Expected Alice's new reaction to replace the removal, got %+v", reactions)
+	}
+}
+
+func TestPollVoteSelectedOptions(t *testing.T) {
+	var stored []driver.Value
+	container := newFakeUpgradeContainer(t, &fakeUpgradeDB{
+		onExec: func(query string, args []driver.NamedValue) error {
+			if !strings.Contains(query, "WHERE whatsmeow_poll_votes.timestamp<=$6") {
+				return errors.New("upsert doesn't compare timestamps")
+			}
+			stored = []driver.Value{args[3].Value, args[4].Value, args[5].Value}
+			return nil
+		},
+		onQuery: func(query string, args []driver.NamedValue) (driver.Rows, error) {
+			return &fakeUpgradeRows{columns: 3, values: [][]driver.Value{stored}}, nil
+		},
+	})
+	sqlStore := NewSQLStore(container, types.NewADJID("1234567890", 0, 5))
+	chat := types.NewJID("123456789-987654321", types.GroupServer)
+	vote := types.PollVote{
+		Voter:           types.NewJID("1111111111", types.DefaultUserServer),
+		SelectedOptions: [][]byte{bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{2}, 32)},
+		Timestamp:       time.UnixMilli(1000),
+	}
+	if err := sqlStore.PutPollVote(chat, "poll", vote); err != nil {
+		t.Fatalf("Failed to store vote: %v", err)
+	}
+	votes, err := sqlStore.GetPollVotes(chat, "poll")
+	if err != nil {
+		t.Fatalf("Failed to get votes: %v", err)
+	} else if len(votes) != 1 || !reflect.DeepEqual(votes[0], vote) {
+		t.Errorf("Expected stored vote %+v, got %+v", vote, votes)
+	}
+
+	vote.SelectedOptions = [][]byte{[]byte("not a hash")}
+	if err = sqlStore.PutPollVote(chat, "poll", vote); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("Expected ErrInvalidLength for invalid option hash, got %v", err)
+	}
+}
@@ -8,6 +8,12 @@ package sqlstore
 
 import (
 	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.mau.fi/whatsmeow/types"
 )
 
 type upgradeFunc func(*sql.Tx, *Container) error
@@ -148,18 +154,296 @@ var Upgrades = [...]upgradeFunc{
 		}
 		return nil
 	},
+	func(tx *sql.Tx, _ *Container) error {
+		_, err := tx.Exec(`CREATE TABLE whatsmeow_labels (
+			our_jid       TEXT,
+			label_id      TEXT,
+			name          TEXT    NOT NULL,
+			color         INTEGER NOT NULL DEFAULT 0,
+			predefined_id INTEGER NOT NULL DEFAULT 0,
+
+			PRIMARY KEY (our_jid, label_id),
+			FOREIGN KEY (our_jid) REFERENCES whatsmeow_device(jid) ON DELETE CASCADE ON UPDATE CASCADE
+		)`)
+		return err
+	},
+	func(tx *sql.Tx, _ *Container) error {
+		err := normalizeAddressColumn(tx, "whatsmeow_identity_keys", "their_id", "our_jid")
+		if err != nil {
+			return err
+		}
+		err = normalizeAddressColumn(tx, "whatsmeow_sessions", "their_id", "our_jid")
+		if err != nil {
+			return err
+		}
+		return normalizeAddressColumn(tx, "whatsmeow_sender_keys", "sender_id", "our_jid", "chat_id")
+	},
+	func(tx *sql.Tx, _ *Container) error {
+		_, err := tx.Exec(`CREATE TABLE whatsmeow_outbox (
+			our_jid    TEXT,
+			message_id TEXT,
+			to_jid     TEXT    NOT NULL,
+			message    bytea   NOT NULL,
+			timestamp  BIGINT  NOT NULL,
+			attempts   INTEGER NOT NULL DEFAULT 0,
+
+			PRIMARY KEY (our_jid, message_id),
+			FOREIGN KEY (our_jid) REFERENCES whatsmeow_device(jid) ON DELETE CASCADE ON UPDATE CASCADE
+		)`)
+		return err
+	},
+	func(tx *sql.Tx, _ *Container) error {
+		_, err := tx.Exec(`ALTER TABLE whatsmeow_device ADD COLUMN deleted_at BIGINT`)
+		return err
+	},
+	func(tx *sql.Tx, _ *Container) error {
+		_, err := tx.Exec(`ALTER TABLE whatsmeow_device ADD COLUMN noise_key_ref TEXT NOT NULL DEFAULT ''`)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`ALTER TABLE whatsmeow_device ADD COLUMN identity_key_ref TEXT NOT NULL DEFAULT ''`)
+		return err
+	},
+	func(tx *sql.Tx, _ *Container) error {
+		_, err := tx.Exec(`CREATE TABLE whatsmeow_reactions (
+			our_jid    TEXT,
+			chat_jid   TEXT,
+			message_id TEXT,
+			sender_jid TEXT,
+			emoji      TEXT   NOT NULL,
+			timestamp  BIGINT NOT NULL,
+
+			PRIMARY KEY (our_jid, chat_jid, message_id, sender_jid),
+			FOREIGN KEY (our_jid) REFERENCES whatsmeow_device(jid) ON DELETE CASCADE ON UPDATE CASCADE
+		)`)
+		return err
+	},
+	func(tx *sql.Tx, _ *Container) error {
+		_, err := tx.Exec(`CREATE TABLE whatsmeow_message_secrets (
+			our_jid    TEXT,
+			chat_jid   TEXT,
+			sender_jid TEXT,
+			message_id TEXT,
+			secret     bytea NOT NULL,
+
+			PRIMARY KEY (our_jid, chat_jid, sender_jid, message_id),
+			FOREIGN KEY (our_jid) REFERENCES whatsmeow_device(jid) ON DELETE CASCADE ON UPDATE CASCADE
+		)`)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`CREATE TABLE whatsmeow_polls (
+			our_jid          TEXT,
+			chat_jid         TEXT,
+			poll_id          TEXT,
+			sender_jid       TEXT    NOT NULL,
+			name             TEXT    NOT NULL,
+			options          TEXT    NOT NULL,
+			selectable_count INTEGER NOT NULL,
+
+			PRIMARY KEY (our_jid, chat_jid, poll_id),
+			FOREIGN KEY (our_jid) REFERENCES whatsmeow_device(jid) ON DELETE CASCADE ON UPDATE CASCADE
+		)`)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`CREATE TABLE whatsmeow_poll_votes (
+			our_jid          TEXT,
+			chat_jid         TEXT,
+			poll_id          TEXT,
+			voter_jid        TEXT,
+			selected_options bytea  NOT NULL,
+			timestamp        BIGINT NOT NULL,
+
+			PRIMARY KEY (our_jid, chat_jid, poll_id, voter_jid),
+			FOREIGN KEY (our_jid) REFERENCES whatsmeow_device(jid) ON DELETE CASCADE ON UPDATE CASCADE
+		)`)
+		return err
+	},
+}
+
+// Downgrades contains the functions that reverse the migrations in Upgrades: the function at index i downgrades
+// the database from version i+1 to version i. Migrations that can't be reversed without losing data have nil
+// functions, and Container.Downgrade refuses to go past them.
+var Downgrades = [len(Upgrades)]upgradeFunc{
+	nil,
+	func(tx *sql.Tx, _ *Container) error {
+		_, err := tx.Exec(`DROP TABLE whatsmeow_labels`)
+		return err
+	},
+	nil,
+	func(tx *sql.Tx, _ *Container) error {
+		_, err := tx.Exec(`DROP TABLE whatsmeow_outbox`)
+		return err
+	},
+	func(tx *sql.Tx, _ *Container) error {
+		// Soft-deleted devices would come back to life without the deleted_at column
+		var hasDeleted bool
+		err := tx.QueryRow(`SELECT EXISTS(SELECT 1 FROM whatsmeow_device WHERE deleted_at IS NOT NULL)`).Scan(&hasDeleted)
+		if err != nil {
+			return fmt.Errorf("failed to check for soft-deleted devices: %w", err)
+		} else if hasDeleted {
+			return fmt.Errorf("database contains soft-deleted devices, remove them with PurgeDeletedDevices first")
+		}
+		_, err = tx.Exec(`ALTER TABLE whatsmeow_device DROP COLUMN deleted_at`)
+		return err
+	},
+	func(tx *sql.Tx, _ *Container) error {
+		// Devices with external keys only have the public noise key in the database, which older versions can't use
+		var hasRefs bool
+		err := tx.QueryRow(`SELECT EXISTS(SELECT 1 FROM whatsmeow_device WHERE noise_key_ref<>'' OR identity_key_ref<>'')`).Scan(&hasRefs)
+		if err != nil {
+			return fmt.Errorf("failed to check for devices with external keys: %w", err)
+		} else if hasRefs {
+			return fmt.Errorf("database contains devices with external keys")
+		}
+		_, err = tx.Exec(`ALTER TABLE whatsmeow_device DROP COLUMN noise_key_ref`)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`ALTER TABLE whatsmeow_device DROP COLUMN identity_key_ref`)
+		return err
+	},
+	func(tx *sql.Tx, _ *Container) error {
+		_, err := tx.Exec(`DROP TABLE whatsmeow_reactions`)
+		return err
+	},
+	func(tx *sql.Tx, _ *Container) error {
+		_, err := tx.Exec(`DROP TABLE whatsmeow_poll_votes`)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`DROP TABLE whatsmeow_polls`)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`DROP TABLE whatsmeow_message_secrets`)
+		return err
+	},
+}
+
+// normalizeSignalAddress converts a user or device JID string into the canonical Signal address format
+// (user:device or user_agent:device, see types.JID.SignalAddress). The second return value is false
+// if the address was already in the canonical format or couldn't be parsed.
+func normalizeSignalAddress(address string) (string, bool) {
+	if !strings.ContainsRune(address, '@') {
+		if strings.ContainsRune(address, ':') {
+			return address, false
+		}
+		// Bare user IDs refer to the primary device.
+		return address + ":0", true
+	}
+	jid, err := types.ParseJID(address)
+	if err != nil || jid.Server != types.DefaultUserServer {
+		return address, false
+	}
+	if !jid.AD && strings.ContainsRune(jid.User, ':') {
+		// ParseJID only detects AD JIDs that have both an agent and a device.
+		parts := strings.SplitN(jid.User, ":", 2)
+		device, err := strconv.ParseUint(parts[1], 10, 8)
+		if err != nil {
+			return address, false
+		}
+		jid = types.NewADJID(parts[0], 0, uint8(device))
+	}
+	return jid.SignalAddress().String(), true
 }
 
+// normalizeAddressColumn rewrites all non-canonical Signal addresses in the given column.
+// If a row with the canonical address already exists, the non-canonical row is deleted instead.
+func normalizeAddressColumn(tx *sql.Tx, table, column string, keyColumns ...string) error {
+	rows, err := tx.Query(fmt.Sprintf("SELECT %s, %s FROM %s WHERE %s LIKE '%%@%%' OR %s NOT LIKE '%%:%%'",
+		strings.Join(keyColumns, ", "), column, table, column, column))
+	if err != nil {
+		return fmt.Errorf("failed to query %s: %w", table, err)
+	}
+	// Read all rows before modifying anything, as some drivers don't allow other queries while rows are open.
+	var toFix [][]interface{}
+	for rows.Next() {
+		values := make([]interface{}, len(keyColumns)+1)
+		pointers := make([]interface{}, len(values))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		err = rows.Scan(pointers...)
+		if err != nil {
+			_ = rows.Close()
+			return fmt.Errorf("failed to scan %s row: %w", table, err)
+		}
+		toFix = append(toFix, values)
+	}
+	_ = rows.Close()
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("failed to iterate %s rows: %w", table, err)
+	}
+
+	conditions := make([]string, len(keyColumns)+1)
+	for i, keyColumn := range keyColumns {
+		conditions[i] = fmt.Sprintf("%s=$%d", keyColumn, i+1)
+	}
+	conditions[len(keyColumns)] = fmt.Sprintf("%s=$%d", column, len(keyColumns)+1)
+	where := strings.Join(conditions, " AND ")
+	existsQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", table, where)
+	deleteQuery := fmt.Sprintf("DELETE FROM %s WHERE %s", table, where)
+	// SQLite numbers $N parameters in the order they appear, so the new value has to be $1 in the update query.
+	updateConditions := make([]string, len(keyColumns)+1)
+	for i, keyColumn := range append(keyColumns, column) {
+		updateConditions[i] = fmt.Sprintf("%s=$%d", keyColumn, i+2)
+	}
+	updateQuery := fmt.Sprintf("UPDATE %s SET %s=$1 WHERE %s", table, column, strings.Join(updateConditions, " AND "))
+	for _, values := range toFix {
+		var oldAddress string
+		switch address := values[len(keyColumns)].(type) {
+		case string:
+			oldAddress = address
+		case []byte:
+			oldAddress = string(address)
+		}
+		newAddress, changed := normalizeSignalAddress(oldAddress)
+		if !changed {
+			continue
+		}
+		canonicalValues := append(append([]interface{}{}, values[:len(keyColumns)]...), newAddress)
+		var count int
+		err = tx.QueryRow(existsQuery, canonicalValues...).Scan(&count)
+		if err != nil {
+			return fmt.Errorf("failed to check for existing %s row: %w", table, err)
+		}
+		if count > 0 {
+			// The canonical row is the one that has been used for encryption, so the other one is stale.
+			_, err = tx.Exec(deleteQuery, values...)
+		} else {
+			_, err = tx.Exec(updateQuery, append([]interface{}{newAddress}, values...)...)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to normalize address %s in %s: %w", oldAddress, table, err)
+		}
+	}
+	return nil
+}
+
+// ErrUnversionedDatabase is returned by Upgrade if the whatsmeow tables exist, but the version table is empty.
+//
+// Running the first migration would fail in that case, as the tables already exist. If you know which migrations
+// have been applied to the database (e.g. all of them), use Container.ForceVersion to record that before upgrading.
+var ErrUnversionedDatabase = errors.New("whatsmeow_device table exists, but the database has no version row")
+
+// ErrDatabaseTooNew is returned by Upgrade if the database version is newer than the latest version in Upgrades,
+// i.e. the database was last used by a newer version of whatsmeow.
+var ErrDatabaseTooNew = errors.New("database version is newer than the latest known version")
+
 func (c *Container) getVersion() (int, error) {
 	_, err := c.db.Exec("CREATE TABLE IF NOT EXISTS whatsmeow_version (version INTEGER)")
 	if err != nil {
-		return -1, err
+		return -1, fmt.Errorf("failed to create version table: %w", err)
 	}
 
 	version := 0
-	row := c.db.QueryRow("SELECT version FROM whatsmeow_version LIMIT 1")
-	if row != nil {
-		_ = row.Scan(&version)
+	err = c.db.QueryRow("SELECT version FROM whatsmeow_version LIMIT 1").Scan(&version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	} else if err != nil {
+		return -1, fmt.Errorf("failed to read database version: %w", err)
 	}
 	return version, nil
 }
@@ -173,35 +457,182 @@ func (c *Container) setVersion(tx *sql.Tx, version int) error {
 	return err
 }
 
-// Upgrade upgrades the database from the current to the latest version available.
-func (c *Container) Upgrade() error {
+func (c *Container) deviceTableExists() (bool, error) {
+	var query string
+	if c.dialect == "postgres" {
+		query = "SELECT EXISTS(SELECT 1 FROM information_schema.tables WHERE table_name='whatsmeow_device')"
+	} else {
+		query = "SELECT EXISTS(SELECT 1 FROM sqlite_master WHERE type='table' AND name='whatsmeow_device')"
+	}
+	var exists bool
+	err := c.db.QueryRow(query).Scan(&exists)
+	return exists, err
+}
+
+// ForceVersion records the given schema version in the database without running any migrations.
+//
+// This is only meant for repairing databases where the version row was lost (see ErrUnversionedDatabase).
+// Setting a version that doesn't match the actual schema will break the store.
+func (c *Container) ForceVersion(version int) error {
+	if version < 0 || version > len(Upgrades) {
+		return fmt.Errorf("invalid database version %d (latest is %d)", version, len(Upgrades))
+	}
+	_, err := c.db.Exec("CREATE TABLE IF NOT EXISTS whatsmeow_version (version INTEGER)")
+	if err != nil {
+		return fmt.Errorf("failed to create version table: %w", err)
+	}
+	tx, err := c.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	if err = c.setVersion(tx, version); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to set database version: %w", err)
+	}
+	return tx.Commit()
+}
+
+// getUpgradeVersion returns the current database version, or ErrUnversionedDatabase if
+// the version is unknown and migrating from scratch would fail, or ErrDatabaseTooNew if
+// the database was created by a newer version.
+func (c *Container) getUpgradeVersion() (int, error) {
+	version, err := c.getVersion()
+	if err != nil {
+		return -1, err
+	} else if version > len(Upgrades) {
+		return -1, fmt.Errorf("%w (database is v%d, latest known is v%d)", ErrDatabaseTooNew, version, len(Upgrades))
+	}
+
+	if version == 0 {
+		exists, err := c.deviceTableExists()
+		if err != nil {
+			return -1, fmt.Errorf("failed to check if device table exists: %w", err)
+		} else if exists {
+			return -1, ErrUnversionedDatabase
+		}
+	}
+	return version, nil
+}
+
+// MigrationStatus returns the current schema version of the database and the latest version that Upgrade would
+// migrate it to. The database is up-to-date if the two are equal.
+func (c *Container) MigrationStatus() (current, latest int, err error) {
+	current, err = c.getVersion()
+	return current, len(Upgrades), err
+}
+
+// PendingUpgrades returns the indices of the functions in Upgrades that Upgrade would run, without running them.
+// The function at index i upgrades the database to version i+1. An empty list means the database is up-to-date.
+//
+// Like Upgrade, this returns ErrUnversionedDatabase if the tables exist but the version row is missing,
+// and ErrDatabaseTooNew if the database is newer than the latest version in Upgrades.
+func (c *Container) PendingUpgrades() ([]int, error) {
+	version, err := c.getUpgradeVersion()
+	if err != nil {
+		return nil, err
+	}
+	pending := make([]int, 0, len(Upgrades))
+	for ; version < len(Upgrades); version++ {
+		pending = append(pending, version)
+	}
+	return pending, nil
+}
+
+// ErrIrreversibleMigration is returned by Downgrade if one of the migrations that would need to be reversed
+// doesn't have a down function in Downgrades.
+var ErrIrreversibleMigration = errors.New("migration can't be reversed")
+
+// Downgrade downgrades the database from the current version to the given older version by running the functions in
+// Downgrades in reverse order. Each step runs in its own transaction, so if one fails, the database is left at the
+// last version that was successfully downgraded to.
+//
+// Nothing is done if any of the migrations in between can't be reversed.
+func (c *Container) Downgrade(toVersion int) error {
 	version, err := c.getVersion()
 	if err != nil {
 		return err
 	}
+	if toVersion < 0 || toVersion > version {
+		return fmt.Errorf("invalid target version %d (current version is %d)", toVersion, version)
+	} else if version > len(Upgrades) {
+		return fmt.Errorf("unknown database version %d (latest known version is %d)", version, len(Upgrades))
+	}
+	for i := toVersion; i < version; i++ {
+		if Downgrades[i] == nil {
+			return fmt.Errorf("%w: v%d can't be downgraded to v%d", ErrIrreversibleMigration, i+1, i)
+		}
+	}
+
+	for ; version > toVersion; version-- {
+		var tx *sql.Tx
+		tx, err = c.db.Begin()
+		if err != nil {
+			return fmt.Errorf("failed to start transaction for downgrade to v%d: %w", version-1, err)
+		}
 
+		err = Downgrades[version-1](tx, c)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to downgrade database to v%d: %w", version-1, err)
+		}
+
+		if err = c.setVersion(tx, version-1); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to set database version to v%d: %w", version-1, err)
+		}
+
+		if err = tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit downgrade to v%d: %w", version-1, err)
+		}
+	}
+
+	return nil
+}
+
+// Upgrade upgrades the database from the current to the latest version available.
+//
+// Deprecated: use UpgradeWithResult to also get the versions that were applied.
+func (c *Container) Upgrade() error {
+	_, err := c.UpgradeWithResult()
+	return err
+}
+
+// UpgradeWithResult upgrades the database from the current to the latest version available.
+//
+// The returned list contains the versions that were upgraded to, e.g. [3, 4, 5] when upgrading from v2 to v5.
+// It's empty if the database was already up-to-date. If a migration fails, the versions that were applied before
+// the failure are returned along with the error.
+func (c *Container) UpgradeWithResult() (applied []int, err error) {
+	version, err := c.getUpgradeVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	applied = make([]int, 0, len(Upgrades)-version)
 	for ; version < len(Upgrades); version++ {
 		var tx *sql.Tx
 		tx, err = c.db.Begin()
 		if err != nil {
-			return err
+			return applied, fmt.Errorf("failed to start transaction for upgrade to v%d: %w", version+1, err)
 		}
 
 		migrateFunc := Upgrades[version]
 		err = migrateFunc(tx, c)
 		if err != nil {
 			_ = tx.Rollback()
-			return err
+			return applied, fmt.Errorf("failed to upgrade database to v%d: %w", version+1, err)
 		}
 
 		if err = c.setVersion(tx, version+1); err != nil {
-			return err
+			_ = tx.Rollback()
+			return applied, fmt.Errorf("failed to set database version to v%d: %w", version+1, err)
 		}
 
 		if err = tx.Commit(); err != nil {
-			return err
+			return applied, fmt.Errorf("failed to commit upgrade to v%d: %w", version+1, err)
 		}
+		applied = append(applied, version+1)
 	}
 
-	return nil
+	return applied, nil
 }
@@ -0,0 +1,346 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeSignalAddress(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		changed  bool
+	}{
+		{"1234567890:0", "1234567890:0", false},
+		{"1234567890:5", "1234567890:5", false},
+		{"1234567890_1:5", "1234567890_1:5", false},
+		{"1234567890", "1234567890:0", true},
+		{"1234567890@s.whatsapp.net", "1234567890:0", true},
+		{"1234567890.0:5@s.whatsapp.net", "1234567890:5", true},
+		{"1234567890:5@s.whatsapp.net", "1234567890:5", true},
+		{"1234567890.1:5@s.whatsapp.net", "1234567890_1:5", true},
+		{"123456789-123456@g.us", "123456789-123456@g.us", false},
+	}
+	for _, test := range tests {
+		output, changed := normalizeSignalAddress(test.input)
+		if output != test.expected || changed != test.changed {
+			t.Errorf("normalizeSignalAddress(%q) = (%q, %t), expected (%q, %t)", test.input, output, changed, test.expected, test.changed)
+		}
+	}
+}
+
+// fakeUpgradeDB is a minimal database/sql driver that pretends to be a database for testing the Upgrade flow.
+type fakeUpgradeDB struct {
+	versionErr  error
+	version     *int
+	deviceTable bool
+	failQuery   string
+	onExec      func(query string, args []driver.NamedValue) error
+	onQuery     func(query string, args []driver.NamedValue) (driver.Rows, error)
+
+	begun, commits, rollbacks int
+}
+
+type fakeUpgradeConn struct{ db *fakeUpgradeDB }
+type fakeUpgradeTx struct{ db *fakeUpgradeDB }
+
+type fakeUpgradeRows struct {
+	columns int
+	values  [][]driver.Value
+}
+
+var fakeUpgradeDBs = map[string]*fakeUpgradeDB{}
+
+type fakeUpgradeDriver struct{}
+
+func (fakeUpgradeDriver) Open(name string) (driver.Conn, error) {
+	return &fakeUpgradeConn{db: fakeUpgradeDBs[name]}, nil
+}
+
+func init() {
+	sql.Register("whatsmeow-upgrade-test", fakeUpgradeDriver{})
+}
+
+func (c *fakeUpgradeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare not supported")
+}
+func (c *fakeUpgradeConn) Close() error { return nil }
+func (c *fakeUpgradeConn) Begin() (driver.Tx, error) {
+	c.db.begun++
+	return &fakeUpgradeTx{db: c.db}, nil
+}
+
+func (tx *fakeUpgradeTx) Commit() error {
+	tx.db.commits++
+	return nil
+}
+
+func (tx *fakeUpgradeTx) Rollback() error {
+	tx.db.rollbacks++
+	return nil
+}
+
+func (c *fakeUpgradeConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if len(c.db.failQuery) > 0 && strings.HasPrefix(query, c.db.failQuery) {
+		return nil, errors.New("simulated failure")
+	}
+	if c.db.onExec != nil {
+		if err := c.db.onExec(query, args); err != nil {
+			return nil, err
+		}
+	}
+	return driver.RowsAffected(0), nil
+}
+
+func (c *fakeUpgradeConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if c.db.onQuery != nil {
+		return c.db.onQuery(query, args)
+	}
+	switch {
+	case strings.HasPrefix(query, "SELECT version FROM whatsmeow_version"):
+		if c.db.versionErr != nil {
+			return nil, c.db.versionErr
+		} else if c.db.version == nil {
+			return &fakeUpgradeRows{}, nil
+		}
+		return &fakeUpgradeRows{values: [][]driver.Value{{int64(*c.db.version)}}}, nil
+	case strings.HasPrefix(query, "SELECT EXISTS"):
+		return &fakeUpgradeRows{values: [][]driver.Value{{c.db.deviceTable}}}, nil
+	default:
+		return &fakeUpgradeRows{}, nil
+	}
+}
+
+func (r *fakeUpgradeRows) Columns() []string {
+	if r.columns > 1 {
+		return make([]string, r.columns)
+	}
+	return []string{"value"}
+}
+func (r *fakeUpgradeRows) Close() error { return nil }
+func (r *fakeUpgradeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+func newFakeUpgradeContainer(t *testing.T, db *fakeUpgradeDB) *Container {
+	fakeUpgradeDBs[t.Name()] = db
+	sqlDB, err := sql.Open("whatsmeow-upgrade-test", t.Name())
+	if err != nil {
+		t.Fatalf("Failed to open fake database: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = sqlDB.Close()
+		delete(fakeUpgradeDBs, t.Name())
+	})
+	return NewWithDB(sqlDB, "sqlite3", nil)
+}
+
+func TestUpgradeFreshDatabase(t *testing.T) {
+	db := &fakeUpgradeDB{}
+	applied, err := newFakeUpgradeContainer(t, db).UpgradeWithResult()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(applied) != len(Upgrades) || applied[0] != 1 || applied[len(applied)-1] != len(Upgrades) {
+		t.Errorf("Expected versions 1-%d to be applied, got %v", len(Upgrades), applied)
+	}
+	if db.commits != len(Upgrades) || db.rollbacks != 0 {
+		t.Errorf("Expected %d commits and no rollbacks, got %d and %d", len(Upgrades), db.commits, db.rollbacks)
+	}
+}
+
+func TestUpgradeVersionReadError(t *testing.T) {
+	db := &fakeUpgradeDB{versionErr: errors.New("database is locked")}
+	err := newFakeUpgradeContainer(t, db).Upgrade()
+	if err == nil || !strings.Contains(err.Error(), "database is locked") {
+		t.Fatalf("Expected version read error, got %v", err)
+	}
+	if db.begun != 0 {
+		t.Errorf("Expected no migrations to run, but %d transactions were started", db.begun)
+	}
+}
+
+func TestUpgradeUnversionedDatabase(t *testing.T) {
+	db := &fakeUpgradeDB{deviceTable: true}
+	container := newFakeUpgradeContainer(t, db)
+	err := container.Upgrade()
+	if !errors.Is(err, ErrUnversionedDatabase) {
+		t.Fatalf("Expected ErrUnversionedDatabase, got %v", err)
+	}
+	if db.begun != 0 {
+		t.Errorf("Expected no migrations to run, but %d transactions were started", db.begun)
+	}
+
+	latest := len(Upgrades)
+	err = container.ForceVersion(latest)
+	if err != nil {
+		t.Fatalf("Unexpected error forcing version: %v", err)
+	}
+	db.version = &latest
+	applied, err := container.UpgradeWithResult()
+	if err != nil {
+		t.Fatalf("Unexpected error after forcing version: %v", err)
+	} else if len(applied) != 0 {
+		t.Errorf("Expected no migrations to be applied, got %v", applied)
+	}
+	if db.begun != 1 {
+		t.Errorf("Expected only the ForceVersion transaction, got %d", db.begun)
+	}
+}
+
+func TestUpgradeDatabaseTooNew(t *testing.T) {
+	version := len(Upgrades) + 1
+	db := &fakeUpgradeDB{version: &version, deviceTable: true}
+	container := newFakeUpgradeContainer(t, db)
+	applied, err := container.UpgradeWithResult()
+	if !errors.Is(err, ErrDatabaseTooNew) {
+		t.Fatalf("Expected ErrDatabaseTooNew, got %v", err)
+	} else if len(applied) != 0 {
+		t.Errorf("Expected no migrations to be applied, got %v", applied)
+	}
+	if db.begun != 0 {
+		t.Errorf("Expected no migrations to run, but %d transactions were started", db.begun)
+	}
+	if _, err = container.PendingUpgrades(); !errors.Is(err, ErrDatabaseTooNew) {
+		t.Errorf("Expected ErrDatabaseTooNew from PendingUpgrades, got %v", err)
+	}
+}
+
+func TestUpgradeSetVersionFailureRollsBack(t *testing.T) {
+	db := &fakeUpgradeDB{failQuery: "INSERT INTO whatsmeow_version"}
+	err := newFakeUpgradeContainer(t, db).Upgrade()
+	if err == nil || !strings.Contains(err.Error(), "simulated failure") {
+		t.Fatalf("Expected set version error, got %v", err)
+	}
+	if db.rollbacks != 1 || db.commits != 0 {
+		t.Errorf("Expected 1 rollback and no commits, got %d and %d", db.rollbacks, db.commits)
+	}
+}
+
+func TestUpgradeMigrationFailureRollsBack(t *testing.T) {
+	db := &fakeUpgradeDB{failQuery: "CREATE TABLE whatsmeow_device"}
+	err := newFakeUpgradeContainer(t, db).Upgrade()
+	if err == nil || !strings.Contains(err.Error(), "v1") {
+		t.Fatalf("Expected migration error, got %v", err)
+	}
+	if db.rollbacks != 1 || db.commits != 0 {
+		t.Errorf("Expected 1 rollback and no commits, got %d and %d", db.rollbacks, db.commits)
+	}
+}
+
+func TestUpgradeReturnsPartiallyAppliedVersions(t *testing.T) {
+	version := 2
+	db := &fakeUpgradeDB{version: &version, deviceTable: true, failQuery: "CREATE TABLE whatsmeow_outbox"}
+	applied, err := newFakeUpgradeContainer(t, db).UpgradeWithResult()
+	if err == nil || !strings.Contains(err.Error(), "v4") {
+		t.Fatalf("Expected migration error, got %v", err)
+	}
+	if len(applied) != 1 || applied[0] != 3 {
+		t.Errorf("Expected only v3 to be applied before the failure, got %v", applied)
+	}
+}
+
+func TestPendingUpgrades(t *testing.T) {
+	version := 2
+	db := &fakeUpgradeDB{version: &version, deviceTable: true}
+	container := newFakeUpgradeContainer(t, db)
+	pending, err := container.PendingUpgrades()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(pending) != len(Upgrades)-2 || pending[0] != 2 || pending[len(pending)-1] != len(Upgrades)-1 {
+		t.Errorf("Unexpected pending upgrades %v", pending)
+	}
+	current, latest, err := container.MigrationStatus()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	} else if current != 2 || latest != len(Upgrades) {
+		t.Errorf("Expected status 2/%d, got %d/%d", len(Upgrades), current, latest)
+	}
+	if db.begun != 0 {
+		t.Errorf("Expected no migrations to run, but %d transactions were started", db.begun)
+	}
+
+	latestVersion := len(Upgrades)
+	db.version = &latestVersion
+	pending, err = container.PendingUpgrades()
+	if err != nil || len(pending) != 0 {
+		t.Errorf("Expected no pending upgrades on latest version, got %v (error: %v)", pending, err)
+	}
+}
+
+func TestPendingUpgradesUnversionedDatabase(t *testing.T) {
+	db := &fakeUpgradeDB{deviceTable: true}
+	_, err := newFakeUpgradeContainer(t, db).PendingUpgrades()
+	if !errors.Is(err, ErrUnversionedDatabase) {
+		t.Fatalf("Expected ErrUnversionedDatabase, got %v", err)
+	}
+}
+
+// keyRefsVersion is the version that added the external key reference columns to whatsmeow_device.
+const keyRefsVersion = 6
+
+func TestDowngrade(t *testing.T) {
+	version := keyRefsVersion
+	db := &fakeUpgradeDB{version: &version}
+	container := newFakeUpgradeContainer(t, db)
+	err := container.Downgrade(keyRefsVersion - 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if db.commits != 2 || db.rollbacks != 0 {
+		t.Errorf("Expected 2 commits and no rollbacks, got %d and %d", db.commits, db.rollbacks)
+	}
+
+	err = container.Downgrade(keyRefsVersion + 1)
+	if err == nil {
+		t.Error("Expected error when downgrading to a newer version")
+	}
+
+	// The fake database answers all EXISTS queries with deviceTable, so this pretends there are external keys
+	db.deviceTable = true
+	err = container.Downgrade(keyRefsVersion - 1)
+	if err == nil || !strings.Contains(err.Error(), "external keys") {
+		t.Errorf("Expected downgrade to be refused when devices have external keys, got %v", err)
+	}
+}
+
+func TestDowngradeIrreversible(t *testing.T) {
+	latest := len(Upgrades)
+	db := &fakeUpgradeDB{version: &latest, deviceTable: true}
+	err := newFakeUpgradeContainer(t, db).Downgrade(0)
+	if !errors.Is(err, ErrIrreversibleMigration) {
+		t.Fatalf("Expected ErrIrreversibleMigration, got %v", err)
+	}
+	if db.begun != 0 {
+		t.Errorf("Expected no migrations to be reversed, but %d transactions were started", db.begun)
+	}
+}
+
+func TestDowngradeFailureRollsBack(t *testing.T) {
+	version := keyRefsVersion
+	db := &fakeUpgradeDB{version: &version, failQuery: "ALTER TABLE whatsmeow_device DROP COLUMN deleted_at"}
+	err := newFakeUpgradeContainer(t, db).Downgrade(keyRefsVersion - 2)
+	if err == nil || !strings.Contains(err.Error(), "simulated failure") {
+		t.Fatalf("Expected migration error, got %v", err)
+	}
+	if db.commits != 1 || db.rollbacks != 1 {
+		t.Errorf("Expected 1 commit and 1 rollback, got %d and %d", db.commits, db.rollbacks)
+	}
+}
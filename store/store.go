@@ -8,6 +8,7 @@
 package store
 
 import (
+	"sync"
 	"time"
 
 	waProto "go.mau.fi/whatsmeow/binary/proto"
@@ -16,6 +17,8 @@ import (
 	waLog "go.mau.fi/whatsmeow/util/log"
 )
 
+// Addresses in IdentityStore, SessionStore and SenderKeyStore are always in the string form of
+// types.JID.SignalAddress (e.g. 1234567890:0 for a primary device or 1234567890:5 for a companion).
 type IdentityStore interface {
 	PutIdentity(address string, key [32]byte) error
 	IsTrustedIdentity(address string, key [32]byte) (bool, error)
@@ -25,6 +28,7 @@ type SessionStore interface {
 	GetSession(address string) ([]byte, error)
 	HasSession(address string) (bool, error)
 	PutSession(address string, session []byte) error
+	DeleteSession(address string) error
 }
 
 type PreKeyStore interface {
@@ -47,9 +51,23 @@ type AppStateSyncKey struct {
 	Timestamp   int64
 }
 
+// AppStateSyncKeyInfo contains the metadata of a stored app state sync key, but not the secret key data.
+type AppStateSyncKeyInfo struct {
+	ID          []byte
+	Fingerprint []byte
+	Timestamp   int64
+}
+
 type AppStateSyncKeyStore interface {
 	PutAppStateSyncKey(id []byte, key AppStateSyncKey) error
 	GetAppStateSyncKey(id []byte) (*AppStateSyncKey, error)
+	GetLatestAppStateSyncKeyID() ([]byte, error)
+	// GetAllAppStateSyncKeys returns the metadata of all stored keys, newest first.
+	GetAllAppStateSyncKeys() ([]AppStateSyncKeyInfo, error)
+	// DeleteOldAppStateSyncKeys deletes keys with a timestamp before the given one, but never the newest key.
+	// Old app state patches can't be decrypted after their key is deleted, so this should only be used
+	// for keys which have been superseded for a long time.
+	DeleteOldAppStateSyncKeys(before int64) (int64, error)
 }
 
 type AppStateMutationMAC struct {
@@ -68,10 +86,16 @@ type AppStateStore interface {
 }
 
 type ContactStore interface {
+	// PutPushName stores the push name of the user and returns whether it changed along with the previous name.
+	// The first push name stored for a user counts as a change from an empty name.
 	PutPushName(user types.JID, pushName string) (bool, string, error)
 	PutBusinessName(user types.JID, businessName string) error
 	PutContactName(user types.JID, fullName, firstName string) error
+	// GetContact returns the stored names of the user. ContactInfo.Found is false if nothing is stored about the user.
 	GetContact(user types.JID) (types.ContactInfo, error)
+	// SearchContacts finds up to limit contacts whose names contain the given query (case-insensitively).
+	// Contacts with a name that starts with the query are returned first.
+	SearchContacts(query string, limit int) ([]types.ContactInfo, error)
 }
 
 type ChatSettingsStore interface {
@@ -81,11 +105,95 @@ type ChatSettingsStore interface {
 	GetChatSettings(chat types.JID) (types.LocalChatSettings, error)
 }
 
+type LabelStore interface {
+	PutLabel(label types.Label) error
+	DeleteLabel(id string) error
+	GetLabel(id string) (*types.Label, error)
+	GetAllLabels() ([]types.Label, error)
+}
+
+// OutgoingMessage is a message in the persistent outbox, see whatsmeow.Client.EnablePersistentOutbox.
+type OutgoingMessage struct {
+	ID        types.MessageID
+	To        types.JID
+	Message   []byte // The protobuf-encoded waProto.Message.
+	Timestamp time.Time
+	Attempts  int
+}
+
+type OutboxStore interface {
+	PutOutgoingMessage(msg OutgoingMessage) error
+	DeleteOutgoingMessage(id types.MessageID) error
+	GetOutgoingMessages() ([]OutgoingMessage, error)
+}
+
+// ReactionStore stores the latest reaction of each user to messages.
+type ReactionStore interface {
+	// PutReaction replaces the sender's previous reaction to the given message. A reaction with an empty emoji
+	// removes the previous one. Reactions older than the one already stored (or the removal) are ignored,
+	// so implementations must remember when a reaction was removed.
+	PutReaction(chat types.JID, messageID types.MessageID, reaction types.Reaction) error
+	GetReactions(chat types.JID, messageID types.MessageID) ([]types.Reaction, error)
+}
+
+// MsgSecretStore stores the message secrets that are attached to some messages (e.g. polls). They're used to
+// encrypt and decrypt later messages that refer to the original message, like votes in polls.
+type MsgSecretStore interface {
+	PutMessageSecret(chat, sender types.JID, id types.MessageID, secret []byte) error
+	// GetMessageSecret returns the secret of the given message, or nil if it's not known.
+	GetMessageSecret(chat, sender types.JID, id types.MessageID) ([]byte, error)
+}
+
+// PollStore stores polls and the current vote of each user in them.
+type PollStore interface {
+	PutPoll(chat types.JID, poll types.Poll) error
+	// GetPoll returns the given poll, or nil if it's not known.
+	GetPoll(chat types.JID, pollID types.MessageID) (*types.Poll, error)
+	// PutPollVote replaces the voter's previous vote in the given poll. Votes older than the one already stored
+	// are ignored, so a vote that is removed and then received again out of order doesn't come back.
+	PutPollVote(chat types.JID, pollID types.MessageID, vote types.PollVote) error
+	GetPollVotes(chat types.JID, pollID types.MessageID) ([]types.PollVote, error)
+}
+
+// DeviceStores contains all the per-device stores. It's used for transaction-scoped store access,
+// see sqlstore.Container.WithTransaction for example.
+type DeviceStores struct {
+	Identities   IdentityStore
+	Sessions     SessionStore
+	PreKeys      PreKeyStore
+	SenderKeys   SenderKeyStore
+	AppStateKeys AppStateSyncKeyStore
+	AppState     AppStateStore
+	Contacts     ContactStore
+	ChatSettings ChatSettingsStore
+	Labels       LabelStore
+	Outbox       OutboxStore
+	Reactions    ReactionStore
+	MsgSecrets   MsgSecretStore
+	Polls        PollStore
+}
+
 type DeviceContainer interface {
 	PutDevice(store *Device) error
 	DeleteDevice(store *Device) error
 }
 
+// DeviceField identifies a field of Device that can be saved without saving the rest of the device.
+type DeviceField int
+
+const (
+	DeviceFieldPushName DeviceField = iota
+	DeviceFieldBusinessName
+	DeviceFieldPlatform
+)
+
+// DeviceFieldUpdater is an optional interface for DeviceContainers that can save a single field of a device.
+//
+// If the container doesn't implement this, the Device.Set* methods fall back to saving the whole device.
+type DeviceFieldUpdater interface {
+	PutDeviceField(store *Device, field DeviceField, value string) error
+}
+
 type Device struct {
 	Log waLog.Logger
 
@@ -95,6 +203,24 @@ type Device struct {
 	RegistrationID uint32
 	AdvSecretKey   []byte
 
+	// ExternalNoiseKey can be set to keep the private noise key outside of memory (e.g. in a HSM).
+	// If set, it's used for the noise handshake and NoiseKey only needs to contain the public key.
+	ExternalNoiseKey keys.DHKey
+	// ExternalIdentityKey can be set to create the signatures made with the identity key (the device signature
+	// when pairing and signed pre-key signatures) outside of memory. It must have the same public key as IdentityKey.
+	//
+	// The Signal protocol implementation still needs the private key in IdentityKey for establishing sessions,
+	// so the identity key can't be kept entirely outside of memory.
+	ExternalIdentityKey keys.SigningKey
+
+	// CompanionProps can be set to override the global CompanionProps for this device, e.g. to show up as a
+	// different platform in the linked devices list. It's only sent when pairing.
+	CompanionProps *waProto.CompanionProps
+	// ClientPayloadHook can be set to modify the client payload that's sent at the end of the handshake, e.g. to
+	// change the user agent platform or connect type. The hook gets a copy of the payload, so it can be modified
+	// freely. The connection fails if the hook leaves required fields empty (see ValidateClientPayload).
+	ClientPayloadHook func(payload *waProto.ClientPayload)
+
 	ID           *types.JID
 	Account      *waProto.ADVSignedDeviceIdentity
 	Platform     string
@@ -110,13 +236,65 @@ type Device struct {
 	AppState     AppStateStore
 	Contacts     ContactStore
 	ChatSettings ChatSettingsStore
+	Labels       LabelStore
+	Outbox       OutboxStore
+	Reactions    ReactionStore
+	MsgSecrets   MsgSecretStore
+	Polls        PollStore
 	Container    DeviceContainer
+
+	saveLock sync.Mutex
+}
+
+// GetNoiseKey returns the key that should be used for the noise handshake.
+func (device *Device) GetNoiseKey() keys.DHKey {
+	if device.ExternalNoiseKey != nil {
+		return device.ExternalNoiseKey
+	}
+	return device.NoiseKey
+}
+
+// GetIdentitySigner returns the key that should be used for signing things with the identity key.
+func (device *Device) GetIdentitySigner() keys.SigningKey {
+	if device.ExternalIdentityKey != nil {
+		return device.ExternalIdentityKey
+	}
+	return device.IdentityKey
 }
 
+// Save saves the whole device. It's meant for storing the device initially after pairing,
+// updating individual fields should be done with the Set* methods.
 func (device *Device) Save() error {
+	device.saveLock.Lock()
+	defer device.saveLock.Unlock()
+	return device.Container.PutDevice(device)
+}
+
+func (device *Device) setField(field DeviceField, ptr *string, value string) error {
+	device.saveLock.Lock()
+	defer device.saveLock.Unlock()
+	*ptr = value
+	if updater, ok := device.Container.(DeviceFieldUpdater); ok {
+		return updater.PutDeviceField(device, field, value)
+	}
 	return device.Container.PutDevice(device)
 }
 
+// SetPushName changes the push name of the device and saves it without rewriting the other fields.
+func (device *Device) SetPushName(name string) error {
+	return device.setField(DeviceFieldPushName, &device.PushName, name)
+}
+
+// SetBusinessName changes the business name of the device and saves it without rewriting the other fields.
+func (device *Device) SetBusinessName(name string) error {
+	return device.setField(DeviceFieldBusinessName, &device.BusinessName, name)
+}
+
+// SetPlatform changes the platform of the device and saves it without rewriting the other fields.
+func (device *Device) SetPlatform(platform string) error {
+	return device.setField(DeviceFieldPlatform, &device.Platform, platform)
+}
+
 func (device *Device) Delete() error {
 	return device.Container.DeleteDevice(device)
 }
@@ -0,0 +1,48 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+// TypedEventHandler is an event handler that only receives events of a single type.
+// It can be created with HandleEvent and registered with Client.AddTypedEventHandlers.
+type TypedEventHandler struct {
+	fn EventHandler
+}
+
+// HandleEvent wraps the given function into a TypedEventHandler which is only called for events of type *T.
+func HandleEvent[T any](fn func(evt *T)) TypedEventHandler {
+	return TypedEventHandler{fn: func(evt interface{}) {
+		if typedEvt, ok := evt.(*T); ok {
+			fn(typedEvt)
+		}
+	}}
+}
+
+// On registers a function to receive events of type *T, e.g.
+//
+//	whatsmeow.On(cli, func(evt *events.Message) { ... })
+//
+// The returned ID can be passed to Client.RemoveEventHandler like IDs returned by AddEventHandler.
+func On[T any](cli *Client, fn func(evt *T)) uint32 {
+	return cli.addEventHandler(HandleEvent(fn).fn, 2)
+}
+
+// AddTypedEventHandlers registers multiple typed event handlers at once, e.g.
+//
+//	cli.AddTypedEventHandlers(
+//		whatsmeow.HandleEvent(func(evt *events.Message) { ... }),
+//		whatsmeow.HandleEvent(func(evt *events.Receipt) { ... }),
+//	)
+//
+// The handlers are registered as a single event handler, so they're all removed together with the returned ID.
+// For each event, the handlers are called in the order they were given.
+func (cli *Client) AddTypedEventHandlers(handlers ...TypedEventHandler) uint32 {
+	return cli.addEventHandler(func(evt interface{}) {
+		for _, handler := range handlers {
+			handler.fn(evt)
+		}
+	}, 2)
+}
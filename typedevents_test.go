@@ -0,0 +1,74 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func TestTypedEventHandlers(t *testing.T) {
+	cli := &Client{}
+	var messages, receipts int
+	id := On(cli, func(evt *events.Message) {
+		messages++
+	})
+	cli.AddTypedEventHandlers(
+		HandleEvent(func(evt *events.Receipt) {
+			receipts++
+		}),
+		HandleEvent(func(evt *events.Message) {
+			messages++
+		}),
+	)
+
+	cli.dispatchEvent(&events.Message{})
+	cli.dispatchEvent(&events.Receipt{})
+	cli.dispatchEvent(&events.Connected{})
+	if messages != 2 || receipts != 1 {
+		t.Errorf("Expected 2 messages and 1 receipt, got %d and %d", messages, receipts)
+	}
+
+	cli.RemoveEventHandler(id)
+	cli.dispatchEvent(&events.Message{})
+	if messages != 3 {
+		t.Errorf("Expected 3 messages after removing a handler, got %d", messages)
+	}
+}
+
+var benchmarkEvents = []interface{}{&events.Message{}, &events.Receipt{}, &events.Presence{}, &events.Connected{}}
+
+func BenchmarkTypeSwitchHandler(b *testing.B) {
+	cli := &Client{}
+	var count int
+	cli.AddEventHandler(func(evt interface{}) {
+		switch evt.(type) {
+		case *events.Message:
+			count++
+		case *events.Receipt:
+			count++
+		}
+	})
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cli.dispatchEvent(benchmarkEvents[i%len(benchmarkEvents)])
+	}
+}
+
+func BenchmarkTypedEventHandlers(b *testing.B) {
+	cli := &Client{}
+	var count int
+	cli.AddTypedEventHandlers(
+		HandleEvent(func(evt *events.Message) { count++ }),
+		HandleEvent(func(evt *events.Receipt) { count++ }),
+	)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cli.dispatchEvent(benchmarkEvents[i%len(benchmarkEvents)])
+	}
+}
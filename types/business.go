@@ -0,0 +1,73 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package types
+
+// BusinessCategory contains the ID and display name of a business category.
+type BusinessCategory struct {
+	ID   string
+	Name string
+}
+
+// BusinessHoursConfig contains the opening hours of a business for a single day of the week.
+type BusinessHoursConfig struct {
+	DayOfWeek string // The day, e.g. "mon"
+	Mode      string // "specific_hours", "open_24h" or "appointment_only"
+	OpenTime  int    // Minutes from midnight, only set if the mode is specific_hours
+	CloseTime int    // Minutes from midnight, only set if the mode is specific_hours
+}
+
+// BusinessProfile contains the profile information of a WhatsApp business account.
+type BusinessProfile struct {
+	JID         JID
+	Address     string
+	Email       string
+	Description string
+	Websites    []string
+	Categories  []BusinessCategory
+
+	BusinessHoursTimeZone string
+	BusinessHours         []BusinessHoursConfig
+}
+
+// ProductInfo contains the details of a single product in a business catalog.
+type ProductInfo struct {
+	ID          string
+	RetailerID  string
+	Name        string
+	Description string
+	URL         string
+	Currency    string // ISO 4217 currency code
+	Price       int64  // The price multiplied by 1000, i.e. 1500 means 1.5 units of the currency
+	ImageURLs   []string
+	IsHidden    bool
+}
+
+// ProductCatalogPage contains one page of products from a business catalog.
+type ProductCatalogPage struct {
+	Products []ProductInfo
+	// The cursor to pass to Client.GetBusinessCatalog to get the next page. Empty if this is the last page.
+	NextCursor string
+}
+
+// CatalogReference contains the catalog-related IDs referenced in a product or order message.
+type CatalogReference struct {
+	BusinessOwnerJID JID // The owner of the catalog (product messages) or the seller (order messages)
+
+	ProductID  string // The ID of the product, only present in product messages
+	RetailerID string // The business's own ID for the product, only present in product messages
+
+	OrderID    string // The ID of the order, only present in order messages
+	OrderToken string // The token needed to fetch the order details, only present in order messages
+}
+
+// Label is a chat/message label of a WhatsApp business account.
+type Label struct {
+	ID           string
+	Name         string
+	Color        int32 // The index of the color in the label color palette of WhatsApp clients.
+	PredefinedID int32 // The ID of the predefined label (like "New customer") this is based on, or zero for custom labels.
+}
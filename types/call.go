@@ -0,0 +1,27 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package types
+
+import (
+	"time"
+)
+
+// CallType is the media type of a call.
+type CallType string
+
+const (
+	CallTypeVoice CallType = "voice"
+	CallTypeVideo CallType = "video"
+)
+
+// BasicCallMeta contains the metadata included in all call signaling events.
+type BasicCallMeta struct {
+	From        JID       // The device that sent the call signaling message.
+	Timestamp   time.Time // The time when the signaling message was sent.
+	CallCreator JID       // The user who started the call.
+	CallID      string    // The ID of the call.
+}
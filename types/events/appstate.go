@@ -9,14 +9,16 @@ package events
 import (
 	"time"
 
+	"go.mau.fi/whatsmeow/appstate"
 	waProto "go.mau.fi/whatsmeow/binary/proto"
 	"go.mau.fi/whatsmeow/types"
 )
 
 // Contact is emitted when an entry in the user's contact list is modified from another device.
 type Contact struct {
-	JID       types.JID // The contact who was modified.
-	Timestamp time.Time // The time when the modification happened.'
+	JID          types.JID // The contact who was modified.
+	Timestamp    time.Time // The time when the modification happened.'
+	FromFullSync bool      // Whether the action is emitted because of a full sync of the app state.
 
 	Action *waProto.ContactAction // The new contact info.
 }
@@ -31,67 +33,187 @@ type PushName struct {
 
 // Pin is emitted when a chat is pinned or unpinned from another device.
 type Pin struct {
-	JID       types.JID // The chat which was pinned or unpinned.
-	Timestamp time.Time // The time when the (un)pinning happened.
+	JID          types.JID // The chat which was pinned or unpinned.
+	Timestamp    time.Time // The time when the (un)pinning happened.
+	FromFullSync bool      // Whether the action is emitted because of a full sync of the app state.
 
 	Action *waProto.PinAction // Whether the chat is now pinned or not.
 }
 
 // Star is emitted when a message is starred or unstarred from another device.
 type Star struct {
-	ChatJID   types.JID // The chat where the message was pinned.
-	SenderJID types.JID // In group chats, the user who sent the message (except if the message was sent by the user).
-	IsFromMe  bool      // Whether the message was sent by the user.
-	MessageID string    // The message which was starred or unstarred.
-	Timestamp time.Time // The time when the (un)starring happened.
+	ChatJID      types.JID // The chat where the message was starred.
+	SenderJID    types.JID // In group chats, the user who sent the message (except if the message was sent by the user).
+	IsFromMe     bool      // Whether the message was sent by the user.
+	MessageID    string    // The message which was starred or unstarred.
+	Timestamp    time.Time // The time when the (un)starring happened.
+	FromFullSync bool      // Whether the action is emitted because of a full sync of the app state.
+	Starred      bool      // Whether the message is now starred.
 
 	Action *waProto.StarAction // Whether the message is now starred or not.
 }
 
 // DeleteForMe is emitted when a message is deleted (for the current user only) from another device.
 type DeleteForMe struct {
-	ChatJID   types.JID // The chat where the message was deleted.
-	SenderJID types.JID // In group chats, the user who sent the message (except if the message was sent by the user).
-	IsFromMe  bool      // Whether the message was sent by the user.
-	MessageID string    // The message which was deleted.
-	Timestamp time.Time // The time when the deletion happened.
+	ChatJID      types.JID // The chat where the message was deleted.
+	SenderJID    types.JID // In group chats, the user who sent the message (except if the message was sent by the user).
+	IsFromMe     bool      // Whether the message was sent by the user.
+	MessageID    string    // The message which was deleted.
+	Timestamp    time.Time // The time when the deletion happened.
+	FromFullSync bool      // Whether the action is emitted because of a full sync of the app state.
 
 	Action *waProto.DeleteMessageForMeAction // Additional information for the deletion.
 }
 
 // Mute is emitted when a chat is muted or unmuted from another device.
 type Mute struct {
-	JID       types.JID // The chat which was muted or unmuted.
-	Timestamp time.Time // The time when the (un)muting happened.
+	JID          types.JID // The chat which was muted or unmuted.
+	Timestamp    time.Time // The time when the (un)muting happened.
+	FromFullSync bool      // Whether the action is emitted because of a full sync of the app state.
 
 	Action *waProto.MuteAction // The current mute status of the chat.
 }
 
 // Archive is emitted when a chat is archived or unarchived from another device.
 type Archive struct {
-	JID       types.JID // The chat which was archived or unarchived.
-	Timestamp time.Time // The time when the (un)archiving happened.
+	JID          types.JID // The chat which was archived or unarchived.
+	Timestamp    time.Time // The time when the (un)archiving happened.
+	FromFullSync bool      // Whether the action is emitted because of a full sync of the app state.
 
 	Action *waProto.ArchiveChatAction // The current archival status of the chat.
 }
 
 // PushNameSetting is emitted when the user's push name is changed from another device.
 type PushNameSetting struct {
-	Timestamp time.Time // The time when the push name was changed.
+	Timestamp    time.Time // The time when the push name was changed.
+	FromFullSync bool      // Whether the action is emitted because of a full sync of the app state.
 
 	Action *waProto.PushNameSetting // The new push name for the user.
 }
 
 // UnarchiveChatsSetting is emitted when the user changes the "Keep chats archived" setting from another device.
 type UnarchiveChatsSetting struct {
-	Timestamp time.Time // The time when the setting was changed.
+	Timestamp    time.Time // The time when the setting was changed.
+	FromFullSync bool      // Whether the action is emitted because of a full sync of the app state.
 
 	Action *waProto.UnarchiveChatsSetting // The new settings.
 }
 
+// LabelEdit is emitted when a label is created, edited or deleted from another device (business accounts only).
+type LabelEdit struct {
+	LabelID      string    // The ID of the label which was changed.
+	Timestamp    time.Time // The time when the change happened.
+	FromFullSync bool      // Whether the action is emitted because of a full sync of the app state.
+
+	Action *waProto.LabelEditAction // The new name and color of the label, or whether it was deleted.
+}
+
+// LabelAssociationChat is emitted when a chat is labeled or unlabeled from another device (business accounts only).
+type LabelAssociationChat struct {
+	JID          types.JID    // The chat which was (un)labeled.
+	LabelID      string       // The ID of the label.
+	Label        *types.Label // The label info from the local store, or nil if the label isn't known.
+	Timestamp    time.Time    // The time when the (un)labeling happened.
+	FromFullSync bool         // Whether the action is emitted because of a full sync of the app state.
+
+	Action *waProto.LabelAssociationAction // Whether the chat now has the label or not.
+}
+
+// LabelAssociationMessage is emitted when a message is labeled or unlabeled from another device (business accounts only).
+type LabelAssociationMessage struct {
+	JID          types.JID    // The chat where the message is.
+	LabelID      string       // The ID of the label.
+	Label        *types.Label // The label info from the local store, or nil if the label isn't known.
+	MessageID    string       // The message which was (un)labeled.
+	Timestamp    time.Time    // The time when the (un)labeling happened.
+	FromFullSync bool         // Whether the action is emitted because of a full sync of the app state.
+
+	Action *waProto.LabelAssociationAction // Whether the message now has the label or not.
+}
+
+// MarkChatAsRead is emitted when a whole chat is marked as read or unread from another device.
+type MarkChatAsRead struct {
+	JID          types.JID // The chat which was marked as read or unread.
+	Timestamp    time.Time // The time when the marking happened.
+	FromFullSync bool      // Whether the action is emitted because of a full sync of the app state.
+
+	Action *waProto.MarkChatAsReadAction // Whether the chat was marked as read or unread, and info about the most recent messages.
+}
+
+// ClearChat is emitted when a chat is cleared on another device. This is different from DeleteChat.
+type ClearChat struct {
+	JID           types.JID // The chat which was cleared.
+	Timestamp     time.Time // The time when the clear happened.
+	DeleteStarred bool      // Whether starred messages were also removed.
+	DeleteMedia   bool      // Whether media in the chat was also deleted from the device.
+	FromFullSync  bool      // Whether the action is emitted because of a full sync of the app state.
+
+	Action *waProto.ClearChatAction // Information about the clear.
+}
+
+// DeleteChat is emitted when a chat is deleted on another device.
+type DeleteChat struct {
+	JID          types.JID // The chat which was deleted.
+	Timestamp    time.Time // The time when the deletion happened.
+	DeleteMedia  bool      // Whether media in the chat was also deleted from the device.
+	FromFullSync bool      // Whether the action is emitted because of a full sync of the app state.
+
+	Action *waProto.DeleteChatAction // Information about the deletion.
+}
+
 // AppState is emitted directly for new data received from app state syncing.
 // You should generally use the higher-level events like events.Contact and events.Mute.
+//
+// This is emitted for all mutations, including ones that don't have a higher-level event.
 type AppState struct {
-	Index []string
+	Index        []string
+	FromFullSync bool
 	*waProto.SyncActionValue
 }
+
+// AppStateSyncProgress is emitted after each page of patches is processed during a full app state sync.
+//
+// Progress is reported in two separate units: PatchesProcessed counts patches, while the SnapshotBytes fields
+// count bytes of the snapshot. The server doesn't say how many patches there are in total, so only the number
+// of patches processed so far is known. Snapshots are decoded in batches, and the event is also emitted after
+// each batch, in which case the SnapshotBytes fields contain the progress within the snapshot.
+type AppStateSyncProgress struct {
+	Name             appstate.WAPatchName
+	Version          uint64 // The app state version that has been reached so far, or zero while a snapshot is being decoded.
+	PatchesProcessed int    // The number of patches (including the snapshot, if there was one) processed so far.
+	HasMore          bool   // Whether there are more patches to fetch.
+
+	SnapshotBytesDecoded int64 // The number of bytes of the snapshot decoded so far, or zero if a snapshot isn't being decoded.
+	SnapshotBytesTotal   int64 // The total size of the snapshot in bytes, or zero if a snapshot isn't being decoded.
+}
+
+// AppStateSyncComplete is emitted when app state of the given type was synced successfully after
+// previously failing because of missing encryption keys that had to be requested from the phone.
+type AppStateSyncComplete struct {
+	Name appstate.WAPatchName
+}
+
+// AppStateSyncError is emitted when the local state of the given app state type doesn't match the server's state,
+// which usually means the local state is corrupted. Until the type is fully resynced (e.g. with Client.FetchAppState),
+// further updates to it can't be applied.
+//
+// If Client.AutoRecoverAppState is enabled, the client does the full resync automatically, which is indicated by
+// WillResync. Resyncs are only done automatically once per type per connection.
+type AppStateSyncError struct {
+	Name     appstate.WAPatchName
+	Version  uint64 // The version of the patch or snapshot that failed to verify.
+	Expected []byte // The snapshot MAC sent by the server.
+	Got      []byte // The snapshot MAC calculated from the local state.
+	Error    error
+
+	WillResync bool // Whether the client is going to fully resync the app state type automatically.
+}
+
+// AppStateKeysNotReceived is emitted when app state of the given type couldn't be synced because of missing
+// encryption keys, and the phone didn't share the keys within whatsmeow.AppStateKeyRequestTimeout after requesting them.
+//
+// The sync will still be retried if the keys arrive later.
+type AppStateKeysNotReceived struct {
+	Name   appstate.WAPatchName
+	KeyIDs [][]byte // The IDs of the keys which are still missing.
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package events
+
+import (
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// CallOffer is emitted when the user receives a call on WhatsApp.
+type CallOffer struct {
+	types.BasicCallMeta
+	Type types.CallType // Whether the call is a voice or video call.
+
+	Data *waBinary.Node // The offer node, which contains the data needed for setting up the actual call.
+}
+
+// CallAccept is emitted when a call is accepted on another device of the user, or by the other party of an outgoing call.
+type CallAccept struct {
+	types.BasicCallMeta
+
+	Data *waBinary.Node
+}
+
+// CallTerminate is emitted when a call ends, either because it was hung up or because it was rejected.
+type CallTerminate struct {
+	types.BasicCallMeta
+	Reason string
+
+	Data *waBinary.Node
+}
+
+// UnknownCallEvent is emitted when a call element with unknown content is received.
+type UnknownCallEvent struct {
+	Node *waBinary.Node
+}
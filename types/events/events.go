@@ -43,10 +43,41 @@ type PairSuccess struct {
 // at this point, which is why this event doesn't contain any data.
 type Connected struct{}
 
+// PrimaryDeviceOffline is emitted when the primary device (i.e. the user's phone) doesn't respond to a peer message
+// in time. Features that depend on the phone, like requesting app state keys, won't work until it comes back online.
+type PrimaryDeviceOffline struct{}
+
+// PrimaryDeviceOnline is emitted when the primary device responds again after PrimaryDeviceOffline was emitted.
+type PrimaryDeviceOnline struct{}
+
 // LoggedOut is emitted when the client has been unpaired from the phone.
 //
 // This can happen while connected (stream:error messages) or right after connecting (connect failure messages).
-type LoggedOut struct{}
+type LoggedOut struct {
+	// OnConnect is true if the event was triggered by a connect failure message.
+	// If it's false, the event was triggered by a stream:error message.
+	OnConnect bool
+	// If OnConnect is true, then this field contains the reason code.
+	Reason ConnectFailureReason
+}
+
+// ConnectFailureReason is an error code included in connection failure events.
+type ConnectFailureReason int
+
+const (
+	ConnectFailureLoggedOut      ConnectFailureReason = 401
+	ConnectFailureTempBanned     ConnectFailureReason = 402
+	ConnectFailureMainDeviceGone ConnectFailureReason = 403
+	ConnectFailureClientOutdated ConnectFailureReason = 405
+	ConnectFailureUnknownLogout  ConnectFailureReason = 406 // WhatsApp Web calls this BANNED
+	ConnectFailureBadUserAgent   ConnectFailureReason = 409
+)
+
+// IsLoggedOut returns true if the client should delete its session data due to this connect failure,
+// which means the device needs to be paired again.
+func (cfr ConnectFailureReason) IsLoggedOut() bool {
+	return cfr == ConnectFailureLoggedOut || cfr == ConnectFailureMainDeviceGone || cfr == ConnectFailureUnknownLogout
+}
 
 // ConnectFailure is emitted when the WhatsApp server sends a <failure> node with an unknown reason.
 //
@@ -56,6 +87,69 @@ type ConnectFailure struct {
 	Raw    *waBinary.Node
 }
 
+// TempBanReason is the reason code included in TemporaryBan events.
+type TempBanReason int
+
+const (
+	TempBanSentToTooManyPeople    TempBanReason = 101
+	TempBanBlockedByUsers         TempBanReason = 102
+	TempBanCreatedTooManyGroups   TempBanReason = 103
+	TempBanSentTooManySameMessage TempBanReason = 104
+	TempBanBroadcastList          TempBanReason = 106
+)
+
+var tempBanReasonMessage = map[TempBanReason]string{
+	TempBanSentToTooManyPeople:    "you sent too many messages to people who don't have you in their address books",
+	TempBanBlockedByUsers:         "too many people blocked you",
+	TempBanCreatedTooManyGroups:   "you created too many groups with people who don't have you in their address books",
+	TempBanSentTooManySameMessage: "you sent the same message to too many people",
+	TempBanBroadcastList:          "you sent too many messages to a broadcast list",
+}
+
+// String returns the reason code and a human-readable description of the ban reason.
+func (tbr TempBanReason) String() string {
+	msg, ok := tempBanReasonMessage[tbr]
+	if !ok {
+		msg = "you may have violated the terms of service (unknown error)"
+	}
+	return fmt.Sprintf("%d: %s", int(tbr), msg)
+}
+
+// TemporaryBan is emitted when the server refuses the connection because the account has been temporarily banned.
+//
+// The client won't reconnect automatically while the ban is active. If Client.EnableAutoReconnect is set and the
+// server said when the ban expires, the client reconnects once the ban has expired.
+type TemporaryBan struct {
+	Code   TempBanReason
+	Expire time.Duration // How long the ban lasts, or zero if the server didn't say.
+	Until  time.Time     // When the ban expires, or the zero time if the server didn't say.
+}
+
+func (tb *TemporaryBan) String() string {
+	if tb.Until.IsZero() {
+		return fmt.Sprintf("You've been temporarily banned from WhatsApp (%s)", tb.Code)
+	}
+	return fmt.Sprintf("You've been temporarily banned from WhatsApp until %s (%s)", tb.Until.Format(time.RFC3339), tb.Code)
+}
+
+// ClientOutdated is emitted when the server refuses the connection because the client version is too old.
+//
+// The client won't reconnect automatically, as connecting won't work until whatsmeow is updated.
+type ClientOutdated struct{}
+
+// AccountBanned is emitted when the server refuses the connection because the account has been banned.
+//
+// The client won't reconnect automatically. Bans in connect failures also invalidate the session,
+// so a LoggedOut event is emitted too in that case.
+type AccountBanned struct {
+	// OnConnect is true if the event was triggered by a connect failure message.
+	// If it's false, the event was triggered by a stream:error message.
+	OnConnect bool
+	// Code is the reason code of the connect failure or the code of the stream error.
+	Code int
+	Raw  *waBinary.Node
+}
+
 // StreamError is emitted when the WhatsApp server sends a <stream:error> node with an unknown code.
 //
 // Known codes are handled internally and emitted as different events (e.g. LoggedOut).
@@ -64,12 +158,34 @@ type StreamError struct {
 	Raw  *waBinary.Node
 }
 
+// OfflineSyncPreview is emitted right after connecting if the server has items that were received while the client was offline.
+// The counts are provided by the server.
+type OfflineSyncPreview struct {
+	Total          int
+	AppDataChanges int
+	Messages       int
+	Notifications  int
+	Receipts       int
+}
+
+// OfflineSyncCompleted is emitted after the server has finished sending everything that was received while the client was offline.
+// Messages delivered before this event will have the Offline flag set in their MessageInfo.
+type OfflineSyncCompleted struct {
+	Count     int // The number of offline items according to the server.
+	Processed int // The number of offline items that were actually handled by the client.
+}
+
 // Disconnected is emitted when the websocket is closed by the server.
 type Disconnected struct{}
 
 // HistorySync is emitted when the phone has sent a blob of historical messages.
+//
+// Use Client.IterateHistorySync to go through the messages in the same format as Message events,
+// and whatsmeow.GetHistorySyncProgress to find out which part of the history this is.
 type HistorySync struct {
 	Data *waProto.HistorySync
+	// OnDemand is true if the phone sent this in response to Client.RequestHistorySync.
+	OnDemand bool
 }
 
 // UndecryptableMessage is emitted when receiving a new message that failed to decrypt.
@@ -86,18 +202,80 @@ type UndecryptableMessage struct {
 	IsUnavailable bool
 }
 
+// SessionRecovered is emitted when the Signal session with a device was deleted after repeated bad MAC errors.
+//
+// A retry receipt including a new pre-key is sent with the message that triggered the recovery,
+// so the sender can establish a new session and resend the message.
+type SessionRecovered struct {
+	JID types.JID // The device whose session was reset.
+}
+
 // Message is emitted when receiving a new message.
 type Message struct {
-	Info        types.MessageInfo // Information about the message like the chat and sender IDs
-	Message     *waProto.Message  // The actual message struct
+	Info    types.MessageInfo // Information about the message like the chat and sender IDs and edit/revoke flags
+	Message *waProto.Message  // The actual message struct
+
+	// Deprecated: use Info.IsEphemeral
 	IsEphemeral bool
-	IsViewOnce  bool
+	// Deprecated: use Info.IsViewOnce
+	IsViewOnce bool
+
+	MentionedJIDs []types.JID        // The users who were mentioned in the message, parsed from the ContextInfo.
+	Sticker       *types.StickerInfo // Metadata of the sticker, if the message is a sticker.
+
+	CatalogReference *types.CatalogReference // The catalog and product/order IDs, if the message is a product or order message.
+
+	Interactive         *types.InteractiveInfo     // The text and buttons, if the message is a buttons, list, template or interactive message.
+	InteractiveResponse *types.InteractiveResponse // The selected button or list row, if the message is a response to an interactive message.
 
 	// The raw message struct. This is the raw unwrapped data, which means the actual message might
 	// be wrapped in DeviceSentMessage, EphemeralMessage or ViewOnceMessage.
 	RawMessage *waProto.Message
 }
 
+// Revoke is emitted when a message is deleted for everyone (revoked) by the sender or a group admin.
+type Revoke struct {
+	Info      types.MessageInfo   // Information about the protocol message containing the revocation.
+	MessageID types.MessageID     // The ID of the message which was revoked.
+	Key       *waProto.MessageKey // The full key of the revoked message.
+}
+
+// EphemeralSetting is emitted when the disappearing message timer is changed in a private chat.
+//
+// Group disappearing message changes are emitted as GroupInfo events instead.
+type EphemeralSetting struct {
+	Info       types.MessageInfo // Information about the protocol message containing the change.
+	Expiration time.Duration     // The new disappearing message timer. Zero means disappearing messages were disabled.
+	Timestamp  time.Time         // The time when the setting was changed.
+}
+
+// DisappearingTimerChanged is emitted when the account-wide default disappearing message timer for new chats
+// is changed, e.g. from another device.
+//
+// Changes to the timer of a single private chat are emitted as EphemeralSetting events.
+type DisappearingTimerChanged struct {
+	Timer     time.Duration // The new default timer. Zero means disappearing messages are disabled by default.
+	Timestamp time.Time     // The time when the setting was changed.
+}
+
+// ProtocolMessage is emitted for protocol messages that aren't handled internally or emitted as a more specific event.
+type ProtocolMessage struct {
+	Info    types.MessageInfo
+	Message *waProto.ProtocolMessage
+}
+
+// MessageRetried is emitted when a message from the persistent outbox is resent after reconnecting,
+// because the server didn't acknowledge it before the previous connection was lost.
+type MessageRetried struct {
+	ID      types.MessageID
+	To      types.JID
+	Attempt int   // The number of times the message has been resent from the outbox, including this time.
+	Error   error // The error if resending failed. Unless Dropped is true, the message will be retried on the next connection.
+	// Dropped is true if the message was removed from the outbox without being sent, because the error isn't
+	// caused by the connection or because the message has already been resent Client.MaxOutboxAttempts times.
+	Dropped bool
+}
+
 // ReceiptType represents the type of a Receipt event.
 type ReceiptType string
 
@@ -106,6 +284,12 @@ const (
 	ReceiptTypeDelivered ReceiptType = ""
 	// ReceiptTypeRead means the user opened the chat and saw the message.
 	ReceiptTypeRead ReceiptType = "read"
+	// ReceiptTypeReadSelf means the current user read a message from a different device, and has read receipts disabled in privacy settings.
+	ReceiptTypeReadSelf ReceiptType = "read-self"
+	// ReceiptTypeRetry means the recipient couldn't decrypt the message and is asking for it to be resent.
+	ReceiptTypeRetry ReceiptType = "retry"
+	// ReceiptTypePeerMsg means one of the user's own devices received a peer message.
+	ReceiptTypePeerMsg ReceiptType = "peer_msg"
 )
 
 // GoString returns the name of the Go constant for the ReceiptType value.
@@ -113,14 +297,24 @@ func (rt ReceiptType) GoString() string {
 	switch rt {
 	case ReceiptTypeRead:
 		return "events.ReceiptTypeRead"
+	case ReceiptTypeReadSelf:
+		return "events.ReceiptTypeReadSelf"
 	case ReceiptTypeDelivered:
 		return "events.ReceiptTypeDelivered"
+	case ReceiptTypeRetry:
+		return "events.ReceiptTypeRetry"
+	case ReceiptTypePeerMsg:
+		return "events.ReceiptTypePeerMsg"
 	default:
 		return fmt.Sprintf("events.ReceiptType(%#v)", string(rt))
 	}
 }
 
 // Receipt is emitted when an outgoing message is delivered to or read by another user, or when another device reads an incoming message.
+//
+// If IsFromMe is true, the receipt was sent by another one of the current user's devices, which means the messages
+// were read on that device (rather than a peer reading our messages), and any unread counts for the chat can be cleared.
+// In that case Chat is the chat where the messages were read and Sender is the device that read them.
 type Receipt struct {
 	types.MessageSource
 	MessageID   string
@@ -134,12 +328,17 @@ type GroupInfo struct {
 	JID       types.JID  // The group ID in question
 	Notify    string     // Seems like a top-level type for the invite
 	Sender    *types.JID // The user who made the change. Doesn't seem to be present when notify=invite
+	IsFromMe  bool       // True if the change was made by the current user
 	Timestamp time.Time  // The time when the change occurred
 
-	Name     *types.GroupName     // Group name change
-	Topic    *types.GroupTopic    // Group topic (description) change
-	Locked   *types.GroupLocked   // Group locked status change (can only admins edit group info?)
-	Announce *types.GroupAnnounce // Group announce status change (can only admins send messages?)
+	Name      *types.GroupName      // Group name change
+	Topic     *types.GroupTopic     // Group topic (description) change
+	Locked    *types.GroupLocked    // Group locked status change (can only admins edit group info?)
+	Announce  *types.GroupAnnounce  // Group announce status change (can only admins send messages?)
+	Ephemeral *types.GroupEphemeral // Disappearing messages change
+
+	MembershipApprovalMode *types.GroupMembershipApprovalMode // Join approval mode change (do admins need to approve new members?)
+	MemberAddMode          *types.GroupMemberAddMode          // Member add mode change (who can add new members?)
 
 	PrevParticipantVersionID string
 	ParticipantVersionID     string
@@ -158,7 +357,102 @@ type GroupInfo struct {
 type Picture struct {
 	JID       types.JID // The user or group ID where the picture was changed.
 	Author    types.JID // The user who changed the picture.
+	IsFromMe  bool      // True if the picture was changed by the current user.
 	Timestamp time.Time // The timestamp when the picture was changed.
 	Remove    bool      // True if the picture was removed.
 	PictureID string    // The new picture ID if it was not removed.
 }
+
+// UserAbout is emitted when a user's about text (status message) is changed.
+//
+// If JID is the current user, the status was changed from another device.
+type UserAbout struct {
+	JID       types.JID // The user whose status was changed.
+	IsFromMe  bool      // True if the status of the current user was changed.
+	Status    string    // The new status text.
+	Timestamp time.Time // The time when the status was changed.
+}
+
+// BlocklistAction is the type of action in a Blocklist event.
+type BlocklistAction string
+
+const (
+	BlocklistActionDefault BlocklistAction = ""
+	BlocklistActionModify  BlocklistAction = "modify"
+)
+
+// Blocklist is emitted when the user's blocked user list is changed, either by this device or another one.
+type Blocklist struct {
+	// Action specifies what happened. If it's an empty string, there should be Changes.
+	// If it's "modify", the whole list was replaced and should be refetched with Client.GetBlocklist.
+	Action    BlocklistAction
+	DHash     string // The hash of the new blocklist
+	PrevDHash string // The hash of the previous blocklist
+	Changes   []BlocklistChange
+}
+
+// BlocklistChangeAction is the type of change to a single JID in a Blocklist event.
+type BlocklistChangeAction string
+
+const (
+	BlocklistChangeActionBlock   BlocklistChangeAction = "block"
+	BlocklistChangeActionUnblock BlocklistChangeAction = "unblock"
+)
+
+// BlocklistChange is an individual change to the blocklist, contained in a Blocklist event.
+type BlocklistChange struct {
+	JID    types.JID
+	Action BlocklistChangeAction
+}
+
+// PrivacySettings is emitted when the user changes their privacy settings from another device.
+type PrivacySettings struct {
+	// The new settings. Settings that weren't changed may be undefined
+	// if the settings weren't fetched with Client.GetPrivacySettings before.
+	NewSettings types.PrivacySettings
+	// The types of settings that were changed.
+	Changed []types.PrivacySettingType
+}
+
+// NewsletterMessage is emitted when a new message is posted in a newsletter (channel) that the user follows.
+type NewsletterMessage struct {
+	NewsletterJID types.JID
+	types.NewsletterMessage
+}
+
+// NewsletterLiveUpdate is emitted when the view or reaction counts of newsletter messages change.
+//
+// The messages in live updates usually only contain the server ID and the new counts, not the message content.
+type NewsletterLiveUpdate struct {
+	JID      types.JID
+	Time     time.Time
+	Messages []*types.NewsletterMessage
+}
+
+// HandlerPanic is emitted when an event handler panics. The other event handlers still receive the original event.
+//
+// This is not emitted if Client.DisableHandlerPanicRecovery is set.
+type HandlerPanic struct {
+	Event        interface{} // The event that was being handled.
+	Value        interface{} // The value returned by recover().
+	Stack        []byte      // The stack trace of the panic.
+	RegisteredAt string      // The file and line where the panicking handler was registered.
+}
+
+// EventsDropped is emitted when events were dropped because the event queue was full.
+// This is only used with the ordered dispatch mode when the client is configured to drop events instead of blocking.
+type EventsDropped struct {
+	Count int // The number of events that were dropped.
+}
+
+// Presence is emitted when a presence update is received.
+type Presence struct {
+	// The user whose presence event this is
+	From types.JID
+	// True if the user is now offline
+	Unavailable bool
+	// The time when the user was last online. This may be the zero value if the user has hid their last seen time.
+	LastSeen time.Time
+	// True if the user is in the list of presence subscriptions (see Client.SubscribePresence).
+	FromSubscription bool
+}
@@ -19,6 +19,13 @@ type GroupInfo struct {
 	GroupTopic
 	GroupLocked
 	GroupAnnounce
+	GroupEphemeral
+	GroupMembershipApprovalMode
+	MemberAddMode GroupMemberAddMode
+
+	GroupParent
+	GroupLinkedParent
+	GroupIsDefaultSub
 
 	GroupCreated time.Time
 
@@ -52,8 +59,79 @@ type GroupAnnounce struct {
 	AnnounceVersionID string
 }
 
+// GroupEphemeral contains the group's disappearing messages settings.
+type GroupEphemeral struct {
+	IsEphemeral       bool
+	DisappearingTimer uint32 // The disappearing message timer in seconds. Outgoing messages should have it in ContextInfo.Expiration.
+}
+
+// GroupMemberAddMode specifies who is allowed to add new members to a group.
+type GroupMemberAddMode string
+
+const (
+	GroupMemberAddModeAdmin     GroupMemberAddMode = "admin_add"
+	GroupMemberAddModeAllMember GroupMemberAddMode = "all_member_add"
+)
+
+// GroupMembershipApprovalMode specifies whether new members need to be approved by an admin.
+type GroupMembershipApprovalMode struct {
+	IsJoinApprovalRequired bool
+}
+
+// GroupParent contains info about a community (parent group).
+type GroupParent struct {
+	IsParent                      bool
+	DefaultMembershipApprovalMode string // request_required
+}
+
+// GroupLinkedParent contains the community that the group is linked to, if any.
+type GroupLinkedParent struct {
+	LinkedParentJID JID
+}
+
+// GroupIsDefaultSub specifies whether the group is the default announcement group of a community.
+type GroupIsDefaultSub struct {
+	IsDefaultSubGroup bool
+}
+
+// IsCommunity returns true if the group is a community, i.e. a parent group that other groups can be linked to.
+func (gi *GroupInfo) IsCommunity() bool {
+	return gi.IsParent
+}
+
+// IsCommunityAnnouncementGroup returns true if the group is the announcement group of a community.
+func (gi *GroupInfo) IsCommunityAnnouncementGroup() bool {
+	return gi.IsDefaultSubGroup && !gi.LinkedParentJID.IsEmpty()
+}
+
+// IsAnnounceOnly returns true if only admins can send messages in the group.
+// Community announcement groups are always announce-only.
+func (gi *GroupInfo) IsAnnounceOnly() bool {
+	return gi.IsAnnounce || gi.IsCommunityAnnouncementGroup()
+}
+
+// CanSendMessages returns true if the given user is allowed to send messages in the group
+// according to the participant list and announcement settings.
+func (gi *GroupInfo) CanSendMessages(user JID) bool {
+	user = user.ToNonAD()
+	for _, participant := range gi.Participants {
+		if participant.JID.User == user.User {
+			return participant.IsAdmin || !gi.IsAnnounceOnly()
+		}
+	}
+	return false
+}
+
+// GroupLinkTarget contains basic info about a group that is linked to a community.
+type GroupLinkTarget struct {
+	JID JID
+	GroupName
+	GroupIsDefaultSub
+}
+
 // GroupParticipant contains info about a participant of a WhatsApp group chat.
 type GroupParticipant struct {
-	JID     JID
-	IsAdmin bool
+	JID          JID
+	IsAdmin      bool
+	IsSuperAdmin bool // The creator of the group is the super admin.
 }
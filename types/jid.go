@@ -10,6 +10,7 @@ package types
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding"
 	"fmt"
 	"strconv"
 	"strings"
@@ -23,6 +24,9 @@ const (
 	GroupServer       = "g.us"
 	LegacyUserServer  = "c.us"
 	BroadcastServer   = "broadcast"
+	NewsletterServer  = "newsletter"
+	HiddenUserServer  = "lid"
+	BotServer         = "bot"
 )
 
 // Some JIDs that are contacted often.
@@ -39,6 +43,10 @@ var (
 // MessageID is the internal ID of a WhatsApp message.
 type MessageID = string
 
+// MessageServerID is the server ID of a newsletter (channel) message.
+// Unlike normal messages, newsletter messages are referred to by a numeric ID assigned by the server.
+type MessageServerID = int
+
 // JID represents a WhatsApp user ID.
 //
 // There are two types of JIDs: regular JID pairs (user and server) and AD-JIDs (user, agent and device).
@@ -58,18 +66,57 @@ func (jid JID) UserInt() uint64 {
 	return number
 }
 
+// ToNonAD returns the JID of the user without the agent and device parts.
 func (jid JID) ToNonAD() JID {
 	if jid.AD {
 		return JID{
 			User:   jid.User,
-			Server: DefaultUserServer,
+			Server: jid.Server,
 		}
 	} else {
 		return jid
 	}
 }
 
+// ToAD returns the JID of the given device of the user. The agent part is kept if the JID already is an AD JID.
+//
+// For any user JID, jid.ToAD(device).ToNonAD() == jid.ToNonAD(), and for AD JIDs, jid.ToNonAD().ToAD(jid.Device)
+// is equal to jid as long as the agent is 0.
+func (jid JID) ToAD(device uint8) JID {
+	return JID{
+		User:   jid.User,
+		Agent:  jid.Agent,
+		Device: device,
+		Server: jid.Server,
+		AD:     true,
+	}
+}
+
+// IsLID returns true if the JID is a hidden user ID (on the lid server) rather than a phone number.
+func (jid JID) IsLID() bool {
+	return jid.Server == HiddenUserServer
+}
+
+// IsBot returns true if the JID belongs to a bot account.
+func (jid JID) IsBot() bool {
+	return jid.Server == BotServer
+}
+
+// IsNewsletter returns true if the JID is a newsletter (channel).
+func (jid JID) IsNewsletter() bool {
+	return jid.Server == NewsletterServer
+}
+
+// IsBroadcastList returns true if the JID is a broadcast list. The status broadcast is not a broadcast list.
+func (jid JID) IsBroadcastList() bool {
+	return jid.Server == BroadcastServer && jid.User != StatusBroadcastJID.User
+}
+
 // SignalAddress returns the Signal protocol address for the user.
+//
+// The string form of the address (user:device, or user_agent:device if the agent is set) is the
+// canonical key for sessions, identities and sender keys in the store. Each device of a user has
+// its own address, and the primary device is always device 0.
 func (jid JID) SignalAddress() *signalProtocol.SignalAddress {
 	user := jid.User
 	if jid.Agent != 0 {
@@ -89,44 +136,79 @@ func NewADJID(user string, agent, device uint8) JID {
 	}
 }
 
-func parseADJID(user string) (JID, error) {
+// parseADJID parses the user part of an AD JID, which is user.agent:device or just user:device.
+func parseADJID(user, server string) (JID, error) {
 	var fullJID JID
 	fullJID.AD = true
-	fullJID.Server = DefaultUserServer
+	fullJID.Server = server
 
-	dotIndex := strings.IndexRune(user, '.')
 	colonIndex := strings.IndexRune(user, ':')
-	if dotIndex < 0 || colonIndex < 0 || colonIndex+1 <= dotIndex {
-		return fullJID, fmt.Errorf("failed to parse ADJID: missing separators")
+	if colonIndex < 0 {
+		return fullJID, fmt.Errorf("failed to parse ADJID: missing device separator")
 	}
-
-	fullJID.User = user[:dotIndex]
-	agent, err := strconv.Atoi(user[dotIndex+1 : colonIndex])
-	if err != nil {
-		return fullJID, fmt.Errorf("failed to parse agent from JID: %w", err)
-	} else if agent < 0 || agent > 255 {
-		return fullJID, fmt.Errorf("failed to parse agent from JID: invalid value (%d)", agent)
+	dotIndex := strings.IndexRune(user[:colonIndex], '.')
+	if dotIndex < 0 {
+		fullJID.User = user[:colonIndex]
+	} else {
+		fullJID.User = user[:dotIndex]
+		agent, err := strconv.ParseUint(user[dotIndex+1:colonIndex], 10, 8)
+		if err != nil {
+			return fullJID, fmt.Errorf("failed to parse agent from JID: %w", err)
+		}
+		fullJID.Agent = uint8(agent)
 	}
-	device, err := strconv.Atoi(user[colonIndex+1:])
+	device, err := strconv.ParseUint(user[colonIndex+1:], 10, 8)
 	if err != nil {
 		return fullJID, fmt.Errorf("failed to parse device from JID: %w", err)
-	} else if device < 0 || device > 255 {
-		return fullJID, fmt.Errorf("failed to parse device from JID: invalid value (%d)", device)
 	}
-	fullJID.Agent = uint8(agent)
 	fullJID.Device = uint8(device)
 	return fullJID, nil
 }
 
+func isNumeric(str string) bool {
+	if len(str) == 0 {
+		return false
+	}
+	for _, char := range str {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+	return true
+}
+
 // ParseJID parses a JID out of the given string. It supports both regular and AD JIDs.
+//
+// A string without @ is parsed as a server-only JID (e.g. s.whatsapp.net), and an empty string is parsed as EmptyJID.
+// An error is returned if the string has an empty user or server part, or if a phone number JID has a non-numeric user.
 func ParseJID(jid string) (JID, error) {
+	if len(jid) == 0 {
+		return EmptyJID, nil
+	}
 	parts := strings.Split(jid, "@")
 	if len(parts) == 1 {
 		return NewJID("", parts[0]), nil
-	} else if strings.ContainsRune(parts[0], ':') && strings.ContainsRune(parts[0], '.') && parts[1] == DefaultUserServer {
-		return parseADJID(parts[0])
+	} else if len(parts) > 2 {
+		return EmptyJID, fmt.Errorf("failed to parse JID %q: too many @ characters", jid)
 	}
-	return NewJID(parts[0], parts[1]), nil
+	user, server := parts[0], parts[1]
+	if len(user) == 0 {
+		return EmptyJID, fmt.Errorf("failed to parse JID %q: empty user part", jid)
+	} else if len(server) == 0 {
+		return EmptyJID, fmt.Errorf("failed to parse JID %q: empty server part", jid)
+	}
+	parsed := NewJID(user, server)
+	if (server == DefaultUserServer || server == HiddenUserServer) && strings.ContainsRune(user, ':') {
+		var err error
+		parsed, err = parseADJID(user, server)
+		if err != nil {
+			return EmptyJID, fmt.Errorf("failed to parse JID %q: %w", jid, err)
+		}
+	}
+	if parsed.Server == DefaultUserServer && !isNumeric(parsed.User) {
+		return EmptyJID, fmt.Errorf("failed to parse JID %q: user part must be a phone number", jid)
+	}
+	return parsed, nil
 }
 
 // NewJID creates a new regular JID.
@@ -154,7 +236,30 @@ func (jid JID) IsEmpty() bool {
 	return len(jid.Server) == 0
 }
 
-var _ sql.Scanner = (*JID)(nil)
+var (
+	_ sql.Scanner              = (*JID)(nil)
+	_ driver.Valuer            = JID{}
+	_ encoding.TextMarshaler   = JID{}
+	_ encoding.TextUnmarshaler = (*JID)(nil)
+)
+
+// MarshalText returns the string representation of the JID. This makes JIDs be encoded as strings in JSON.
+func (jid JID) MarshalText() ([]byte, error) {
+	if jid.IsEmpty() {
+		return []byte{}, nil
+	}
+	return []byte(jid.String()), nil
+}
+
+// UnmarshalText parses the given string into this JID using ParseJID.
+func (jid *JID) UnmarshalText(text []byte) error {
+	out, err := ParseJID(string(text))
+	if err != nil {
+		return err
+	}
+	*jid = out
+	return nil
+}
 
 // Scan scans the given SQL value into this JID.
 func (jid *JID) Scan(src interface{}) error {
@@ -0,0 +1,94 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package types
+
+import (
+	"testing"
+)
+
+func TestSignalAddressDevices(t *testing.T) {
+	primary := NewJID("1234567890", DefaultUserServer)
+	primaryAD := NewADJID("1234567890", 0, 0)
+	companion := NewADJID("1234567890", 0, 5)
+
+	if primary.SignalAddress().String() != "1234567890:0" {
+		t.Errorf("Unexpected primary device address %s", primary.SignalAddress())
+	}
+	if primary.SignalAddress().String() != primaryAD.SignalAddress().String() {
+		t.Errorf("Non-AD and device 0 AD JIDs have different addresses: %s and %s", primary.SignalAddress(), primaryAD.SignalAddress())
+	}
+	if companion.SignalAddress().String() != "1234567890:5" {
+		t.Errorf("Unexpected companion device address %s", companion.SignalAddress())
+	}
+	if companion.SignalAddress().String() == primaryAD.SignalAddress().String() {
+		t.Errorf("Device 0 and device 5 have the same address %s", companion.SignalAddress())
+	}
+}
+
+func TestParseJID(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected JID
+	}{
+		{"", EmptyJID},
+		{"s.whatsapp.net", ServerJID},
+		{"1234567890@s.whatsapp.net", NewJID("1234567890", DefaultUserServer)},
+		{"1234567890.0:5@s.whatsapp.net", NewADJID("1234567890", 0, 5)},
+		{"1234567890.1:5@s.whatsapp.net", NewADJID("1234567890", 1, 5)},
+		{"1234567890:5@s.whatsapp.net", NewADJID("1234567890", 0, 5)},
+		{"1234567890:5@lid", NewJID("1234567890", HiddenUserServer).ToAD(5)},
+		{"123456789-123456@g.us", NewJID("123456789-123456", GroupServer)},
+		{"status@broadcast", StatusBroadcastJID},
+	}
+	for _, test := range tests {
+		parsed, err := ParseJID(test.input)
+		if err != nil {
+			t.Errorf("Failed to parse %q: %v", test.input, err)
+		} else if parsed != test.expected {
+			t.Errorf("Unexpected result for %q: %#v", test.input, parsed)
+		}
+	}
+}
+
+func TestParseJIDErrors(t *testing.T) {
+	for _, input := range []string{
+		"@s.whatsapp.net",
+		"1234567890@",
+		"a@b@c",
+		"notanumber@s.whatsapp.net",
+		"1234567890.x:5@s.whatsapp.net",
+		"1234567890.0:300@s.whatsapp.net",
+	} {
+		if parsed, err := ParseJID(input); err == nil {
+			t.Errorf("Expected error when parsing %q, got %#v", input, parsed)
+		}
+	}
+}
+
+func TestJIDRoundTrip(t *testing.T) {
+	for _, jid := range []JID{
+		NewJID("1234567890", DefaultUserServer),
+		NewADJID("1234567890", 1, 5),
+		NewJID("123456789-123456", GroupServer),
+		NewJID("1234567890", HiddenUserServer).ToAD(3),
+		ServerJID,
+	} {
+		text, err := jid.MarshalText()
+		if err != nil {
+			t.Fatalf("Failed to marshal %s: %v", jid, err)
+		}
+		var parsed JID
+		if err = parsed.UnmarshalText(text); err != nil {
+			t.Errorf("Failed to unmarshal %s: %v", text, err)
+		} else if parsed != jid {
+			t.Errorf("%s didn't round-trip: got %#v", jid, parsed)
+		}
+		if jid.ToAD(2).ToNonAD() != jid.ToNonAD() {
+			t.Errorf("ToAD/ToNonAD didn't round-trip for %s", jid)
+		}
+	}
+}
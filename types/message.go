@@ -17,6 +17,10 @@ type MessageSource struct {
 	Sender   JID  // The user who sent the message.
 	IsFromMe bool // Whether the message was sent by the current user instead of someone else.
 	IsGroup  bool // Whether the chat is a group chat or broadcast list.
+
+	// Whether the chat is the announcement group of a community.
+	// This is only filled if the group info is cached (see Client.GroupInfoCacheTTL).
+	IsCommunityAnnouncement bool
 }
 
 // DeviceSentMeta contains metadata from messages sent by another one of the user's own devices.
@@ -25,14 +29,36 @@ type DeviceSentMeta struct {
 	Phash          string
 }
 
+// EditAttribute is the value of the edit attribute of message stanzas, which marks edits and revocations.
+type EditAttribute string
+
+const (
+	EditAttributeEmpty        EditAttribute = ""
+	EditAttributeMessageEdit  EditAttribute = "1"
+	EditAttributePinInChat    EditAttribute = "2"
+	EditAttributeAdminEdit    EditAttribute = "3"
+	EditAttributeSenderRevoke EditAttribute = "7"
+	EditAttributeAdminRevoke  EditAttribute = "8"
+)
+
 // MessageInfo contains metadata about an incoming message.
 type MessageInfo struct {
 	MessageSource
 	ID        string
 	Type      string
 	PushName  string
-	Timestamp time.Time
+	Timestamp time.Time // The server timestamp of the message.
 	Category  string
+	Multicast bool // True if the message was sent to multiple chats at once, e.g. through a broadcast list.
+	Offline   bool // True if the message was sent while the client was offline and delivered from the offline queue.
+	Edit      EditAttribute
+
+	// These flags describe the kind of message. IsEdit is based on the stanza, while the other flags also take
+	// the decrypted message into account, so they're only fully filled in Message events.
+	IsEdit      bool // True if the message is an edit of a previously sent message.
+	IsRevoke    bool // True if the message deletes a previously sent message for everyone.
+	IsViewOnce  bool // True if the message was wrapped in a ViewOnceMessage.
+	IsEphemeral bool // True if the message was wrapped in an EphemeralMessage (i.e. it's a disappearing message).
 
 	DeviceSentMeta *DeviceSentMeta // Metadata for direct messages sent from another one of the user's own devices.
 }
@@ -47,3 +73,77 @@ func (mi *MessageInfo) SourceString() string {
 		return mi.Chat.String()
 	}
 }
+
+// StickerInfo contains metadata about a sticker message.
+type StickerInfo struct {
+	Mimetype   string
+	Width      uint32
+	Height     uint32
+	IsAnimated bool
+	FileLength uint64
+}
+
+// InteractiveType is the kind of interactive message in InteractiveInfo and InteractiveResponse.
+type InteractiveType string
+
+const (
+	InteractiveButtons    InteractiveType = "buttons"
+	InteractiveList       InteractiveType = "list"
+	InteractiveTemplate   InteractiveType = "template"
+	InteractiveNativeFlow InteractiveType = "native_flow"
+)
+
+// InteractiveButton is a single button of a buttons, template or native flow message, or a single row of a list message.
+type InteractiveButton struct {
+	ID          string // The ID that is sent back when the button is pressed. Empty for URL and call buttons.
+	Title       string // The text shown on the button or the title of the list row.
+	Description string // The description of the list row. Only used in list messages.
+	Section     string // The title of the section the list row is in. Only used in list messages.
+
+	URL         string // The URL that URL buttons open.
+	PhoneNumber string // The phone number that call buttons call.
+}
+
+// InteractiveInfo contains the normalized contents of a buttons, list, template or interactive message.
+type InteractiveInfo struct {
+	Type       InteractiveType
+	Title      string
+	Text       string
+	Footer     string
+	ButtonText string // The text of the button that opens the list. Only used in list messages.
+	Buttons    []InteractiveButton
+}
+
+// InteractiveResponse contains the button or list row that a user selected in response to an interactive message.
+type InteractiveResponse struct {
+	Type          InteractiveType
+	SelectedID    string
+	SelectedText  string
+	SelectedIndex int       // The index of the selected button. Only present in template button replies.
+	ReplyTo       MessageID // The ID of the interactive message that the user responded to.
+}
+
+// Reaction is a reaction to a message, see whatsmeow.Client.GetMessageReactions.
+type Reaction struct {
+	Sender    JID       // The user who reacted.
+	Emoji     string    // The reaction, usually a single emoji. Empty means the reaction was removed.
+	Timestamp time.Time // When the reaction was sent or last changed.
+}
+
+// Poll contains the question and options of a poll, see whatsmeow.Client.GetPollResults.
+type Poll struct {
+	ID      MessageID
+	Sender  JID // The user who created the poll.
+	Name    string
+	Options []string
+	// The maximum number of options that each user can select. Zero means there's no limit.
+	SelectableOptionsCount int
+}
+
+// PollVote is the current vote of a user in a poll, see whatsmeow.Client.GetPollResults.
+type PollVote struct {
+	Voter JID // The user who voted.
+	// The SHA-256 hashes of the names of the selected options. Empty means the user removed their vote.
+	SelectedOptions [][]byte
+	Timestamp       time.Time // When the vote was sent or last changed.
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package types
+
+import (
+	"time"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+)
+
+// NewsletterMessageStats contains the view and reaction counts of a newsletter message.
+type NewsletterMessageStats struct {
+	ServerID       MessageServerID
+	ViewsCount     int
+	ReactionCounts map[string]int
+}
+
+// NewsletterRole is the role of the current user in a newsletter.
+type NewsletterRole string
+
+const (
+	NewsletterRoleSubscriber NewsletterRole = "subscriber"
+	NewsletterRoleGuest      NewsletterRole = "guest"
+	NewsletterRoleAdmin      NewsletterRole = "admin"
+	NewsletterRoleOwner      NewsletterRole = "owner"
+)
+
+// NewsletterPicture contains the ID and path of a newsletter's picture or picture preview.
+type NewsletterPicture struct {
+	ID         string
+	Type       string
+	DirectPath string
+}
+
+// NewsletterMetadata contains info about a newsletter (channel).
+type NewsletterMetadata struct {
+	ID    JID
+	State string // "active", "suspended" or "geosuspended"
+
+	Name              string
+	Description       string
+	InviteCode        string
+	SubscriberCount   int
+	IsVerified        bool
+	CreationTime      time.Time
+	Picture           *NewsletterPicture
+	Preview           *NewsletterPicture
+	ViewerRole        NewsletterRole // The role of the current user. Empty if the user isn't subscribed.
+	ViewerMutedNotifs bool
+}
+
+// NewsletterMessage contains a single message in a newsletter, either from the message history or received live.
+type NewsletterMessage struct {
+	ServerID  MessageServerID
+	MessageID string
+	Type      string
+	Timestamp time.Time
+
+	ViewsCount     int
+	ReactionCounts map[string]int
+
+	// The message itself. Newsletter messages are not end-to-end encrypted, so this is always available.
+	// May be nil in live updates, which only contain the updated counts.
+	Message *waProto.Message
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package types
+
+// PrivacySettingType is the type of privacy setting.
+type PrivacySettingType string
+
+// The known privacy setting types.
+const (
+	PrivacySettingTypeGroupAdd     PrivacySettingType = "groupadd"     // Valid values: PrivacySettingAll, PrivacySettingContacts, PrivacySettingContactBlacklist, PrivacySettingNone
+	PrivacySettingTypeLastSeen     PrivacySettingType = "last"         // Valid values: PrivacySettingAll, PrivacySettingContacts, PrivacySettingContactBlacklist, PrivacySettingNone
+	PrivacySettingTypeStatus       PrivacySettingType = "status"       // Valid values: PrivacySettingAll, PrivacySettingContacts, PrivacySettingContactBlacklist, PrivacySettingNone
+	PrivacySettingTypeProfile      PrivacySettingType = "profile"      // Valid values: PrivacySettingAll, PrivacySettingContacts, PrivacySettingContactBlacklist, PrivacySettingNone
+	PrivacySettingTypeReadReceipts PrivacySettingType = "readreceipts" // Valid values: PrivacySettingAll, PrivacySettingNone
+	PrivacySettingTypeOnline       PrivacySettingType = "online"       // Valid values: PrivacySettingAll, PrivacySettingMatchLastSeen
+)
+
+// PrivacySetting is the value of a privacy setting.
+type PrivacySetting string
+
+// The known privacy setting values.
+const (
+	PrivacySettingUndefined        PrivacySetting = ""
+	PrivacySettingAll              PrivacySetting = "all"
+	PrivacySettingContacts         PrivacySetting = "contacts"
+	PrivacySettingContactBlacklist PrivacySetting = "contact_blacklist" // Contacts except the ones in the exclusion list
+	PrivacySettingMatchLastSeen    PrivacySetting = "match_last_seen"
+	PrivacySettingNone             PrivacySetting = "none"
+)
+
+// PrivacySettings contains the user's privacy settings.
+type PrivacySettings struct {
+	GroupAdd     PrivacySetting
+	LastSeen     PrivacySetting
+	Status       PrivacySetting
+	Profile      PrivacySetting
+	ReadReceipts PrivacySetting
+	Online       PrivacySetting
+}
+
+// Set updates the value of the given setting type. Unknown types are ignored.
+func (ps *PrivacySettings) Set(name PrivacySettingType, value PrivacySetting) {
+	switch name {
+	case PrivacySettingTypeGroupAdd:
+		ps.GroupAdd = value
+	case PrivacySettingTypeLastSeen:
+		ps.LastSeen = value
+	case PrivacySettingTypeStatus:
+		ps.Status = value
+	case PrivacySettingTypeProfile:
+		ps.Profile = value
+	case PrivacySettingTypeReadReceipts:
+		ps.ReadReceipts = value
+	case PrivacySettingTypeOnline:
+		ps.Online = value
+	}
+}
+
+// StatusPrivacyType is the type of list in StatusPrivacy.
+type StatusPrivacyType string
+
+const (
+	// StatusPrivacyTypeContacts means statuses are sent to all contacts.
+	StatusPrivacyTypeContacts StatusPrivacyType = "contacts"
+	// StatusPrivacyTypeBlacklist means statuses are sent to all contacts, except the ones on the list ("my contacts except").
+	StatusPrivacyTypeBlacklist StatusPrivacyType = "blacklist"
+	// StatusPrivacyTypeWhitelist means statuses are only sent to users on the list ("only share with").
+	StatusPrivacyTypeWhitelist StatusPrivacyType = "whitelist"
+)
+
+// StatusPrivacy contains the settings for who to send status messages to by default.
+type StatusPrivacy struct {
+	Type StatusPrivacyType
+	List []JID
+
+	IsDefault bool
+}
@@ -18,10 +18,20 @@ type VerifiedName struct {
 	Details     *waProto.VerifiedNameDetails
 }
 
+// IsOnWhatsAppResponse contains information received in response to checking if a phone number is on WhatsApp.
+type IsOnWhatsAppResponse struct {
+	Query string // The phone number as it was given in the input
+	JID   JID    // The canonical user ID
+	IsIn  bool   // Whether the phone is registered or not.
+
+	VerifiedName *VerifiedName // If the phone is a business, the verified business details.
+}
+
 // UserInfo contains info about a WhatsApp user.
 type UserInfo struct {
 	VerifiedName *VerifiedName
-	Status       string
+	Status       string    // The status (about) text. Empty if the user hasn't set one or it's hidden by their privacy settings.
+	StatusSetAt  time.Time // The time when the status text was set. Zero if the status is empty.
 	PictureID    string
 	Devices      []JID
 }
@@ -37,6 +47,9 @@ type ProfilePictureInfo struct {
 
 // ContactInfo contains the cached names of a WhatsApp user.
 type ContactInfo struct {
+	JID JID
+	// Found is false if nothing has been stored about the user, in which case all the names are empty.
+	// When Found is true, the names may still be empty (e.g. if only some of them are known).
 	Found bool
 
 	FirstName    string
@@ -53,3 +66,14 @@ type LocalChatSettings struct {
 	Pinned     bool
 	Archived   bool
 }
+
+// IsMuted returns whether the chat is muted at the given time. Chats that are muted forever have a negative MutedUntil.
+func (settings LocalChatSettings) IsMuted(now time.Time) bool {
+	return !settings.MutedUntil.IsZero() && (settings.MutedUntil.Unix() < 0 || settings.MutedUntil.After(now))
+}
+
+// Blocklist contains the list of users that the current user has blocked.
+type Blocklist struct {
+	DHash string // The hash of the blocklist, changes whenever the list changes
+	JIDs  []JID
+}
@@ -67,7 +67,8 @@ func (cli *Client) Upload(ctx context.Context, plaintext []byte, appInfo MediaTy
 	fileEncSHA256 := sha256.Sum256(dataToUpload)
 	resp.FileEncSHA256 = fileEncSHA256[:]
 
-	err = cli.refreshMediaConn(false)
+	var mediaConn *MediaConn
+	mediaConn, err = cli.refreshMediaConn(false)
 	if err != nil {
 		err = fmt.Errorf("failed to refresh media connections: %w", err)
 		return
@@ -75,13 +76,13 @@ func (cli *Client) Upload(ctx context.Context, plaintext []byte, appInfo MediaTy
 
 	token := base64.URLEncoding.EncodeToString(resp.FileEncSHA256)
 	q := url.Values{
-		"auth":  []string{cli.mediaConn.Auth},
+		"auth":  []string{mediaConn.Auth},
 		"token": []string{token},
 	}
 	path := mediaTypeMap[appInfo]
 	uploadURL := url.URL{
 		Scheme:   "https",
-		Host:     cli.mediaConn.Hosts[0].Hostname,
+		Host:     mediaConn.Hosts[0].Hostname,
 		Path:     fmt.Sprintf("%s/%s", path, token),
 		RawQuery: q.Encode(),
 	}
@@ -104,6 +105,8 @@ func (cli *Client) Upload(ctx context.Context, plaintext []byte, appInfo MediaTy
 		err = fmt.Errorf("upload failed with status code %d", httpResp.StatusCode)
 	} else if err = json.NewDecoder(httpResp.Body).Decode(&resp); err != nil {
 		err = fmt.Errorf("failed to parse upload response: %w", err)
+	} else {
+		cli.incrCounter(&cli.metrics.mediaBytesUploaded, MetricMediaBytesUploaded, uint64(len(dataToUpload)))
 	}
 	return
 }
@@ -9,45 +9,86 @@ package whatsmeow
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"google.golang.org/protobuf/proto"
 
+	"go.mau.fi/whatsmeow/appstate"
 	waBinary "go.mau.fi/whatsmeow/binary"
 	waProto "go.mau.fi/whatsmeow/binary/proto"
 	"go.mau.fi/whatsmeow/types"
 	"go.mau.fi/whatsmeow/types/events"
 )
 
-// IsOnWhatsAppResponse contains information received in response to checking if a phone number is on WhatsApp.
-type IsOnWhatsAppResponse struct {
-	Query string    // The query string used, plus @c.us at the end
-	JID   types.JID // The canonical user ID
-	IsIn  bool      // Whether the phone is registered or not.
+// usyncBatchSize is the maximum number of users to include in a single usync query.
+const usyncBatchSize = 500
 
-	VerifiedName *types.VerifiedName // If the phone is a business, the verified business details.
+// MaxStatusMessageLength is the maximum number of characters in the status text set with Client.SetStatusMessage.
+const MaxStatusMessageLength = 139
+
+// normalizePhoneNumber strips formatting characters from the phone number and ensures it starts with a +.
+func normalizePhoneNumber(phone string) string {
+	var builder strings.Builder
+	builder.WriteByte('+')
+	for _, char := range phone {
+		if char >= '0' && char <= '9' {
+			builder.WriteRune(char)
+		}
+	}
+	return builder.String()
 }
 
 // IsOnWhatsApp checks if the given phone numbers are registered on WhatsApp.
-// The phone numbers should be in international format, including the `+` prefix.
-func (cli *Client) IsOnWhatsApp(phones []string) ([]IsOnWhatsAppResponse, error) {
-	jids := make([]types.JID, len(phones))
-	for i := range jids {
-		jids[i] = types.NewJID(phones[i], types.LegacyUserServer)
+//
+// The phone numbers should be in international format. The `+` prefix and other formatting characters
+// like spaces and dashes are optional. The response contains one item for each input in the same order,
+// and numbers that aren't registered will have IsIn set to false.
+func (cli *Client) IsOnWhatsApp(phones []string) ([]types.IsOnWhatsAppResponse, error) {
+	normalized := make([]string, len(phones))
+	uniqueJIDs := make([]types.JID, 0, len(phones))
+	seen := make(map[string]struct{}, len(phones))
+	for i, phone := range phones {
+		normalized[i] = normalizePhoneNumber(phone)
+		if _, alreadySeen := seen[normalized[i]]; !alreadySeen {
+			seen[normalized[i]] = struct{}{}
+			uniqueJIDs = append(uniqueJIDs, types.NewJID(normalized[i], types.LegacyUserServer))
+		}
+	}
+	results := make(map[string]types.IsOnWhatsAppResponse, len(uniqueJIDs))
+	for start := 0; start < len(uniqueJIDs); start += usyncBatchSize {
+		end := start + usyncBatchSize
+		if end > len(uniqueJIDs) {
+			end = len(uniqueJIDs)
+		}
+		err := cli.isOnWhatsAppBatch(uniqueJIDs[start:end], results)
+		if err != nil {
+			return nil, err
+		}
 	}
+	output := make([]types.IsOnWhatsAppResponse, len(phones))
+	for i, phone := range phones {
+		output[i] = results[normalized[i]]
+		output[i].Query = phone
+	}
+	return output, nil
+}
+
+func (cli *Client) isOnWhatsAppBatch(jids []types.JID, results map[string]types.IsOnWhatsAppResponse) error {
 	list, err := cli.usync(jids, "query", "interactive", []waBinary.Node{
 		{Tag: "business", Content: []waBinary.Node{{Tag: "verified_name"}}},
 		{Tag: "contact"},
 	})
 	if err != nil {
-		return nil, err
+		return err
 	}
-	output := make([]IsOnWhatsAppResponse, 0, len(jids))
 	for _, child := range list.GetChildren() {
 		jid, jidOK := child.Attrs["jid"].(types.JID)
 		if child.Tag != "user" || !jidOK {
 			continue
 		}
-		var info IsOnWhatsAppResponse
+		var info types.IsOnWhatsAppResponse
 		info.JID = jid
 		info.VerifiedName, err = parseVerifiedName(child.GetChildByTag("business"))
 		if err != nil {
@@ -55,15 +96,38 @@ func (cli *Client) IsOnWhatsApp(phones []string) ([]IsOnWhatsAppResponse, error)
 		}
 		contactNode := child.GetChildByTag("contact")
 		info.IsIn = contactNode.AttrGetter().String("type") == "in"
-		contactQuery, _ := contactNode.Content.([]byte)
-		info.Query = string(contactQuery)
-		output = append(output, info)
+		var contactQuery string
+		switch content := contactNode.Content.(type) {
+		case []byte:
+			contactQuery = string(content)
+		case string:
+			contactQuery = content
+		}
+		results[normalizePhoneNumber(strings.TrimSuffix(contactQuery, "@"+types.LegacyUserServer))] = info
 	}
-	return output, nil
+	return nil
 }
 
 // GetUserInfo gets basic user info (avatar, status, verified business name, device list).
+//
+// The users are queried in batches of 500. If a user's status (about text) is hidden by their privacy settings,
+// the Status field will simply be empty instead of returning an error.
 func (cli *Client) GetUserInfo(jids []types.JID) (map[types.JID]types.UserInfo, error) {
+	respData := make(map[types.JID]types.UserInfo, len(jids))
+	for start := 0; start < len(jids); start += usyncBatchSize {
+		end := start + usyncBatchSize
+		if end > len(jids) {
+			end = len(jids)
+		}
+		err := cli.getUserInfoBatch(jids[start:end], respData)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return respData, nil
+}
+
+func (cli *Client) getUserInfoBatch(jids []types.JID, respData map[types.JID]types.UserInfo) error {
 	list, err := cli.usync(jids, "full", "background", []waBinary.Node{
 		{Tag: "business", Content: []waBinary.Node{{Tag: "verified_name"}}},
 		{Tag: "status"},
@@ -71,38 +135,93 @@ func (cli *Client) GetUserInfo(jids []types.JID) (map[types.JID]types.UserInfo,
 		{Tag: "devices", Attrs: waBinary.Attrs{"version": "2"}},
 	})
 	if err != nil {
-		return nil, err
+		return err
 	}
-	respData := make(map[types.JID]types.UserInfo, len(jids))
 	for _, child := range list.GetChildren() {
 		jid, jidOK := child.Attrs["jid"].(types.JID)
 		if child.Tag != "user" || !jidOK {
 			continue
 		}
-		verifiedName, err := parseVerifiedName(child.GetChildByTag("business"))
+		var info types.UserInfo
+		info.VerifiedName, err = parseVerifiedName(child.GetChildByTag("business"))
 		if err != nil {
 			cli.Log.Warnf("Failed to parse %s's verified name details: %v", jid, err)
 		}
-		status, _ := child.GetChildByTag("status").Content.([]byte)
-		pictureID, _ := child.GetChildByTag("picture").Attrs["id"].(string)
-		devices := parseDeviceList(jid.User, child.GetChildByTag("devices"), nil, nil)
-		respData[jid] = types.UserInfo{
-			VerifiedName: verifiedName,
-			Status:       string(status),
-			PictureID:    pictureID,
-			Devices:      devices,
+		statusNode := child.GetChildByTag("status")
+		// Hidden statuses have an error child (e.g. code 401) instead of the text.
+		if _, hidden := statusNode.GetOptionalChildByTag("error"); !hidden {
+			status, _ := statusNode.Content.([]byte)
+			info.Status = string(status)
+			if ts := statusNode.AttrGetter().OptionalInt64("t"); len(info.Status) > 0 && ts > 0 {
+				info.StatusSetAt = time.Unix(ts, 0)
+			}
 		}
-		if verifiedName != nil {
-			cli.updateBusinessName(jid, verifiedName.Details.GetVerifiedName())
+		info.PictureID, _ = child.GetChildByTag("picture").Attrs["id"].(string)
+		info.Devices = parseDeviceList(jid.User, child.GetChildByTag("devices"), nil, nil)
+		respData[jid] = info
+		if info.VerifiedName != nil {
+			cli.updateBusinessName(jid, info.VerifiedName.Details.GetVerifiedName())
 		}
 	}
-	return respData, nil
+	return nil
+}
+
+// SetStatusMessage updates the current user's status text, which is shown in the "About" section of the user profile.
+//
+// This is different from the ephemeral status broadcast messages. Use SendMessage to types.StatusBroadcastJID to send
+// such messages.
+//
+// The text can be at most MaxStatusMessageLength characters long, longer messages return ErrStatusTooLong.
+func (cli *Client) SetStatusMessage(msg string) error {
+	if utf8.RuneCountInString(msg) > MaxStatusMessageLength {
+		return ErrStatusTooLong
+	}
+	_, err := cli.sendIQ(infoQuery{
+		Namespace: "status",
+		Type:      "set",
+		To:        types.ServerJID,
+		Content: []waBinary.Node{{
+			Tag:     "status",
+			Content: []byte(msg),
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set status message: %w", err)
+	}
+	return nil
+}
+
+// GetMyStatus gets the current user's status text (about).
+func (cli *Client) GetMyStatus() (string, error) {
+	if cli.Store.ID == nil {
+		return "", ErrNotLoggedIn
+	}
+	ownJID := cli.Store.ID.ToNonAD()
+	info, err := cli.GetUserInfo([]types.JID{ownJID})
+	if err != nil {
+		return "", fmt.Errorf("failed to get own status message: %w", err)
+	}
+	return info[ownJID].Status, nil
 }
 
 // GetUserDevices gets the list of devices that the given user has. The input should be a list of
 // regular JIDs, and the output will be a list of AD JIDs. The local device will not be included in
 // the output even if the user's JID is included in the input. All other devices will be included.
 func (cli *Client) GetUserDevices(jids []types.JID) ([]types.JID, error) {
+	lists, err := cli.fetchDeviceLists(jids)
+	if err != nil {
+		return nil, err
+	}
+
+	var devices []types.JID
+	for _, userDevices := range lists {
+		devices = cli.appendDevicesExceptOwn(devices, userDevices)
+	}
+	return devices, nil
+}
+
+// fetchDeviceLists gets the full device lists of the given users, keyed by the non-AD JID of each user.
+func (cli *Client) fetchDeviceLists(jids []types.JID) (map[types.JID][]types.JID, error) {
 	list, err := cli.usync(jids, "query", "message", []waBinary.Node{
 		{Tag: "devices", Attrs: waBinary.Attrs{"version": "2"}},
 	})
@@ -110,29 +229,46 @@ func (cli *Client) GetUserDevices(jids []types.JID) ([]types.JID, error) {
 		return nil, err
 	}
 
-	var devices []types.JID
+	lists := make(map[types.JID][]types.JID, len(jids))
 	for _, user := range list.GetChildren() {
 		jid, jidOK := user.Attrs["jid"].(types.JID)
 		if user.Tag != "user" || !jidOK {
 			continue
 		}
-		parseDeviceList(jid.User, user.GetChildByTag("devices"), &devices, cli.Store.ID)
+		lists[jid.ToNonAD()] = parseDeviceList(jid.User, user.GetChildByTag("devices"), nil, nil)
 	}
+	return lists, nil
+}
 
-	return devices, nil
+// GetProfilePictureParams contains the optional parameters for GetProfilePictureInfo.
+type GetProfilePictureParams struct {
+	// If true, the URL of the low-resolution thumbnail is requested instead of the full image.
+	Preview bool
+	// The ID of the picture that is already known. If the picture hasn't changed,
+	// GetProfilePictureInfo will return ErrProfilePictureNotChanged instead of the info.
+	ExistingID string
+	// Set to true if the JID is a community. Community photos are fetched through the group namespace.
+	IsCommunity bool
 }
 
-// GetProfilePictureInfo gets the URL where you can download a WhatsApp user's profile picture or group's photo.
-func (cli *Client) GetProfilePictureInfo(jid types.JID, preview bool) (*types.ProfilePictureInfo, error) {
+// GetProfilePictureInfo gets the URL where you can download a WhatsApp user's profile picture, group's photo or
+// community's photo.
+//
+// If the user or group doesn't have a picture, this returns nil with no error.
+// If the picture is hidden by the user's privacy settings, this returns ErrProfilePictureUnauthorized.
+func (cli *Client) GetProfilePictureInfo(jid types.JID, params GetProfilePictureParams) (*types.ProfilePictureInfo, error) {
 	attrs := waBinary.Attrs{
 		"query": "url",
 	}
-	if preview {
+	if params.Preview {
 		attrs["type"] = "preview"
 	} else {
 		attrs["type"] = "image"
 	}
-	resp, err := cli.sendIQ(infoQuery{
+	if len(params.ExistingID) > 0 {
+		attrs["id"] = params.ExistingID
+	}
+	query := infoQuery{
 		Namespace: "w:profile:picture",
 		Type:      "get",
 		To:        jid,
@@ -140,24 +276,43 @@ func (cli *Client) GetProfilePictureInfo(jid types.JID, preview bool) (*types.Pr
 			Tag:   "picture",
 			Attrs: attrs,
 		}},
-	})
-	if err != nil {
-		if errors.Is(err, ErrIQError) {
-			code := resp.GetChildByTag("error").Attrs["code"].(string)
-			if code == "404" {
-				return nil, nil
-			} else if code == "401" {
-				return nil, ErrProfilePictureUnauthorized
-			}
-		}
+	}
+	if params.IsCommunity {
+		attrs["parent_group_jid"] = jid
+		query.Namespace = "w:g2"
+		query.Content = []waBinary.Node{{
+			Tag:     "pictures",
+			Content: query.Content,
+		}}
+	}
+	resp, err := cli.sendIQ(query)
+	if errors.Is(err, ErrIQNotFound) {
+		return nil, nil
+	} else if errors.Is(err, ErrIQNotAuthorized) {
+		return nil, fmt.Errorf("%w: %v", ErrProfilePictureUnauthorized, err)
+	} else if errors.Is(err, ErrIQNotModified) {
+		return nil, ErrProfilePictureNotChanged
+	} else if err != nil {
 		return nil, err
 	}
-	picture, ok := resp.GetOptionalChildByTag("picture")
+	var picture waBinary.Node
+	var ok bool
+	if params.IsCommunity {
+		picture, ok = resp.GetOptionalChildByTag("pictures", "picture")
+	} else {
+		picture, ok = resp.GetOptionalChildByTag("picture")
+	}
 	if !ok {
+		if len(params.ExistingID) > 0 {
+			return nil, ErrProfilePictureNotChanged
+		}
 		return nil, fmt.Errorf("missing <picture> element in response to profile picture query")
 	}
 	var info types.ProfilePictureInfo
 	ag := picture.AttrGetter()
+	if ag.OptionalString("status") == "304" {
+		return nil, ErrProfilePictureNotChanged
+	}
 	info.ID = ag.String("id")
 	info.URL = ag.String("url")
 	info.Type = ag.String("type")
@@ -168,6 +323,60 @@ func (cli *Client) GetProfilePictureInfo(jid types.JID, preview bool) (*types.Pr
 	return &info, nil
 }
 
+// SetProfilePhoto updates the profile photo of the current user and returns the new picture ID.
+//
+// The photo should be a square JPEG. Passing nil removes the photo, in which case the returned ID is empty.
+func (cli *Client) SetProfilePhoto(jpeg []byte) (string, error) {
+	var content []waBinary.Node
+	if jpeg != nil {
+		content = []waBinary.Node{{
+			Tag:     "picture",
+			Attrs:   waBinary.Attrs{"type": "image"},
+			Content: jpeg,
+		}}
+	}
+	resp, err := cli.sendIQ(infoQuery{
+		Namespace: "w:profile:picture",
+		Type:      "set",
+		To:        types.ServerJID,
+		Content:   content,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to set profile photo: %w", err)
+	}
+	if jpeg == nil {
+		return "", nil
+	}
+	pictureID, ok := resp.GetChildByTag("picture").Attrs["id"].(string)
+	if !ok {
+		return "", fmt.Errorf("didn't find picture ID in response")
+	}
+	return pictureID, nil
+}
+
+// SetPushName updates the push name (display name) of the current user.
+//
+// The name is saved in the device store, synced to other devices through app state and
+// sent to the server in an available presence, which is where recipients get the name from.
+func (cli *Client) SetPushName(name string) error {
+	if len(name) == 0 {
+		return ErrNoPushName
+	}
+	err := cli.SendAppState(appstate.BuildSettingPushName(name))
+	if err != nil {
+		return fmt.Errorf("failed to sync push name change: %w", err)
+	}
+	// Fetching the app state after sending the patch should already update the push name,
+	// but make sure it's saved even if the mutation wasn't applied.
+	if cli.Store.PushName != name {
+		err = cli.Store.SetPushName(name)
+		if err != nil {
+			return fmt.Errorf("failed to save push name: %w", err)
+		}
+	}
+	return cli.SendPresence(types.PresenceAvailable)
+}
+
 func (cli *Client) updatePushName(user types.JID, messageInfo *types.MessageInfo, name string) {
 	if cli.Store.Contacts == nil {
 		return
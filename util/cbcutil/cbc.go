@@ -48,6 +48,54 @@ func Decrypt(key, iv, ciphertext []byte) ([]byte, error) {
 	return unpad(ciphertext)
 }
 
+/*
+DecryptStream decrypts the ciphertext read from src with the given key and initialization vector(iv) and writes
+the plaintext to dst. Unlike Decrypt, the iv must be provided, and the ciphertext doesn't need to fit in memory.
+*/
+func DecryptStream(key, iv []byte, src io.Reader, dst io.Writer) error {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return err
+	}
+	cbc := cipher.NewCBCDecrypter(block, iv)
+
+	buf := make([]byte, 32*1024)
+	// The last block is held back until the end of the stream, as it contains the padding.
+	lastBlock := make([]byte, 0, aes.BlockSize)
+	for {
+		n, readErr := io.ReadFull(src, buf)
+		if readErr != nil && readErr != io.EOF && readErr != io.ErrUnexpectedEOF {
+			return readErr
+		} else if n%aes.BlockSize != 0 {
+			return fmt.Errorf("ciphertext is not a multiple of the block size")
+		}
+		if n > 0 {
+			cbc.CryptBlocks(buf[:n], buf[:n])
+			if len(lastBlock) > 0 {
+				if _, err = dst.Write(lastBlock); err != nil {
+					return err
+				}
+			}
+			if _, err = dst.Write(buf[:n-aes.BlockSize]); err != nil {
+				return err
+			}
+			lastBlock = append(lastBlock[:0], buf[n-aes.BlockSize:n]...)
+		}
+		if readErr != nil {
+			break
+		}
+	}
+	if len(lastBlock) == 0 {
+		return fmt.Errorf("ciphertext is shorter then block size: 0 / %d", aes.BlockSize)
+	}
+	lastBlock, err = unpad(lastBlock)
+	if err != nil {
+		return err
+	}
+	_, err = dst.Write(lastBlock)
+	return err
+}
+
 /*
 Encrypt is a function that encrypts plaintext with a given key and an optional initialization vector(iv).
 */
@@ -23,3 +23,23 @@ func TestEncryptDecrypt(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestDecryptStream(t *testing.T) {
+	key := []byte("MySecretSecretSecretSecretKey123")
+	iv := []byte("0123456789abcdef")
+	for _, length := range []int{0, 15, 16, 17, 32*1024 - 1, 32 * 1024, 100000} {
+		plain := bytes.Repeat([]byte{'a'}, length)
+		cipher, err := Encrypt(key, iv, plain)
+		if err != nil {
+			t.Fatalf("Failed to encrypt %d bytes: %v", length, err)
+		}
+		var out bytes.Buffer
+		err = DecryptStream(key, iv, bytes.NewReader(cipher), &out)
+		if err != nil {
+			t.Fatalf("Failed to decrypt %d bytes: %v", length, err)
+		}
+		if !bytes.Equal(plain, out.Bytes()) {
+			t.Errorf("Decrypted data of %d bytes doesn't match (got %d bytes)", length, out.Len())
+		}
+	}
+}
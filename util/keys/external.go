@@ -0,0 +1,77 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package keys
+
+import (
+	"fmt"
+
+	"go.mau.fi/libsignal/ecc"
+	"golang.org/x/crypto/curve25519"
+)
+
+// DHKey is a curve25519 key that can be used for Diffie-Hellman key agreement.
+//
+// The private key doesn't have to be available in memory, implementations can e.g. delegate the operation to a HSM.
+type DHKey interface {
+	PublicKey() *[32]byte
+	SharedSecret(pub [32]byte) ([]byte, error)
+}
+
+// SigningKey is a curve25519 key that can create XEdDSA signatures that are verifiable with ecc.VerifySignature.
+//
+// The private key doesn't have to be available in memory, implementations can e.g. delegate the operation to a HSM.
+type SigningKey interface {
+	PublicKey() *[32]byte
+	SignMessage(message []byte) (*[64]byte, error)
+}
+
+// ExternalKey is implemented by keys that are stored outside the whatsmeow database.
+// Stores save the reference instead of the private key, and load the key using a KeyProvider.
+type ExternalKey interface {
+	KeyReference() string
+}
+
+// KeyProvider loads external keys from the references returned by ExternalKey.KeyReference.
+type KeyProvider interface {
+	LoadDHKey(ref string) (DHKey, error)
+	LoadSigningKey(ref string) (SigningKey, error)
+}
+
+var (
+	_ DHKey      = (*KeyPair)(nil)
+	_ SigningKey = (*KeyPair)(nil)
+)
+
+// PublicKey returns the public key of the key pair.
+func (kp *KeyPair) PublicKey() *[32]byte {
+	return kp.Pub
+}
+
+// SharedSecret calculates the X25519 shared secret between the private key of this key pair and the given public key.
+func (kp *KeyPair) SharedSecret(pub [32]byte) ([]byte, error) {
+	return curve25519.X25519(kp.Priv[:], pub[:])
+}
+
+// SignMessage signs the given message with the private key of this key pair.
+func (kp *KeyPair) SignMessage(message []byte) (*[64]byte, error) {
+	signature := ecc.CalculateSignature(ecc.NewDjbECPrivateKey(*kp.Priv), message)
+	return &signature, nil
+}
+
+// NewSignedPreKey generates a new pre-key and signs it with the given key.
+func NewSignedPreKey(signer SigningKey, keyID uint32) (*PreKey, error) {
+	newKey := NewPreKey(keyID)
+	pubKeyForSignature := make([]byte, 33)
+	pubKeyForSignature[0] = ecc.DjbType
+	copy(pubKeyForSignature[1:], newKey.Pub[:])
+	signature, err := signer.SignMessage(pubKeyForSignature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign pre-key: %w", err)
+	}
+	newKey.Signature = signature
+	return newKey, nil
+}
@@ -10,6 +10,9 @@ package keys
 import (
 	"crypto/rand"
 	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
 
 	"go.mau.fi/libsignal/ecc"
 	"golang.org/x/crypto/curve25519"
@@ -73,3 +76,42 @@ func NewPreKey(keyID uint32) *PreKey {
 		KeyID:   keyID,
 	}
 }
+
+// NewPreKeys generates count prekeys with sequential IDs starting from startID.
+//
+// The keys are generated concurrently using up to GOMAXPROCS goroutines,
+// but the returned slice is always ordered by key ID.
+func NewPreKeys(startID uint32, count int) (preKeys []*PreKey, err error) {
+	preKeys = make([]*PreKey, count)
+	workers := runtime.GOMAXPROCS(0)
+	if workers > count {
+		workers = count
+	}
+	var next int64 = -1
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if val := recover(); val != nil {
+					errs <- fmt.Errorf("failed to generate prekey: %v", val)
+				}
+			}()
+			for {
+				index := atomic.AddInt64(&next, 1)
+				if index >= int64(count) {
+					return
+				}
+				preKeys[index] = NewPreKey(startID + uint32(index))
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	if err = <-errs; err != nil {
+		return nil, err
+	}
+	return preKeys, nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package keys
+
+import (
+	"bytes"
+	"testing"
+
+	"go.mau.fi/libsignal/ecc"
+)
+
+func TestNewPreKeysSequentialIDs(t *testing.T) {
+	preKeys, err := NewPreKeys(100, 50)
+	if err != nil {
+		t.Fatalf("Failed to generate prekeys: %v", err)
+	}
+	if len(preKeys) != 50 {
+		t.Fatalf("Expected 50 prekeys, got %d", len(preKeys))
+	}
+	for i, key := range preKeys {
+		if key == nil || key.KeyID != uint32(100+i) {
+			t.Fatalf("Unexpected prekey at index %d: %+v", i, key)
+		}
+	}
+}
+
+func BenchmarkNewPreKeysSerial(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for id := uint32(1); id <= 1000; id++ {
+			NewPreKey(id)
+		}
+	}
+}
+
+func BenchmarkNewPreKeysParallel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = NewPreKeys(1, 1000)
+	}
+}
+
+// hiddenKey is a software implementation of DHKey and SigningKey that doesn't expose the private key,
+// similar to what a HSM-backed implementation would look like.
+type hiddenKey struct {
+	kp *KeyPair
+}
+
+func (hk *hiddenKey) PublicKey() *[32]byte {
+	return hk.kp.Pub
+}
+
+func (hk *hiddenKey) SharedSecret(pub [32]byte) ([]byte, error) {
+	return hk.kp.SharedSecret(pub)
+}
+
+func (hk *hiddenKey) SignMessage(message []byte) (*[64]byte, error) {
+	return hk.kp.SignMessage(message)
+}
+
+func TestExternalKeyOperations(t *testing.T) {
+	external := &hiddenKey{kp: NewKeyPair()}
+	other := NewKeyPair()
+	secret1, err := external.SharedSecret(*other.Pub)
+	if err != nil {
+		t.Fatalf("Failed to calculate shared secret: %v", err)
+	}
+	secret2, err := other.SharedSecret(*external.PublicKey())
+	if err != nil {
+		t.Fatalf("Failed to calculate shared secret: %v", err)
+	}
+	if !bytes.Equal(secret1, secret2) {
+		t.Error("Shared secrets don't match")
+	}
+
+	preKey, err := NewSignedPreKey(external, 5)
+	if err != nil {
+		t.Fatalf("Failed to create signed pre-key: %v", err)
+	}
+	if preKey.KeyID != 5 {
+		t.Errorf("Expected pre-key ID 5, got %d", preKey.KeyID)
+	}
+	message := append([]byte{ecc.DjbType}, preKey.Pub[:]...)
+	if !ecc.VerifySignature(ecc.NewDjbECPublicKey(*external.PublicKey()), message, *preKey.Signature) {
+		t.Error("Signed pre-key signature is invalid")
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package waLog
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// SlogOptions contains the level settings for a Logger created with Slog.
+type SlogOptions struct {
+	// DefaultLevel is the minimum level logged for modules that don't have an override in ModuleLevels.
+	DefaultLevel slog.Level
+	// ModuleLevels contains minimum levels for specific modules. The keys are module paths relative to the
+	// root logger (e.g. "Socket" or "RawNode/Recv"). The most specific matching path is used, so a level
+	// set for a module also applies to its submodules.
+	ModuleLevels map[string]slog.Level
+}
+
+type slogLogger struct {
+	log    *slog.Logger
+	base   *slog.Logger
+	module string
+	level  slog.Level
+	opts   *SlogOptions
+}
+
+// Slog returns a Logger that writes to the given *slog.Logger.
+// Submodules are included in log records as a "module" attribute containing the full module path.
+func Slog(logger *slog.Logger, opts SlogOptions) Logger {
+	return &slogLogger{log: logger, base: logger, level: opts.levelFor(""), opts: &opts}
+}
+
+func (opts *SlogOptions) levelFor(module string) slog.Level {
+	for path := module; len(path) > 0; {
+		if level, ok := opts.ModuleLevels[path]; ok {
+			return level
+		}
+		index := strings.LastIndexByte(path, '/')
+		if index < 0 {
+			break
+		}
+		path = path[:index]
+	}
+	return opts.DefaultLevel
+}
+
+func (s *slogLogger) logf(level slog.Level, msg string, args ...interface{}) {
+	if level < s.level {
+		return
+	}
+	ctx := context.Background()
+	if !s.log.Enabled(ctx, level) {
+		return
+	}
+	s.log.Log(ctx, level, fmt.Sprintf(msg, args...))
+}
+
+func (s *slogLogger) Errorf(msg string, args ...interface{}) { s.logf(slog.LevelError, msg, args...) }
+func (s *slogLogger) Warnf(msg string, args ...interface{})  { s.logf(slog.LevelWarn, msg, args...) }
+func (s *slogLogger) Infof(msg string, args ...interface{})  { s.logf(slog.LevelInfo, msg, args...) }
+func (s *slogLogger) Debugf(msg string, args ...interface{}) { s.logf(slog.LevelDebug, msg, args...) }
+func (s *slogLogger) Sub(mod string) Logger {
+	module := mod
+	if len(s.module) > 0 {
+		module = fmt.Sprintf("%s/%s", s.module, mod)
+	}
+	return &slogLogger{
+		log:    s.base.With("module", module),
+		base:   s.base,
+		module: module,
+		level:  s.opts.levelFor(module),
+		opts:   s.opts,
+	}
+}
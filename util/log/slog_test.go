@@ -0,0 +1,39 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package waLog
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestSlogModuleLevels(t *testing.T) {
+	var buf bytes.Buffer
+	handler := slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})
+	log := Slog(slog.New(handler), SlogOptions{
+		DefaultLevel: slog.LevelWarn,
+		ModuleLevels: map[string]slog.Level{"Socket": slog.LevelDebug},
+	})
+
+	log.Infof("root info")
+	log.Sub("Socket").Sub("Frame").Debugf("socket debug %d", 1)
+	log.Sub("AppState").Debugf("app state debug")
+	log.Sub("AppState").Warnf("app state warning")
+
+	output := buf.String()
+	if strings.Contains(output, "root info") || strings.Contains(output, "app state debug") {
+		t.Errorf("Logs below the module level were written:\n%s", output)
+	}
+	if !strings.Contains(output, "socket debug 1") || !strings.Contains(output, "module=Socket/Frame") {
+		t.Errorf("Debug log from Socket submodule is missing:\n%s", output)
+	}
+	if !strings.Contains(output, "app state warning") || !strings.Contains(output, "module=AppState") {
+		t.Errorf("Warning from AppState module is missing:\n%s", output)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package lru contains a simple size-bounded least-recently-used cache.
+package lru
+
+import (
+	"container/list"
+)
+
+// Cache is a map that evicts the least recently used entries when it grows beyond MaxSize entries.
+//
+// Cache is not safe for concurrent use, callers are expected to use their own locks.
+type Cache[K comparable, V any] struct {
+	maxSize int
+	items   map[K]*list.Element
+	order   *list.List
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// New creates a new cache that holds at most maxSize entries. Zero or a negative number means no limit.
+func New[K comparable, V any](maxSize int) *Cache[K, V] {
+	return &Cache[K, V]{
+		maxSize: maxSize,
+		items:   make(map[K]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// Get returns the value for the given key and marks it as the most recently used entry.
+func (c *Cache[K, V]) Get(key K) (value V, ok bool) {
+	elem, ok := c.items[key]
+	if !ok {
+		return
+	}
+	c.order.MoveToBack(elem)
+	return elem.Value.(*entry[K, V]).value, true
+}
+
+// Peek returns the value for the given key without affecting the eviction order.
+func (c *Cache[K, V]) Peek(key K) (value V, ok bool) {
+	elem, ok := c.items[key]
+	if !ok {
+		return
+	}
+	return elem.Value.(*entry[K, V]).value, true
+}
+
+// Put stores the given value, marks it as the most recently used entry and returns the number of entries
+// that were evicted to make room for it.
+func (c *Cache[K, V]) Put(key K, value V) (evicted int) {
+	if elem, ok := c.items[key]; ok {
+		elem.Value.(*entry[K, V]).value = value
+		c.order.MoveToBack(elem)
+		return 0
+	}
+	c.items[key] = c.order.PushBack(&entry[K, V]{key: key, value: value})
+	return c.evict()
+}
+
+// Remove deletes the given key from the cache.
+func (c *Cache[K, V]) Remove(key K) {
+	if elem, ok := c.items[key]; ok {
+		c.order.Remove(elem)
+		delete(c.items, key)
+	}
+}
+
+// Len returns the number of entries in the cache.
+func (c *Cache[K, V]) Len() int {
+	return len(c.items)
+}
+
+// Clear removes all entries from the cache.
+func (c *Cache[K, V]) Clear() {
+	c.items = make(map[K]*list.Element)
+	c.order.Init()
+}
+
+// SetMaxSize changes the maximum number of entries and returns the number of entries that were evicted
+// if the cache was larger than the new limit.
+func (c *Cache[K, V]) SetMaxSize(maxSize int) (evicted int) {
+	c.maxSize = maxSize
+	return c.evict()
+}
+
+func (c *Cache[K, V]) evict() (evicted int) {
+	for c.maxSize > 0 && c.order.Len() > c.maxSize {
+		oldest := c.order.Remove(c.order.Front()).(*entry[K, V])
+		delete(c.items, oldest.key)
+		evicted++
+	}
+	return
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package lru
+
+import (
+	"testing"
+)
+
+func TestCacheEviction(t *testing.T) {
+	cache := New[string, int](2)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	if _, ok := cache.Get("a"); !ok {
+		t.Fatal("Expected a to be cached")
+	}
+	if evicted := cache.Put("c", 3); evicted != 1 {
+		t.Errorf("Expected 1 evicted entry, got %d", evicted)
+	}
+	if _, ok := cache.Peek("b"); ok {
+		t.Error("Expected least recently used entry b to be evicted")
+	}
+	if val, ok := cache.Peek("a"); !ok || val != 1 {
+		t.Errorf("Expected a=1 to still be cached, got %d/%t", val, ok)
+	}
+	if evicted := cache.SetMaxSize(1); evicted != 1 || cache.Len() != 1 {
+		t.Errorf("Expected shrinking to evict 1 entry, got %d (len %d)", evicted, cache.Len())
+	}
+	if _, ok := cache.Peek("c"); !ok {
+		t.Error("Expected most recently used entry c to survive shrinking")
+	}
+	cache.Clear()
+	if cache.Len() != 0 {
+		t.Errorf("Expected cache to be empty after clearing, got %d entries", cache.Len())
+	}
+}
+
+func TestCacheUnlimited(t *testing.T) {
+	cache := New[int, int](0)
+	for i := 0; i < 1000; i++ {
+		cache.Put(i, i)
+	}
+	cache.Remove(5)
+	if cache.Len() != 999 {
+		t.Errorf("Expected 999 entries, got %d", cache.Len())
+	}
+}
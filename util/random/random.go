@@ -0,0 +1,46 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package random contains helpers for generating cryptographically secure random values.
+//
+// Anything that ends up in keys, IDs or other protocol values should use this package (or crypto/rand directly)
+// instead of math/rand, which is predictable.
+package random
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+)
+
+// Bytes returns n cryptographically secure random bytes.
+//
+// It panics if the system random source fails, as there's no sensible way to continue without entropy.
+func Bytes(n int) []byte {
+	data := make([]byte, n)
+	_, err := rand.Read(data)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+// Uint32 returns a cryptographically secure random uint32.
+func Uint32() uint32 {
+	return binary.BigEndian.Uint32(Bytes(4))
+}
+
+// MaxRegistrationID is the upper bound (exclusive) of registration IDs generated by RegistrationID.
+const MaxRegistrationID = 1 << 31
+
+// RegistrationID returns a random Signal registration ID, which is never zero and always less than MaxRegistrationID.
+func RegistrationID() uint32 {
+	for {
+		id := Uint32() & (MaxRegistrationID - 1)
+		if id != 0 {
+			return id
+		}
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package random
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBytes(t *testing.T) {
+	a, b := Bytes(32), Bytes(32)
+	if len(a) != 32 || len(b) != 32 {
+		t.Fatalf("Expected 32 bytes, got %d and %d", len(a), len(b))
+	}
+	if bytes.Equal(a, b) {
+		t.Error("Two random byte slices were equal")
+	}
+}
+
+func TestRegistrationIDRange(t *testing.T) {
+	seen := make(map[uint32]struct{})
+	for i := 0; i < 1000; i++ {
+		id := RegistrationID()
+		if id == 0 || id >= MaxRegistrationID {
+			t.Fatalf("Registration ID %d out of range", id)
+		}
+		seen[id] = struct{}{}
+	}
+	if len(seen) < 990 {
+		t.Errorf("Expected registration IDs to be mostly unique, got %d unique out of 1000", len(seen))
+	}
+}